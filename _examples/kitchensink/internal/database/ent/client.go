@@ -24,6 +24,7 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/skipped"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 // Client is the client that holds all ent builders.
@@ -47,6 +48,8 @@ type Client struct {
 	Skipped *SkippedClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Vaccination is the client for interacting with the Vaccination builders.
+	Vaccination *VaccinationClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -66,6 +69,7 @@ func (c *Client) init() {
 	c.Settings = NewSettingsClient(c.config)
 	c.Skipped = NewSkippedClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.Vaccination = NewVaccinationClient(c.config)
 }
 
 type (
@@ -156,16 +160,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Category:   NewCategoryClient(cfg),
-		Follows:    NewFollowsClient(cfg),
-		Friendship: NewFriendshipClient(cfg),
-		Pet:        NewPetClient(cfg),
-		Post:       NewPostClient(cfg),
-		Settings:   NewSettingsClient(cfg),
-		Skipped:    NewSkippedClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Category:    NewCategoryClient(cfg),
+		Follows:     NewFollowsClient(cfg),
+		Friendship:  NewFriendshipClient(cfg),
+		Pet:         NewPetClient(cfg),
+		Post:        NewPostClient(cfg),
+		Settings:    NewSettingsClient(cfg),
+		Skipped:     NewSkippedClient(cfg),
+		User:        NewUserClient(cfg),
+		Vaccination: NewVaccinationClient(cfg),
 	}, nil
 }
 
@@ -183,16 +188,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Category:   NewCategoryClient(cfg),
-		Follows:    NewFollowsClient(cfg),
-		Friendship: NewFriendshipClient(cfg),
-		Pet:        NewPetClient(cfg),
-		Post:       NewPostClient(cfg),
-		Settings:   NewSettingsClient(cfg),
-		Skipped:    NewSkippedClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Category:    NewCategoryClient(cfg),
+		Follows:     NewFollowsClient(cfg),
+		Friendship:  NewFriendshipClient(cfg),
+		Pet:         NewPetClient(cfg),
+		Post:        NewPostClient(cfg),
+		Settings:    NewSettingsClient(cfg),
+		Skipped:     NewSkippedClient(cfg),
+		User:        NewUserClient(cfg),
+		Vaccination: NewVaccinationClient(cfg),
 	}, nil
 }
 
@@ -223,7 +229,7 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Category, c.Follows, c.Friendship, c.Pet, c.Post, c.Settings, c.Skipped,
-		c.User,
+		c.User, c.Vaccination,
 	} {
 		n.Use(hooks...)
 	}
@@ -234,7 +240,7 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Category, c.Follows, c.Friendship, c.Pet, c.Post, c.Settings, c.Skipped,
-		c.User,
+		c.User, c.Vaccination,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -259,6 +265,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Skipped.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *VaccinationMutation:
+		return c.Vaccination.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -866,6 +874,22 @@ func (c *PetClient) QueryFollowedBy(_m *Pet) *UserQuery {
 	return query
 }
 
+// QueryVaccinations queries the vaccinations edge of a Pet.
+func (c *PetClient) QueryVaccinations(_m *Pet) *VaccinationQuery {
+	query := (&VaccinationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pet.Table, pet.FieldID, id),
+			sqlgraph.To(vaccination.Table, vaccination.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pet.VaccinationsTable, pet.VaccinationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryFollowing queries the following edge of a Pet.
 func (c *PetClient) QueryFollowing(_m *Pet) *FollowsQuery {
 	query := (&FollowsClient{config: c.config}).Query()
@@ -1567,13 +1591,163 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// VaccinationClient is a client for the Vaccination schema.
+type VaccinationClient struct {
+	config
+}
+
+// NewVaccinationClient returns a client for the Vaccination from the given config.
+func NewVaccinationClient(c config) *VaccinationClient {
+	return &VaccinationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vaccination.Hooks(f(g(h())))`.
+func (c *VaccinationClient) Use(hooks ...Hook) {
+	c.hooks.Vaccination = append(c.hooks.Vaccination, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vaccination.Intercept(f(g(h())))`.
+func (c *VaccinationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Vaccination = append(c.inters.Vaccination, interceptors...)
+}
+
+// Create returns a builder for creating a Vaccination entity.
+func (c *VaccinationClient) Create() *VaccinationCreate {
+	mutation := newVaccinationMutation(c.config, OpCreate)
+	return &VaccinationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Vaccination entities.
+func (c *VaccinationClient) CreateBulk(builders ...*VaccinationCreate) *VaccinationCreateBulk {
+	return &VaccinationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VaccinationClient) MapCreateBulk(slice any, setFunc func(*VaccinationCreate, int)) *VaccinationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VaccinationCreateBulk{err: fmt.Errorf("calling to VaccinationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VaccinationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VaccinationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Vaccination.
+func (c *VaccinationClient) Update() *VaccinationUpdate {
+	mutation := newVaccinationMutation(c.config, OpUpdate)
+	return &VaccinationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VaccinationClient) UpdateOne(_m *Vaccination) *VaccinationUpdateOne {
+	mutation := newVaccinationMutation(c.config, OpUpdateOne, withVaccination(_m))
+	return &VaccinationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VaccinationClient) UpdateOneID(id int) *VaccinationUpdateOne {
+	mutation := newVaccinationMutation(c.config, OpUpdateOne, withVaccinationID(id))
+	return &VaccinationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Vaccination.
+func (c *VaccinationClient) Delete() *VaccinationDelete {
+	mutation := newVaccinationMutation(c.config, OpDelete)
+	return &VaccinationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VaccinationClient) DeleteOne(_m *Vaccination) *VaccinationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VaccinationClient) DeleteOneID(id int) *VaccinationDeleteOne {
+	builder := c.Delete().Where(vaccination.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VaccinationDeleteOne{builder}
+}
+
+// Query returns a query builder for Vaccination.
+func (c *VaccinationClient) Query() *VaccinationQuery {
+	return &VaccinationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVaccination},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Vaccination entity by its id.
+func (c *VaccinationClient) Get(ctx context.Context, id int) (*Vaccination, error) {
+	return c.Query().Where(vaccination.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VaccinationClient) GetX(ctx context.Context, id int) *Vaccination {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPet queries the pet edge of a Vaccination.
+func (c *VaccinationClient) QueryPet(_m *Vaccination) *PetQuery {
+	query := (&PetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vaccination.Table, vaccination.FieldID, id),
+			sqlgraph.To(pet.Table, pet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vaccination.PetTable, vaccination.PetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VaccinationClient) Hooks() []Hook {
+	return c.hooks.Vaccination
+}
+
+// Interceptors returns the client interceptors.
+func (c *VaccinationClient) Interceptors() []Interceptor {
+	return c.inters.Vaccination
+}
+
+func (c *VaccinationClient) mutate(ctx context.Context, m *VaccinationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VaccinationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VaccinationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VaccinationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VaccinationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Vaccination mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Category, Follows, Friendship, Pet, Post, Settings, Skipped, User []ent.Hook
+		Category, Follows, Friendship, Pet, Post, Settings, Skipped, User,
+		Vaccination []ent.Hook
 	}
 	inters struct {
-		Category, Follows, Friendship, Pet, Post, Settings, Skipped,
-		User []ent.Interceptor
+		Category, Follows, Friendship, Pet, Post, Settings, Skipped, User,
+		Vaccination []ent.Interceptor
 	}
 )
@@ -20,6 +20,7 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/skipped"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -80,14 +81,15 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			category.Table:   category.ValidColumn,
-			follows.Table:    follows.ValidColumn,
-			friendship.Table: friendship.ValidColumn,
-			pet.Table:        pet.ValidColumn,
-			post.Table:       post.ValidColumn,
-			settings.Table:   settings.ValidColumn,
-			skipped.Table:    skipped.ValidColumn,
-			user.Table:       user.ValidColumn,
+			category.Table:    category.ValidColumn,
+			follows.Table:     follows.ValidColumn,
+			friendship.Table:  friendship.ValidColumn,
+			pet.Table:         pet.ValidColumn,
+			post.Table:        post.ValidColumn,
+			settings.Table:    settings.ValidColumn,
+			skipped.Table:     skipped.ValidColumn,
+			user.Table:        user.ValidColumn,
+			vaccination.Table: vaccination.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
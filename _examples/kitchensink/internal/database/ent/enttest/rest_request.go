@@ -99,7 +99,6 @@ func Request[T any](ctx context.Context, ts *TestServer, method, path string, da
 		if resp.Data.Code == http.StatusNoContent {
 			return resp
 		}
-
 		errResp := &rest.ErrorResponse{}
 		err := json.Unmarshal(resp.Data.Body.Bytes(), errResp)
 		if err != nil {
@@ -111,6 +110,11 @@ func Request[T any](ctx context.Context, ts *TestServer, method, path string, da
 		}
 	}
 
+	// Responses to HEAD requests don't have a body.
+	if method == http.MethodHead {
+		return resp
+	}
+
 	if _, ok := any(resp.Value).(string); ok {
 		*resp.Value = any(resp.Data.Body.String()).(T) //nolint:erespcheck
 		return resp
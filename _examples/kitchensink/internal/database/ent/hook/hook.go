@@ -105,6 +105,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The VaccinationFunc type is an adapter to allow the use of ordinary
+// function as Vaccination mutator.
+type VaccinationFunc func(context.Context, *ent.VaccinationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VaccinationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VaccinationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VaccinationMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -93,7 +93,7 @@ var (
 		{Name: "nicknames", Type: field.TypeJSON, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "age", Type: field.TypeInt},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"DOG", "CAT", "BIRD", "FISH", "AMPHIBIAN", "REPTILE", "OTHER"}},
+		{Name: "pet_type", Type: field.TypeEnum, Enums: []string{"DOG", "CAT", "BIRD", "FISH", "AMPHIBIAN", "REPTILE", "OTHER"}},
 		{Name: "user_pets", Type: field.TypeUUID, Nullable: true},
 	}
 	// PetsTable holds the schema information for the "pets" table.
@@ -116,7 +116,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "title", Type: field.TypeString, Size: 200},
-		{Name: "slug", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "body", Type: field.TypeString},
 		{Name: "user_posts", Type: field.TypeUUID},
 	}
@@ -190,6 +190,27 @@ var (
 			},
 		},
 	}
+	// VaccinationsColumns holds the columns for the "vaccinations" table.
+	VaccinationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "administered_at", Type: field.TypeTime},
+		{Name: "pet_vaccinations", Type: field.TypeInt},
+	}
+	// VaccinationsTable holds the schema information for the "vaccinations" table.
+	VaccinationsTable = &schema.Table{
+		Name:       "vaccinations",
+		Columns:    VaccinationsColumns,
+		PrimaryKey: []*schema.Column{VaccinationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vaccinations_pets_vaccinations",
+				Columns:    []*schema.Column{VaccinationsColumns[3]},
+				RefColumns: []*schema.Column{PetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// CategoryPetsColumns holds the columns for the "category_pets" table.
 	CategoryPetsColumns = []*schema.Column{
 		{Name: "category_id", Type: field.TypeInt},
@@ -250,6 +271,7 @@ var (
 		SettingsTable,
 		SkippedsTable,
 		UsersTable,
+		VaccinationsTable,
 		CategoryPetsTable,
 		PetFriendsTable,
 	}
@@ -263,6 +285,7 @@ func init() {
 	PetsTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = SettingsTable
+	VaccinationsTable.ForeignKeys[0].RefTable = PetsTable
 	CategoryPetsTable.ForeignKeys[0].RefTable = CategoriesTable
 	CategoryPetsTable.ForeignKeys[1].RefTable = PetsTable
 	PetFriendsTable.ForeignKeys[0].RefTable = PetsTable
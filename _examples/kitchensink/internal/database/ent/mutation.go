@@ -22,6 +22,7 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/skipped"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/schema"
 )
 
@@ -34,14 +35,15 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCategory   = "Category"
-	TypeFollows    = "Follows"
-	TypeFriendship = "Friendship"
-	TypePet        = "Pet"
-	TypePost       = "Post"
-	TypeSettings   = "Settings"
-	TypeSkipped    = "Skipped"
-	TypeUser       = "User"
+	TypeCategory    = "Category"
+	TypeFollows     = "Follows"
+	TypeFriendship  = "Friendship"
+	TypePet         = "Pet"
+	TypePost        = "Post"
+	TypeSettings    = "Settings"
+	TypeSkipped     = "Skipped"
+	TypeUser        = "User"
+	TypeVaccination = "Vaccination"
 )
 
 // CategoryMutation represents an operation that mutates the Category nodes in the graph.
@@ -1895,31 +1897,34 @@ func (m *FriendshipMutation) ResetEdge(name string) error {
 // PetMutation represents an operation that mutates the Pet nodes in the graph.
 type PetMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	nicknames          *[]string
-	appendnicknames    []string
-	description        *string
-	age                *int
-	addage             *int
-	_type              *pet.Type
-	clearedFields      map[string]struct{}
-	categories         map[int]struct{}
-	removedcategories  map[int]struct{}
-	clearedcategories  bool
-	owner              *uuid.UUID
-	clearedowner       bool
-	friends            map[int]struct{}
-	removedfriends     map[int]struct{}
-	clearedfriends     bool
-	followed_by        map[uuid.UUID]struct{}
-	removedfollowed_by map[uuid.UUID]struct{}
-	clearedfollowed_by bool
-	done               bool
-	oldValue           func(context.Context) (*Pet, error)
-	predicates         []predicate.Pet
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	nicknames           *[]string
+	appendnicknames     []string
+	description         *string
+	age                 *int
+	addage              *int
+	_type               *pet.Type
+	clearedFields       map[string]struct{}
+	categories          map[int]struct{}
+	removedcategories   map[int]struct{}
+	clearedcategories   bool
+	owner               *uuid.UUID
+	clearedowner        bool
+	friends             map[int]struct{}
+	removedfriends      map[int]struct{}
+	clearedfriends      bool
+	followed_by         map[uuid.UUID]struct{}
+	removedfollowed_by  map[uuid.UUID]struct{}
+	clearedfollowed_by  bool
+	vaccinations        map[int]struct{}
+	removedvaccinations map[int]struct{}
+	clearedvaccinations bool
+	done                bool
+	oldValue            func(context.Context) (*Pet, error)
+	predicates          []predicate.Pet
 }
 
 var _ ent.Mutation = (*PetMutation)(nil)
@@ -2469,6 +2474,60 @@ func (m *PetMutation) ResetFollowedBy() {
 	m.removedfollowed_by = nil
 }
 
+// AddVaccinationIDs adds the "vaccinations" edge to the Vaccination entity by ids.
+func (m *PetMutation) AddVaccinationIDs(ids ...int) {
+	if m.vaccinations == nil {
+		m.vaccinations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.vaccinations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVaccinations clears the "vaccinations" edge to the Vaccination entity.
+func (m *PetMutation) ClearVaccinations() {
+	m.clearedvaccinations = true
+}
+
+// VaccinationsCleared reports if the "vaccinations" edge to the Vaccination entity was cleared.
+func (m *PetMutation) VaccinationsCleared() bool {
+	return m.clearedvaccinations
+}
+
+// RemoveVaccinationIDs removes the "vaccinations" edge to the Vaccination entity by IDs.
+func (m *PetMutation) RemoveVaccinationIDs(ids ...int) {
+	if m.removedvaccinations == nil {
+		m.removedvaccinations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.vaccinations, ids[i])
+		m.removedvaccinations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVaccinations returns the removed IDs of the "vaccinations" edge to the Vaccination entity.
+func (m *PetMutation) RemovedVaccinationsIDs() (ids []int) {
+	for id := range m.removedvaccinations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VaccinationsIDs returns the "vaccinations" edge IDs in the mutation.
+func (m *PetMutation) VaccinationsIDs() (ids []int) {
+	for id := range m.vaccinations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVaccinations resets all changes to the "vaccinations" edge.
+func (m *PetMutation) ResetVaccinations() {
+	m.vaccinations = nil
+	m.clearedvaccinations = false
+	m.removedvaccinations = nil
+}
+
 // Where appends a list predicates to the PetMutation builder.
 func (m *PetMutation) Where(ps ...predicate.Pet) {
 	m.predicates = append(m.predicates, ps...)
@@ -2700,7 +2759,7 @@ func (m *PetMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *PetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.categories != nil {
 		edges = append(edges, pet.EdgeCategories)
 	}
@@ -2713,6 +2772,9 @@ func (m *PetMutation) AddedEdges() []string {
 	if m.followed_by != nil {
 		edges = append(edges, pet.EdgeFollowedBy)
 	}
+	if m.vaccinations != nil {
+		edges = append(edges, pet.EdgeVaccinations)
+	}
 	return edges
 }
 
@@ -2742,13 +2804,19 @@ func (m *PetMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case pet.EdgeVaccinations:
+		ids := make([]ent.Value, 0, len(m.vaccinations))
+		for id := range m.vaccinations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *PetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.removedcategories != nil {
 		edges = append(edges, pet.EdgeCategories)
 	}
@@ -2758,6 +2826,9 @@ func (m *PetMutation) RemovedEdges() []string {
 	if m.removedfollowed_by != nil {
 		edges = append(edges, pet.EdgeFollowedBy)
 	}
+	if m.removedvaccinations != nil {
+		edges = append(edges, pet.EdgeVaccinations)
+	}
 	return edges
 }
 
@@ -2783,13 +2854,19 @@ func (m *PetMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case pet.EdgeVaccinations:
+		ids := make([]ent.Value, 0, len(m.removedvaccinations))
+		for id := range m.removedvaccinations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *PetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 5)
 	if m.clearedcategories {
 		edges = append(edges, pet.EdgeCategories)
 	}
@@ -2802,6 +2879,9 @@ func (m *PetMutation) ClearedEdges() []string {
 	if m.clearedfollowed_by {
 		edges = append(edges, pet.EdgeFollowedBy)
 	}
+	if m.clearedvaccinations {
+		edges = append(edges, pet.EdgeVaccinations)
+	}
 	return edges
 }
 
@@ -2817,6 +2897,8 @@ func (m *PetMutation) EdgeCleared(name string) bool {
 		return m.clearedfriends
 	case pet.EdgeFollowedBy:
 		return m.clearedfollowed_by
+	case pet.EdgeVaccinations:
+		return m.clearedvaccinations
 	}
 	return false
 }
@@ -2848,6 +2930,9 @@ func (m *PetMutation) ResetEdge(name string) error {
 	case pet.EdgeFollowedBy:
 		m.ResetFollowedBy()
 		return nil
+	case pet.EdgeVaccinations:
+		m.ResetVaccinations()
+		return nil
 	}
 	return fmt.Errorf("unknown Pet edge %s", name)
 }
@@ -5876,3 +5961,450 @@ func (m *UserMutation) ResetEdge(name string) error {
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
+
+// VaccinationMutation represents an operation that mutates the Vaccination nodes in the graph.
+type VaccinationMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	administered_at *time.Time
+	clearedFields   map[string]struct{}
+	pet             *int
+	clearedpet      bool
+	done            bool
+	oldValue        func(context.Context) (*Vaccination, error)
+	predicates      []predicate.Vaccination
+}
+
+var _ ent.Mutation = (*VaccinationMutation)(nil)
+
+// vaccinationOption allows management of the mutation configuration using functional options.
+type vaccinationOption func(*VaccinationMutation)
+
+// newVaccinationMutation creates new mutation for the Vaccination entity.
+func newVaccinationMutation(c config, op Op, opts ...vaccinationOption) *VaccinationMutation {
+	m := &VaccinationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVaccination,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVaccinationID sets the ID field of the mutation.
+func withVaccinationID(id int) vaccinationOption {
+	return func(m *VaccinationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Vaccination
+		)
+		m.oldValue = func(ctx context.Context) (*Vaccination, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Vaccination.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVaccination sets the old Vaccination of the mutation.
+func withVaccination(node *Vaccination) vaccinationOption {
+	return func(m *VaccinationMutation) {
+		m.oldValue = func(context.Context) (*Vaccination, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VaccinationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VaccinationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VaccinationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VaccinationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Vaccination.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *VaccinationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *VaccinationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Vaccination entity.
+// If the Vaccination object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VaccinationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VaccinationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAdministeredAt sets the "administered_at" field.
+func (m *VaccinationMutation) SetAdministeredAt(t time.Time) {
+	m.administered_at = &t
+}
+
+// AdministeredAt returns the value of the "administered_at" field in the mutation.
+func (m *VaccinationMutation) AdministeredAt() (r time.Time, exists bool) {
+	v := m.administered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdministeredAt returns the old "administered_at" field's value of the Vaccination entity.
+// If the Vaccination object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VaccinationMutation) OldAdministeredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdministeredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdministeredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdministeredAt: %w", err)
+	}
+	return oldValue.AdministeredAt, nil
+}
+
+// ResetAdministeredAt resets all changes to the "administered_at" field.
+func (m *VaccinationMutation) ResetAdministeredAt() {
+	m.administered_at = nil
+}
+
+// SetPetID sets the "pet" edge to the Pet entity by id.
+func (m *VaccinationMutation) SetPetID(id int) {
+	m.pet = &id
+}
+
+// ClearPet clears the "pet" edge to the Pet entity.
+func (m *VaccinationMutation) ClearPet() {
+	m.clearedpet = true
+}
+
+// PetCleared reports if the "pet" edge to the Pet entity was cleared.
+func (m *VaccinationMutation) PetCleared() bool {
+	return m.clearedpet
+}
+
+// PetID returns the "pet" edge ID in the mutation.
+func (m *VaccinationMutation) PetID() (id int, exists bool) {
+	if m.pet != nil {
+		return *m.pet, true
+	}
+	return
+}
+
+// PetIDs returns the "pet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PetID instead. It exists only for internal usage by the builders.
+func (m *VaccinationMutation) PetIDs() (ids []int) {
+	if id := m.pet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPet resets all changes to the "pet" edge.
+func (m *VaccinationMutation) ResetPet() {
+	m.pet = nil
+	m.clearedpet = false
+}
+
+// Where appends a list predicates to the VaccinationMutation builder.
+func (m *VaccinationMutation) Where(ps ...predicate.Vaccination) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VaccinationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VaccinationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Vaccination, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VaccinationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VaccinationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Vaccination).
+func (m *VaccinationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VaccinationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, vaccination.FieldName)
+	}
+	if m.administered_at != nil {
+		fields = append(fields, vaccination.FieldAdministeredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VaccinationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vaccination.FieldName:
+		return m.Name()
+	case vaccination.FieldAdministeredAt:
+		return m.AdministeredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VaccinationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vaccination.FieldName:
+		return m.OldName(ctx)
+	case vaccination.FieldAdministeredAt:
+		return m.OldAdministeredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Vaccination field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VaccinationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vaccination.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case vaccination.FieldAdministeredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdministeredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Vaccination field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VaccinationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VaccinationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VaccinationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Vaccination numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VaccinationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VaccinationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VaccinationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Vaccination nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VaccinationMutation) ResetField(name string) error {
+	switch name {
+	case vaccination.FieldName:
+		m.ResetName()
+		return nil
+	case vaccination.FieldAdministeredAt:
+		m.ResetAdministeredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Vaccination field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VaccinationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.pet != nil {
+		edges = append(edges, vaccination.EdgePet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VaccinationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case vaccination.EdgePet:
+		if id := m.pet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VaccinationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VaccinationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VaccinationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpet {
+		edges = append(edges, vaccination.EdgePet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VaccinationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case vaccination.EdgePet:
+		return m.clearedpet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VaccinationMutation) ClearEdge(name string) error {
+	switch name {
+	case vaccination.EdgePet:
+		m.ClearPet()
+		return nil
+	}
+	return fmt.Errorf("unknown Vaccination unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VaccinationMutation) ResetEdge(name string) error {
+	switch name {
+	case vaccination.EdgePet:
+		m.ResetPet()
+		return nil
+	}
+	return fmt.Errorf("unknown Vaccination edge %s", name)
+}
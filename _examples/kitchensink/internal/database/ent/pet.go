@@ -46,11 +46,13 @@ type PetEdges struct {
 	Friends []*Pet `json:"friends,omitempty"`
 	// Users that this pet is followed by.
 	FollowedBy []*User `json:"followed_by,omitempty"`
+	// Vaccinations the pet has received.
+	Vaccinations []*Vaccination `json:"vaccinations,omitempty"`
 	// Following holds the value of the following edge.
 	Following []*Follows `json:"following,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // CategoriesOrErr returns the Categories value or an error if the edge
@@ -91,10 +93,19 @@ func (e PetEdges) FollowedByOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "followed_by"}
 }
 
+// VaccinationsOrErr returns the Vaccinations value or an error if the edge
+// was not loaded in eager-loading.
+func (e PetEdges) VaccinationsOrErr() ([]*Vaccination, error) {
+	if e.loadedTypes[4] {
+		return e.Vaccinations, nil
+	}
+	return nil, &NotLoadedError{edge: "vaccinations"}
+}
+
 // FollowingOrErr returns the Following value or an error if the edge
 // was not loaded in eager-loading.
 func (e PetEdges) FollowingOrErr() ([]*Follows, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Following, nil
 	}
 	return nil, &NotLoadedError{edge: "following"}
@@ -207,6 +218,11 @@ func (_m *Pet) QueryFollowedBy() *UserQuery {
 	return NewPetClient(_m.config).QueryFollowedBy(_m)
 }
 
+// QueryVaccinations queries the "vaccinations" edge of the Pet entity.
+func (_m *Pet) QueryVaccinations() *VaccinationQuery {
+	return NewPetClient(_m.config).QueryVaccinations(_m)
+}
+
 // QueryFollowing queries the "following" edge of the Pet entity.
 func (_m *Pet) QueryFollowing() *FollowsQuery {
 	return NewPetClient(_m.config).QueryFollowing(_m)
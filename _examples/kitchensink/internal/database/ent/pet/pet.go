@@ -23,7 +23,7 @@ const (
 	// FieldAge holds the string denoting the age field in the database.
 	FieldAge = "age"
 	// FieldType holds the string denoting the type field in the database.
-	FieldType = "type"
+	FieldType = "pet_type"
 	// EdgeCategories holds the string denoting the categories edge name in mutations.
 	EdgeCategories = "categories"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
@@ -32,6 +32,8 @@ const (
 	EdgeFriends = "friends"
 	// EdgeFollowedBy holds the string denoting the followed_by edge name in mutations.
 	EdgeFollowedBy = "followed_by"
+	// EdgeVaccinations holds the string denoting the vaccinations edge name in mutations.
+	EdgeVaccinations = "vaccinations"
 	// EdgeFollowing holds the string denoting the following edge name in mutations.
 	EdgeFollowing = "following"
 	// Table holds the table name of the pet in the database.
@@ -55,6 +57,13 @@ const (
 	// FollowedByInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	FollowedByInverseTable = "users"
+	// VaccinationsTable is the table that holds the vaccinations relation/edge.
+	VaccinationsTable = "vaccinations"
+	// VaccinationsInverseTable is the table name for the Vaccination entity.
+	// It exists in this package in order to avoid circular dependency with the "vaccination" package.
+	VaccinationsInverseTable = "vaccinations"
+	// VaccinationsColumn is the table column denoting the vaccinations relation/edge.
+	VaccinationsColumn = "pet_vaccinations"
 	// FollowingTable is the table that holds the following relation/edge.
 	FollowingTable = "follows"
 	// FollowingInverseTable is the table name for the Follows entity.
@@ -217,6 +226,20 @@ func ByFollowedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByVaccinationsCount orders the results by vaccinations count.
+func ByVaccinationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVaccinationsStep(), opts...)
+	}
+}
+
+// ByVaccinations orders the results by vaccinations terms.
+func ByVaccinations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVaccinationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByFollowingCount orders the results by following count.
 func ByFollowingCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -258,6 +281,13 @@ func newFollowedByStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, FollowedByTable, FollowedByPrimaryKey...),
 	)
 }
+func newVaccinationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VaccinationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VaccinationsTable, VaccinationsColumn),
+	)
+}
 func newFollowingStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -370,6 +370,29 @@ func HasFollowedByWith(preds ...predicate.User) predicate.Pet {
 	})
 }
 
+// HasVaccinations applies the HasEdge predicate on the "vaccinations" edge.
+func HasVaccinations() predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VaccinationsTable, VaccinationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVaccinationsWith applies the HasEdge predicate on the "vaccinations" edge with a given conditions (other predicates).
+func HasVaccinationsWith(preds ...predicate.Vaccination) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		step := newVaccinationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasFollowing applies the HasEdge predicate on the "following" edge.
 func HasFollowing() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
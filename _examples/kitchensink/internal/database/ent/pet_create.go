@@ -14,6 +14,7 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/category"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 // PetCreate is the builder for creating a Pet entity.
@@ -132,6 +133,21 @@ func (_c *PetCreate) AddFollowedBy(v ...*User) *PetCreate {
 	return _c.AddFollowedByIDs(ids...)
 }
 
+// AddVaccinationIDs adds the "vaccinations" edge to the Vaccination entity by IDs.
+func (_c *PetCreate) AddVaccinationIDs(ids ...int) *PetCreate {
+	_c.mutation.AddVaccinationIDs(ids...)
+	return _c
+}
+
+// AddVaccinations adds the "vaccinations" edges to the Vaccination entity.
+func (_c *PetCreate) AddVaccinations(v ...*Vaccination) *PetCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVaccinationIDs(ids...)
+}
+
 // Mutation returns the PetMutation object of the builder.
 func (_c *PetCreate) Mutation() *PetMutation {
 	return _c.mutation
@@ -307,6 +323,22 @@ func (_c *PetCreate) createSpec() (*Pet, *sqlgraph.CreateSpec) {
 		edge.Target.Fields = specE.Fields
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.VaccinationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pet.VaccinationsTable,
+			Columns: []string{pet.VaccinationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
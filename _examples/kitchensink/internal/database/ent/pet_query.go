@@ -18,21 +18,23 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/predicate"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 // PetQuery is the builder for querying Pet entities.
 type PetQuery struct {
 	config
-	ctx            *QueryContext
-	order          []pet.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Pet
-	withCategories *CategoryQuery
-	withOwner      *UserQuery
-	withFriends    *PetQuery
-	withFollowedBy *UserQuery
-	withFollowing  *FollowsQuery
-	withFKs        bool
+	ctx              *QueryContext
+	order            []pet.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Pet
+	withCategories   *CategoryQuery
+	withOwner        *UserQuery
+	withFriends      *PetQuery
+	withFollowedBy   *UserQuery
+	withVaccinations *VaccinationQuery
+	withFollowing    *FollowsQuery
+	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -157,6 +159,28 @@ func (_q *PetQuery) QueryFollowedBy() *UserQuery {
 	return query
 }
 
+// QueryVaccinations chains the current query on the "vaccinations" edge.
+func (_q *PetQuery) QueryVaccinations() *VaccinationQuery {
+	query := (&VaccinationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pet.Table, pet.FieldID, selector),
+			sqlgraph.To(vaccination.Table, vaccination.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pet.VaccinationsTable, pet.VaccinationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryFollowing chains the current query on the "following" edge.
 func (_q *PetQuery) QueryFollowing() *FollowsQuery {
 	query := (&FollowsClient{config: _q.config}).Query()
@@ -366,16 +390,17 @@ func (_q *PetQuery) Clone() *PetQuery {
 		return nil
 	}
 	return &PetQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]pet.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Pet{}, _q.predicates...),
-		withCategories: _q.withCategories.Clone(),
-		withOwner:      _q.withOwner.Clone(),
-		withFriends:    _q.withFriends.Clone(),
-		withFollowedBy: _q.withFollowedBy.Clone(),
-		withFollowing:  _q.withFollowing.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]pet.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Pet{}, _q.predicates...),
+		withCategories:   _q.withCategories.Clone(),
+		withOwner:        _q.withOwner.Clone(),
+		withFriends:      _q.withFriends.Clone(),
+		withFollowedBy:   _q.withFollowedBy.Clone(),
+		withVaccinations: _q.withVaccinations.Clone(),
+		withFollowing:    _q.withFollowing.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -426,6 +451,17 @@ func (_q *PetQuery) WithFollowedBy(opts ...func(*UserQuery)) *PetQuery {
 	return _q
 }
 
+// WithVaccinations tells the query-builder to eager-load the nodes that are connected to
+// the "vaccinations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PetQuery) WithVaccinations(opts ...func(*VaccinationQuery)) *PetQuery {
+	query := (&VaccinationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVaccinations = query
+	return _q
+}
+
 // WithFollowing tells the query-builder to eager-load the nodes that are connected to
 // the "following" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PetQuery) WithFollowing(opts ...func(*FollowsQuery)) *PetQuery {
@@ -516,11 +552,12 @@ func (_q *PetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pet, err
 		nodes       = []*Pet{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			_q.withCategories != nil,
 			_q.withOwner != nil,
 			_q.withFriends != nil,
 			_q.withFollowedBy != nil,
+			_q.withVaccinations != nil,
 			_q.withFollowing != nil,
 		}
 	)
@@ -575,6 +612,13 @@ func (_q *PetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pet, err
 			return nil, err
 		}
 	}
+	if query := _q.withVaccinations; query != nil {
+		if err := _q.loadVaccinations(ctx, query, nodes,
+			func(n *Pet) { n.Edges.Vaccinations = []*Vaccination{} },
+			func(n *Pet, e *Vaccination) { n.Edges.Vaccinations = append(n.Edges.Vaccinations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withFollowing; query != nil {
 		if err := _q.loadFollowing(ctx, query, nodes,
 			func(n *Pet) { n.Edges.Following = []*Follows{} },
@@ -800,6 +844,37 @@ func (_q *PetQuery) loadFollowedBy(ctx context.Context, query *UserQuery, nodes
 	}
 	return nil
 }
+func (_q *PetQuery) loadVaccinations(ctx context.Context, query *VaccinationQuery, nodes []*Pet, init func(*Pet), assign func(*Pet, *Vaccination)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Pet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Vaccination(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(pet.VaccinationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.pet_vaccinations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "pet_vaccinations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "pet_vaccinations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *PetQuery) loadFollowing(ctx context.Context, query *FollowsQuery, nodes []*Pet, init func(*Pet), assign func(*Pet, *Follows)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Pet)
@@ -16,6 +16,7 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/predicate"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 // PetUpdate is the builder for updating Pet entities.
@@ -182,6 +183,21 @@ func (_u *PetUpdate) AddFollowedBy(v ...*User) *PetUpdate {
 	return _u.AddFollowedByIDs(ids...)
 }
 
+// AddVaccinationIDs adds the "vaccinations" edge to the Vaccination entity by IDs.
+func (_u *PetUpdate) AddVaccinationIDs(ids ...int) *PetUpdate {
+	_u.mutation.AddVaccinationIDs(ids...)
+	return _u
+}
+
+// AddVaccinations adds the "vaccinations" edges to the Vaccination entity.
+func (_u *PetUpdate) AddVaccinations(v ...*Vaccination) *PetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVaccinationIDs(ids...)
+}
+
 // Mutation returns the PetMutation object of the builder.
 func (_u *PetUpdate) Mutation() *PetMutation {
 	return _u.mutation
@@ -256,6 +272,27 @@ func (_u *PetUpdate) RemoveFollowedBy(v ...*User) *PetUpdate {
 	return _u.RemoveFollowedByIDs(ids...)
 }
 
+// ClearVaccinations clears all "vaccinations" edges to the Vaccination entity.
+func (_u *PetUpdate) ClearVaccinations() *PetUpdate {
+	_u.mutation.ClearVaccinations()
+	return _u
+}
+
+// RemoveVaccinationIDs removes the "vaccinations" edge to Vaccination entities by IDs.
+func (_u *PetUpdate) RemoveVaccinationIDs(ids ...int) *PetUpdate {
+	_u.mutation.RemoveVaccinationIDs(ids...)
+	return _u
+}
+
+// RemoveVaccinations removes "vaccinations" edges to Vaccination entities.
+func (_u *PetUpdate) RemoveVaccinations(v ...*Vaccination) *PetUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVaccinationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PetUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -515,6 +552,51 @@ func (_u *PetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VaccinationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pet.VaccinationsTable,
+			Columns: []string{pet.VaccinationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVaccinationsIDs(); len(nodes) > 0 && !_u.mutation.VaccinationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pet.VaccinationsTable,
+			Columns: []string{pet.VaccinationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VaccinationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pet.VaccinationsTable,
+			Columns: []string{pet.VaccinationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pet.Label}
@@ -686,6 +768,21 @@ func (_u *PetUpdateOne) AddFollowedBy(v ...*User) *PetUpdateOne {
 	return _u.AddFollowedByIDs(ids...)
 }
 
+// AddVaccinationIDs adds the "vaccinations" edge to the Vaccination entity by IDs.
+func (_u *PetUpdateOne) AddVaccinationIDs(ids ...int) *PetUpdateOne {
+	_u.mutation.AddVaccinationIDs(ids...)
+	return _u
+}
+
+// AddVaccinations adds the "vaccinations" edges to the Vaccination entity.
+func (_u *PetUpdateOne) AddVaccinations(v ...*Vaccination) *PetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVaccinationIDs(ids...)
+}
+
 // Mutation returns the PetMutation object of the builder.
 func (_u *PetUpdateOne) Mutation() *PetMutation {
 	return _u.mutation
@@ -760,6 +857,27 @@ func (_u *PetUpdateOne) RemoveFollowedBy(v ...*User) *PetUpdateOne {
 	return _u.RemoveFollowedByIDs(ids...)
 }
 
+// ClearVaccinations clears all "vaccinations" edges to the Vaccination entity.
+func (_u *PetUpdateOne) ClearVaccinations() *PetUpdateOne {
+	_u.mutation.ClearVaccinations()
+	return _u
+}
+
+// RemoveVaccinationIDs removes the "vaccinations" edge to Vaccination entities by IDs.
+func (_u *PetUpdateOne) RemoveVaccinationIDs(ids ...int) *PetUpdateOne {
+	_u.mutation.RemoveVaccinationIDs(ids...)
+	return _u
+}
+
+// RemoveVaccinations removes "vaccinations" edges to Vaccination entities.
+func (_u *PetUpdateOne) RemoveVaccinations(v ...*Vaccination) *PetUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVaccinationIDs(ids...)
+}
+
 // Where appends a list predicates to the PetUpdate builder.
 func (_u *PetUpdateOne) Where(ps ...predicate.Pet) *PetUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1049,6 +1167,51 @@ func (_u *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
 		edge.Target.Fields = specE.Fields
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VaccinationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pet.VaccinationsTable,
+			Columns: []string{pet.VaccinationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVaccinationsIDs(); len(nodes) > 0 && !_u.mutation.VaccinationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pet.VaccinationsTable,
+			Columns: []string{pet.VaccinationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VaccinationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pet.VaccinationsTable,
+			Columns: []string{pet.VaccinationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Pet{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -29,3 +29,6 @@ type Skipped func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Vaccination is the predicate function for vaccination builders.
+type Vaccination func(*sql.Selector)
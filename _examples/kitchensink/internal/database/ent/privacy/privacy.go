@@ -301,3 +301,27 @@ func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	}
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
 }
+
+// The VaccinationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type VaccinationQueryRuleFunc func(context.Context, *ent.VaccinationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f VaccinationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VaccinationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.VaccinationQuery", q)
+}
+
+// The VaccinationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type VaccinationMutationRuleFunc func(context.Context, *ent.VaccinationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f VaccinationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.VaccinationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.VaccinationMutation", m)
+}
@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+)
+
+// Aggregated contains the parameters for aggregate requests, which select the fields to
+// group by, and the fields to aggregate. All can be provided as comma-separated values,
+// multiple times, or both.
+type Aggregated struct {
+	// GroupBy are the fields to group the results by. If not provided, a single row is
+	// returned for all matching entities.
+	GroupBy []string `json:"group_by,omitempty" form:"group_by,omitempty"`
+
+	Sum []string `json:"sum,omitempty" form:"sum,omitempty"` // Fields to calculate the sum of.
+	Avg []string `json:"avg,omitempty" form:"avg,omitempty"` // Fields to calculate the average of.
+	Min []string `json:"min,omitempty" form:"min,omitempty"` // Fields to calculate the minimum value of.
+	Max []string `json:"max,omitempty" form:"max,omitempty"` // Fields to calculate the maximum value of.
+}
+
+// groupBy validates the requested group by fields, returning their columns. columns maps
+// the fields which can be grouped by to their column.
+func (a *Aggregated) groupBy(columns map[string]string) (groupBy []string, err error) {
+	for _, field := range splitQueryValues(a.GroupBy) {
+		column, ok := columns[field]
+		if !ok {
+			return nil, &ErrBadRequest{Err: fmt.Errorf("invalid group_by field: %s", field)}
+		}
+		groupBy = append(groupBy, column)
+	}
+	return groupBy, nil
+}
+
+// funcs validates the requested aggregates, returning their functions. The number of
+// entities in each group is always included. supported maps the aggregates which can be
+// requested (as "<func>:<field>") to their function.
+func (a *Aggregated) funcs(supported map[string]ent.AggregateFunc) ([]ent.AggregateFunc, error) {
+	fns := []ent.AggregateFunc{ent.As(ent.Count(), "count")}
+
+	for _, requested := range []struct {
+		name   string
+		fields []string
+	}{
+		{"sum", a.Sum},
+		{"avg", a.Avg},
+		{"min", a.Min},
+		{"max", a.Max},
+	} {
+		for _, field := range splitQueryValues(requested.fields) {
+			fn, ok := supported[requested.name+":"+field]
+			if !ok {
+				return nil, &ErrBadRequest{Err: fmt.Errorf("invalid %s field: %s", requested.name, field)}
+			}
+			fns = append(fns, fn)
+		}
+	}
+	return fns, nil
+}
+
+// PetAggregateRow is a single row of results for aggregate requests on Pets.
+// Fields which weren't grouped by or aggregated are omitted. Grouped fields are scanned
+// from their column, which may differ from the name of the field.
+type PetAggregateRow struct {
+	Type   *pet.Type `json:"type,omitempty" sql:"pet_type"`
+	Count  int       `json:"count"` // The number of entities in the group.
+	AgeSum *int      `json:"age_sum,omitempty"`
+	AgeAvg *float64  `json:"age_avg,omitempty"`
+	AgeMin *int      `json:"age_min,omitempty"`
+	AgeMax *int      `json:"age_max,omitempty"`
+}
+
+var (
+	// petAggregateGroupBy maps the fields which Pets can be grouped by, to their column.
+	petAggregateGroupBy = map[string]string{
+		"type": pet.FieldType,
+	}
+
+	// petAggregateFuncs maps the aggregates which can be requested for Pets, to their function.
+	petAggregateFuncs = map[string]ent.AggregateFunc{
+		"sum:age": ent.As(ent.Sum(pet.FieldAge), "age_sum"),
+		"avg:age": ent.As(ent.Mean(pet.FieldAge), "age_avg"),
+		"min:age": ent.As(ent.Min(pet.FieldAge), "age_min"),
+		"max:age": ent.As(ent.Max(pet.FieldAge), "age_max"),
+	}
+)
+
+// AggregatePetParams defines parameters for aggregating Pets via a GET
+// request, using the same filters as [ListPetParams]. Sorting, pagination
+// and other parameters which only apply to listing aren't supported.
+type AggregatePetParams struct {
+	FilterPetParams
+	Aggregated
+}
+
+// Exec applies the filters (if any), and returns the aggregated values of the matching
+// Pets, with one row per group (ordered by the grouped fields).
+func (p *AggregatePetParams) Exec(ctx context.Context, query *ent.PetQuery) (*[]PetAggregateRow, error) {
+	predicates, err := p.FilterPredicates()
+	if err != nil {
+		return nil, err
+	}
+	query.Where(predicates)
+
+	groupBy, err := p.groupBy(petAggregateGroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	fns, err := p.funcs(petAggregateFuncs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []PetAggregateRow{}
+	if len(groupBy) == 0 {
+		err = query.Aggregate(fns...).Scan(ctx, &rows)
+	} else {
+		err = query.Order(ent.Asc(groupBy...)).GroupBy(groupBy[0], groupBy[1:]...).Aggregate(fns...).Scan(ctx, &rows)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rows, nil
+}
@@ -4,6 +4,9 @@ package rest
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	github "github.com/google/go-github/v66/github"
@@ -16,6 +19,7 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/post"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 	schema "github.com/lrstanley/entrest/_examples/kitchensink/internal/database/schema"
 )
 
@@ -41,6 +45,12 @@ func (c *CreateCategoryParams) ApplyInputs(builder *ent.CategoryCreate) *ent.Cat
 	return builder
 }
 
+// Validate runs the validators defined in the Category schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreateCategoryParams) Validate() error {
+	return nil
+}
+
 // Exec wraps all logic (mapping all provided values to the builder), creates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
 // loaded edges.
@@ -64,6 +74,12 @@ func (c *CreateFollowParams) ApplyInputs(builder *ent.FollowsCreate) *ent.Follow
 	return builder
 }
 
+// Validate runs the validators defined in the Follow schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreateFollowParams) Validate() error {
+	return nil
+}
+
 // Exec wraps all logic (mapping all provided values to the builder), creates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
 // loaded edges.
@@ -95,6 +111,12 @@ func (c *CreateFriendshipParams) ApplyInputs(builder *ent.FriendshipCreate) *ent
 	return builder
 }
 
+// Validate runs the validators defined in the Friendship schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreateFriendshipParams) Validate() error {
+	return nil
+}
+
 // Exec wraps all logic (mapping all provided values to the builder), creates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
 // loaded edges.
@@ -122,6 +144,8 @@ type CreatePetParams struct {
 	Friends []int `json:"friends,omitempty"`
 	// Users that this pet is followed by.
 	FollowedBy []uuid.UUID `json:"followed_by,omitempty"`
+	// Vaccinations the pet has received.
+	Vaccinations []int `json:"vaccinations,omitempty"`
 }
 
 func (c *CreatePetParams) ApplyInputs(builder *ent.PetCreate) *ent.PetCreate {
@@ -140,9 +164,22 @@ func (c *CreatePetParams) ApplyInputs(builder *ent.PetCreate) *ent.PetCreate {
 	}
 	builder.AddFriendIDs(c.Friends...)
 	builder.AddFollowedByIDs(c.FollowedBy...)
+	builder.AddVaccinationIDs(c.Vaccinations...)
 	return builder
 }
 
+// Validate runs the validators defined in the Pet schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreatePetParams) Validate() error {
+	if err := pet.AgeValidator(c.Age); err != nil {
+		return fmt.Errorf("validator failed for field %q: %w", "age", err)
+	}
+	if err := pet.TypeValidator(c.Type); err != nil {
+		return fmt.Errorf("validator failed for field %q: %w", "type", err)
+	}
+	return nil
+}
+
 // Exec wraps all logic (mapping all provided values to the builder), creates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
 // loaded edges.
@@ -154,6 +191,88 @@ func (c *CreatePetParams) Exec(ctx context.Context, builder *ent.PetCreate, quer
 	return EagerLoadPet(query.Where(pet.ID(result.ID))).Only(ctx)
 }
 
+// CreatePetVaccinationParams defines parameters for creating a Vaccination through the
+// vaccinations edge of a Pet via a POST request. The pet edge
+// is set from the path, so it isn't accepted in the request body.
+type CreatePetVaccinationParams struct {
+	// Name of the vaccine.
+	Name string `json:"name"`
+	// When the vaccine was administered.
+	AdministeredAt *time.Time `json:"administered_at"`
+}
+
+func (c *CreatePetVaccinationParams) ApplyInputs(builder *ent.VaccinationCreate) *ent.VaccinationCreate {
+	builder.SetName(c.Name)
+	if c.AdministeredAt != nil {
+		builder.SetAdministeredAt(*c.AdministeredAt)
+	}
+	return builder
+}
+
+// BulkCreatePetParams defines parameters for creating multiple Pet entities
+// via a single POST request.
+type BulkCreatePetParams []*CreatePetParams
+
+// Exec validates all provided entities (reporting the index of each invalid entity),
+// creates them in a single transaction, and does another query to get the entities,
+// with all eager loaded edges. Entities are returned in the same order as provided.
+func (c BulkCreatePetParams) Exec(ctx context.Context, db *ent.Client) ([]*ent.Pet, error) {
+	if len(c) == 0 {
+		return nil, &ErrBadRequest{Err: errors.New("no entities provided")}
+	}
+
+	var errs []error
+	for i, params := range c {
+		if params == nil {
+			errs = append(errs, fmt.Errorf("item %d: entity cannot be null", i))
+			continue
+		}
+		if err := params.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, &ErrBadRequest{Err: errors.Join(errs...)}
+	}
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builders := make([]*ent.PetCreate, len(c))
+	for i, params := range c {
+		builders[i] = params.ApplyInputs(tx.Pet.Create())
+	}
+
+	created, err := tx.Pet.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(created))
+	for i, result := range created {
+		ids[i] = result.ID
+	}
+
+	results, err := EagerLoadPet(db.Pet.Query().Where(pet.IDIn(ids...))).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[int]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+	slices.SortFunc(results, func(a, b *ent.Pet) int {
+		return index[a.ID] - index[b.ID]
+	})
+	return results, nil
+}
+
 // CreatePostParams defines parameters for creating a Post via a POST request.
 type CreatePostParams struct {
 	Title string `json:"title"`
@@ -168,6 +287,18 @@ func (c *CreatePostParams) ApplyInputs(builder *ent.PostCreate) *ent.PostCreate
 	return builder
 }
 
+// Validate runs the validators defined in the Post schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreatePostParams) Validate() error {
+	if err := post.TitleValidator(c.Title); err != nil {
+		return fmt.Errorf("validator failed for field %q: %w", "title", err)
+	}
+	if err := post.BodyValidator(c.Body); err != nil {
+		return fmt.Errorf("validator failed for field %q: %w", "body", err)
+	}
+	return nil
+}
+
 // Exec wraps all logic (mapping all provided values to the builder), creates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
 // loaded edges.
@@ -195,6 +326,17 @@ func (c *CreateSettingParams) ApplyInputs(builder *ent.SettingsCreate) *ent.Sett
 	return builder
 }
 
+// Validate runs the validators defined in the Setting schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreateSettingParams) Validate() error {
+	if c.GlobalBanner != nil {
+		if err := settings.GlobalBannerValidator(*c.GlobalBanner); err != nil {
+			return fmt.Errorf("validator failed for field %q: %w", "global_banner", err)
+		}
+	}
+	return nil
+}
+
 // Exec wraps all logic (mapping all provided values to the builder), creates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
 // loaded edges.
@@ -280,6 +422,35 @@ func (c *CreateUserParams) ApplyInputs(builder *ent.UserCreate) *ent.UserCreate
 	return builder
 }
 
+// Validate runs the validators defined in the User schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreateUserParams) Validate() error {
+	if c.Type != nil {
+		if err := user.TypeValidator(*c.Type); err != nil {
+			return fmt.Errorf("validator failed for field %q: %w", "type", err)
+		}
+	}
+	if c.Description != nil {
+		if err := user.DescriptionValidator(*c.Description); err != nil {
+			return fmt.Errorf("validator failed for field %q: %w", "description", err)
+		}
+	}
+	if c.Email != nil {
+		if err := user.EmailValidator(*c.Email); err != nil {
+			return fmt.Errorf("validator failed for field %q: %w", "email", err)
+		}
+	}
+	if c.Avatar != nil {
+		if err := user.AvatarValidator(c.Avatar); err != nil {
+			return fmt.Errorf("validator failed for field %q: %w", "avatar", err)
+		}
+	}
+	if err := user.PasswordHashedValidator(c.PasswordHashed); err != nil {
+		return fmt.Errorf("validator failed for field %q: %w", "password_hashed", err)
+	}
+	return nil
+}
+
 // Exec wraps all logic (mapping all provided values to the builder), creates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
 // loaded edges.
@@ -290,3 +461,111 @@ func (c *CreateUserParams) Exec(ctx context.Context, builder *ent.UserCreate, qu
 	}
 	return EagerLoadUser(query.Where(user.ID(result.ID))).Only(ctx)
 }
+
+// CreateUserPetParams defines parameters for creating a Pet through the
+// pets edge of a User via a POST request. The owner edge
+// is set from the path, so it isn't accepted in the request body.
+type CreateUserPetParams struct {
+	Name      string   `json:"name"`
+	Nicknames []string `json:"nicknames,omitempty"`
+	// Optional description of the pet.
+	Description *string  `json:"description,omitempty"`
+	Age         int      `json:"age"`
+	Type        pet.Type `json:"type"`
+	// Categories that the pet belongs to.
+	Categories []int `json:"categories,omitempty"`
+	// Pets that this pet is friends with.
+	Friends []int `json:"friends,omitempty"`
+	// Users that this pet is followed by.
+	FollowedBy []uuid.UUID `json:"followed_by,omitempty"`
+	// Vaccinations the pet has received.
+	Vaccinations []int `json:"vaccinations,omitempty"`
+}
+
+func (c *CreateUserPetParams) ApplyInputs(builder *ent.PetCreate) *ent.PetCreate {
+	builder.SetName(c.Name)
+	if c.Nicknames != nil {
+		builder.SetNicknames(c.Nicknames)
+	}
+	if c.Description != nil {
+		builder.SetDescription(*c.Description)
+	}
+	builder.SetAge(c.Age)
+	builder.SetType(c.Type)
+	builder.AddCategoryIDs(c.Categories...)
+	builder.AddFriendIDs(c.Friends...)
+	builder.AddFollowedByIDs(c.FollowedBy...)
+	builder.AddVaccinationIDs(c.Vaccinations...)
+	return builder
+}
+
+// CreateUserPostParams defines parameters for creating a Post through the
+// posts edge of a User via a POST request. The author edge
+// is set from the path, so it isn't accepted in the request body.
+type CreateUserPostParams struct {
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+	Body  string `json:"body"`
+}
+
+func (c *CreateUserPostParams) ApplyInputs(builder *ent.PostCreate) *ent.PostCreate {
+	builder.SetTitle(c.Title)
+	builder.SetSlug(c.Slug)
+	builder.SetBody(c.Body)
+	return builder
+}
+
+// CreateUserFriendshipParams defines parameters for creating a Friendship through the
+// friendships edge of a User via a POST request. The user edge
+// is set from the path, so it isn't accepted in the request body.
+type CreateUserFriendshipParams struct {
+	CreatedAt *time.Time `json:"created_at"`
+	FriendID  uuid.UUID  `json:"friend_id"`
+}
+
+func (c *CreateUserFriendshipParams) ApplyInputs(builder *ent.FriendshipCreate) *ent.FriendshipCreate {
+	if c.CreatedAt != nil {
+		builder.SetCreatedAt(*c.CreatedAt)
+	}
+	builder.SetFriendID(c.FriendID)
+	return builder
+}
+
+// CreateVaccinationParams defines parameters for creating a Vaccination via a POST request.
+type CreateVaccinationParams struct {
+	// Name of the vaccine.
+	Name string `json:"name"`
+	// When the vaccine was administered.
+	AdministeredAt *time.Time `json:"administered_at"`
+	// The pet that was vaccinated.
+	Pet int `json:"pet"`
+}
+
+func (c *CreateVaccinationParams) ApplyInputs(builder *ent.VaccinationCreate) *ent.VaccinationCreate {
+	builder.SetName(c.Name)
+	if c.AdministeredAt != nil {
+		builder.SetAdministeredAt(*c.AdministeredAt)
+	}
+	builder.SetPetID(c.Pet)
+	return builder
+}
+
+// Validate runs the validators defined in the Vaccination schema against the provided
+// values, without creating the entity. Ent runs the same validators when saving.
+func (c *CreateVaccinationParams) Validate() error {
+	if err := vaccination.NameValidator(c.Name); err != nil {
+		return fmt.Errorf("validator failed for field %q: %w", "name", err)
+	}
+	return nil
+}
+
+// Exec wraps all logic (mapping all provided values to the builder), creates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges.
+func (c *CreateVaccinationParams) Exec(ctx context.Context, builder *ent.VaccinationCreate, query *ent.VaccinationQuery) (*ent.Vaccination, error) {
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadVaccination(query.Where(vaccination.ID(result.ID))).Only(ctx)
+}
@@ -0,0 +1,89 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+)
+
+// DistinctResponse is the JSON response structure for distinct value queries.
+type DistinctResponse[T any] struct {
+	Page       int  `json:"page"`         // Current page number.
+	IsLastPage bool `json:"is_last_page"` // Whether this is the last page.
+	HasMore    bool `json:"has_more"`     // Whether there are more values after this page.
+	Content    []T  `json:"content"`      // Paged values.
+}
+
+// distinctPage returns the page number and the number of values per page of the requested
+// page of distinct values, using the defaults and bounds of the provided page configuration.
+func distinctPage(page, itemsPerPage *int, pageConfig *PageConfig) (int, int, error) {
+	if page == nil {
+		page = &firstPage
+	}
+
+	if itemsPerPage == nil {
+		itemsPerPage = &pageConfig.ItemsPerPage
+	}
+
+	if err := validateItemsPerPage(*itemsPerPage, pageConfig); err != nil {
+		return 0, 0, err
+	}
+
+	if *page < 1 {
+		return 0, 0, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, must be >= 1", *page)}
+	}
+	return *page, *itemsPerPage, nil
+}
+
+// newDistinctResponse returns the requested page of distinct values, where values contains
+// up to one additional value (beyond itemsPerPage), to determine if there are more values.
+func newDistinctResponse[T any](values []T, page, itemsPerPage int) *DistinctResponse[T] {
+	resp := &DistinctResponse[T]{Page: page, HasMore: len(values) > itemsPerPage}
+	if resp.HasMore {
+		values = values[:itemsPerPage]
+	}
+	resp.IsLastPage = !resp.HasMore
+	resp.Content = values
+	return resp
+}
+
+// DistinctPetParams defines parameters for listing the distinct values of Pet
+// fields via a GET request, using the same filters as [ListPetParams].
+type DistinctPetParams struct {
+	FilterPetParams
+
+	Page         *int `json:"page"     form:"page,omitempty"`
+	ItemsPerPage *int `json:"per_page" form:"per_page,omitempty"`
+}
+
+// ExecType applies the filters (if any), and returns the requested page of the
+// distinct values of the "type" field, sorted in ascending order. Pages are
+// bounded by the page configuration of Pet.
+func (p *DistinctPetParams) ExecType(ctx context.Context, query *ent.PetQuery) (*DistinctResponse[pet.Type], error) {
+	predicates, err := p.FilterPredicates()
+	if err != nil {
+		return nil, err
+	}
+	query.Where(predicates)
+
+	page, itemsPerPage, err := distinctPage(p.Page, p.ItemsPerPage, PetPageConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []pet.Type{}
+	err = query.Unique(true).
+		Order(ent.Asc(pet.FieldType)).
+		Limit(itemsPerPage+1).
+		Offset((page-1)*itemsPerPage).
+		Select(pet.FieldType).
+		Scan(ctx, &values)
+	if err != nil {
+		return nil, err
+	}
+	return newDistinctResponse(values, page, itemsPerPage), nil
+}
@@ -3,9 +3,45 @@
 package rest
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
 )
 
+// splitQueryValues returns the unique values of a query parameter which accepts a list
+// (e.g. "expand" or "fields"), which can be provided as comma-separated values, multiple
+// times, or both.
+func splitQueryValues(values []string) (split []string) {
+	for _, v := range values {
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p != "" && !slices.Contains(split, p) {
+				split = append(split, p)
+			}
+		}
+	}
+	return split
+}
+
+// expandTree groups the provided (dotted) edge paths by their first edge, returning the
+// remaining nested paths for each edge (if any).
+func expandTree(paths []string) map[string][]string {
+	tree := make(map[string][]string)
+	for _, p := range paths {
+		edge, nested, _ := strings.Cut(p, ".")
+		if _, ok := tree[edge]; !ok {
+			tree[edge] = nil
+		}
+		if nested != "" {
+			tree[edge] = append(tree[edge], nested)
+		}
+	}
+	return tree
+}
+
 // EagerLoadCategory eager-loads the edges of a Category entity, if any edges
 // were requested to be eager-loaded, based off associated annotations.
 func EagerLoadCategory(query *ent.CategoryQuery) *ent.CategoryQuery {
@@ -17,11 +53,11 @@ func EagerLoadCategory(query *ent.CategoryQuery) *ent.CategoryQuery {
 func EagerLoadFollow(query *ent.FollowsQuery) *ent.FollowsQuery {
 	return query.WithUser(
 		func(e *ent.UserQuery) {
-			applySortingUser(e, "name", "asc")
+			applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
 		},
 	).WithPet(
 		func(e *ent.PetQuery) {
-			applySortingPet(e, "name", "asc")
+			applySortingPet(e, "name", "asc", PetSortConfig.NullableFields["name"])
 		},
 	)
 }
@@ -37,22 +73,77 @@ func EagerLoadFriendship(query *ent.FriendshipQuery) *ent.FriendshipQuery {
 func EagerLoadPet(query *ent.PetQuery) *ent.PetQuery {
 	return query.WithCategories(
 		func(e *ent.CategoryQuery) {
-			applySortingCategory(e, "id", "asc")
+			applySortingCategory(e, "id", "asc", CategorySortConfig.NullableFields["id"])
 			e.Limit(1000)
 		},
 	).WithOwner(
 		func(e *ent.UserQuery) {
-			applySortingUser(e, "name", "asc")
+			applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
 		},
 	)
 }
 
+// PetExpandablePaths are all edge paths which can be requested for a Pet
+// entity, using the "expand" query parameter.
+var PetExpandablePaths = []string{"categories", "friends", "followed_by", "followed_by.pets", "followed_by.posts", "following"}
+
+// ExpandPet eager-loads the edges of a Pet entity which were requested
+// by the client, using the "expand" query parameter. Only edge paths in
+// [PetExpandablePaths] can be requested.
+func ExpandPet(query *ent.PetQuery, expand []string) (*ent.PetQuery, error) {
+	paths := splitQueryValues(expand)
+	for _, p := range paths {
+		if !slices.Contains(PetExpandablePaths, p) {
+			return nil, &ErrBadRequest{Err: fmt.Errorf("edge %q cannot be expanded", p)}
+		}
+	}
+	expandPet(query, paths)
+	return query, nil
+}
+
+// expandPet eager-loads the provided (already validated) edge paths.
+func expandPet(query *ent.PetQuery, paths []string) {
+	for edge, nested := range expandTree(paths) {
+		switch edge {
+		case pet.EdgeCategories:
+			query.WithCategories(
+				func(e *ent.CategoryQuery) {
+					applySortingCategory(e, "id", "asc", CategorySortConfig.NullableFields["id"])
+					e.Limit(1000)
+				},
+			)
+		case pet.EdgeFriends:
+			query.WithFriends(
+				func(e *ent.PetQuery) {
+					applySortingPet(e, "name", "asc", PetSortConfig.NullableFields["name"])
+					e.Limit(1000)
+					expandPet(e, nested)
+				},
+			)
+		case pet.EdgeFollowedBy:
+			query.WithFollowedBy(
+				func(e *ent.UserQuery) {
+					applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
+					e.Limit(1000)
+					expandUser(e, nested)
+				},
+			)
+		case pet.EdgeFollowing:
+			query.WithFollowing(
+				func(e *ent.FollowsQuery) {
+					e.Limit(1000)
+				},
+			)
+		}
+	}
+}
+
 // EagerLoadPost eager-loads the edges of a Post entity, if any edges
 // were requested to be eager-loaded, based off associated annotations.
 func EagerLoadPost(query *ent.PostQuery) *ent.PostQuery {
 	return query.WithAuthor(
 		func(e *ent.UserQuery) {
-			applySortingUser(e, "name", "asc")
+			applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
 		},
 	)
 }
@@ -62,7 +153,7 @@ func EagerLoadPost(query *ent.PostQuery) *ent.PostQuery {
 func EagerLoadSetting(query *ent.SettingsQuery) *ent.SettingsQuery {
 	return query.WithAdmins(
 		func(e *ent.UserQuery) {
-			applySortingUser(e, "name", "asc")
+			applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
 			e.Limit(1000)
 		},
 	)
@@ -73,7 +164,53 @@ func EagerLoadSetting(query *ent.SettingsQuery) *ent.SettingsQuery {
 func EagerLoadUser(query *ent.UserQuery) *ent.UserQuery {
 	return query.WithPets(
 		func(e *ent.PetQuery) {
-			applySortingPet(e, "name", "asc")
+			applySortingPet(e, "name", "asc", PetSortConfig.NullableFields["name"])
 		},
 	)
 }
+
+// UserExpandablePaths are all edge paths which can be requested for a User
+// entity, using the "expand" query parameter.
+var UserExpandablePaths = []string{"pets", "pets.categories", "pets.friends", "pets.followed_by", "pets.following", "posts"}
+
+// ExpandUser eager-loads the edges of a User entity which were requested
+// by the client, using the "expand" query parameter. Only edge paths in
+// [UserExpandablePaths] can be requested.
+func ExpandUser(query *ent.UserQuery, expand []string) (*ent.UserQuery, error) {
+	paths := splitQueryValues(expand)
+	for _, p := range paths {
+		if !slices.Contains(UserExpandablePaths, p) {
+			return nil, &ErrBadRequest{Err: fmt.Errorf("edge %q cannot be expanded", p)}
+		}
+	}
+	expandUser(query, paths)
+	return query, nil
+}
+
+// expandUser eager-loads the provided (already validated) edge paths.
+func expandUser(query *ent.UserQuery, paths []string) {
+	for edge, nested := range expandTree(paths) {
+		switch edge {
+		case user.EdgePets:
+			query.WithPets(
+				func(e *ent.PetQuery) {
+					applySortingPet(e, "name", "asc", PetSortConfig.NullableFields["name"])
+					expandPet(e, nested)
+				},
+			)
+		case user.EdgePosts:
+			query.WithPosts(
+				func(e *ent.PostQuery) {
+					applySortingPost(e, "id", "asc", PostSortConfig.NullableFields["id"])
+					e.Limit(1000)
+				},
+			)
+		}
+	}
+}
+
+// EagerLoadVaccination eager-loads the edges of a Vaccination entity, if any edges
+// were requested to be eager-loaded, based off associated annotations.
+func EagerLoadVaccination(query *ent.VaccinationQuery) *ent.VaccinationQuery {
+	return query
+}
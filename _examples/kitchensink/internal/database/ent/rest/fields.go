@@ -0,0 +1,497 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/friendship"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/post"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
+)
+
+// FieldSelection contains the fields requested by the client, using the "fields" query
+// parameter for the entity itself, and "fields[<edge>]" for eager-loaded (or expanded)
+// edges. If no fields are requested, all fields are returned.
+type FieldSelection struct {
+	Fields []string            // Fields of the entity.
+	Edges  map[string][]string // Fields of edges, keyed by edge name.
+}
+
+// ParseFieldSelection parses the "fields" and "fields[<edge>]" query parameters from the
+// provided query values. Returns nil if no fields were requested.
+func ParseFieldSelection(query url.Values) *FieldSelection {
+	fs := &FieldSelection{}
+
+	for k, v := range query {
+		if k == "fields" {
+			fs.Fields = splitQueryValues(v)
+			continue
+		}
+
+		edge, ok := strings.CutPrefix(k, "fields[")
+		if !ok {
+			continue
+		}
+		if edge, ok = strings.CutSuffix(edge, "]"); !ok || edge == "" {
+			continue
+		}
+		if fields := splitQueryValues(v); len(fields) > 0 {
+			if fs.Edges == nil {
+				fs.Edges = make(map[string][]string)
+			}
+			fs.Edges[edge] = fields
+		}
+	}
+
+	if len(fs.Fields) == 0 && len(fs.Edges) == 0 {
+		return nil
+	}
+	return fs
+}
+
+// selectColumns returns the columns associated with the provided fields, using the
+// field-to-column mapping of an entity.
+func selectColumns(selectable map[string]string, fields []string) ([]string, error) {
+	columns := make([]string, 0, len(fields))
+	for _, f := range fields {
+		column, ok := selectable[f]
+		if !ok {
+			return nil, &ErrBadRequest{Err: fmt.Errorf("invalid field: %s", f)}
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// trim removes all fields which weren't requested from the JSON representation of the
+// provided response. If list is true, the response is expected to either be a list of
+// entities, or an object with the entities in the "content" field (e.g. when paginated).
+func (fs *FieldSelection) trim(resp any, list bool) (any, error) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err = dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	if obj, ok := v.(map[string]any); ok && list {
+		fs.trimEntities(obj["content"])
+	} else {
+		fs.trimEntities(v)
+	}
+	return v, nil
+}
+
+// trimEntities trims the provided entity, or list of entities, and their edges.
+func (fs *FieldSelection) trimEntities(v any) {
+	if entities, ok := v.([]any); ok {
+		for _, e := range entities {
+			fs.trimEntities(e)
+		}
+		return
+	}
+
+	entity, ok := v.(map[string]any)
+	if !ok {
+		return
+	}
+
+	if len(fs.Fields) > 0 {
+		trimFields(entity, fs.Fields)
+	}
+
+	if edges, ok := entity["edges"].(map[string]any); ok {
+		for edge, fields := range fs.Edges {
+			trimFields(edges[edge], fields)
+		}
+	}
+}
+
+// trimFields removes all keys (except for the ID and edges) from the provided entity,
+// or list of entities, which aren't in fields.
+func trimFields(v any, fields []string) {
+	switch v := v.(type) {
+	case []any:
+		for _, e := range v {
+			trimFields(e, fields)
+		}
+	case map[string]any:
+		for k := range v {
+			if k != "id" && k != "edges" && !slices.Contains(fields, k) {
+				delete(v, k)
+			}
+		}
+	}
+}
+
+// CategorySelectableFields maps the fields which can be requested for a Category
+// entity, using the "fields" query parameter, to their associated columns.
+var CategorySelectableFields = map[string]string{
+	"id":         category.FieldID,
+	"created_at": category.FieldCreatedAt,
+	"updated_at": category.FieldUpdatedAt,
+	"name":       category.FieldName,
+	"readonly":   category.FieldReadonly,
+	"nillable":   category.FieldNillable,
+	"strings":    category.FieldStrings,
+	"ints":       category.FieldInts,
+}
+
+// SelectCategory limits the columns which are queried for a Category entity (and
+// its edges) to the fields requested by the client. Edges with selected fields are
+// loaded again, so this must be called after [EagerLoadCategory].
+func SelectCategory(query *ent.CategoryQuery, fs *FieldSelection) (*ent.CategoryQuery, error) {
+	if fs == nil {
+		return query, nil
+	}
+
+	columns, err := selectColumns(CategorySelectableFields, fs.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		query.Select(columns...)
+	}
+	if len(fs.Edges) > 0 {
+		return nil, &ErrBadRequest{Err: errors.New("fields cannot be selected for any edges")}
+	}
+	return query, nil
+}
+
+// FriendshipSelectableFields maps the fields which can be requested for a Friendship
+// entity, using the "fields" query parameter, to their associated columns.
+var FriendshipSelectableFields = map[string]string{
+	"id":         friendship.FieldID,
+	"created_at": friendship.FieldCreatedAt,
+	"user_id":    friendship.FieldUserID,
+	"friend_id":  friendship.FieldFriendID,
+}
+
+// SelectFriendship limits the columns which are queried for a Friendship entity (and
+// its edges) to the fields requested by the client. Edges with selected fields are
+// loaded again, so this must be called after [EagerLoadFriendship].
+func SelectFriendship(query *ent.FriendshipQuery, fs *FieldSelection) (*ent.FriendshipQuery, error) {
+	if fs == nil {
+		return query, nil
+	}
+
+	columns, err := selectColumns(FriendshipSelectableFields, fs.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		query.Select(columns...)
+	}
+	if len(fs.Edges) > 0 {
+		return nil, &ErrBadRequest{Err: errors.New("fields cannot be selected for any edges")}
+	}
+	return query, nil
+}
+
+// PetSelectableFields maps the fields which can be requested for a Pet
+// entity, using the "fields" query parameter, to their associated columns.
+var PetSelectableFields = map[string]string{
+	"id":          pet.FieldID,
+	"name":        pet.FieldName,
+	"nicknames":   pet.FieldNicknames,
+	"description": pet.FieldDescription,
+	"age":         pet.FieldAge,
+	"type":        pet.FieldType,
+}
+
+// SelectPet limits the columns which are queried for a Pet entity (and
+// its edges) to the fields requested by the client. Edges with selected fields are
+// loaded again, so this must be called after [EagerLoadPet] and
+// [ExpandPet], with the same expand values.
+func SelectPet(query *ent.PetQuery, fs *FieldSelection, expand []string) (*ent.PetQuery, error) {
+	if fs == nil {
+		return query, nil
+	}
+
+	columns, err := selectColumns(PetSelectableFields, fs.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		query.Select(columns...)
+	}
+	tree := expandTree(splitQueryValues(expand))
+
+	for edge, fields := range fs.Edges {
+		switch edge {
+		case pet.EdgeCategories:
+			columns, err := selectColumns(CategorySelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithCategories(
+				func(e *ent.CategoryQuery) {
+					applySortingCategory(e, "id", "asc", CategorySortConfig.NullableFields["id"])
+					e.Limit(1000)
+					e.Select(columns...)
+				},
+			)
+		case pet.EdgeOwner:
+			columns, err := selectColumns(UserSelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithOwner(
+				func(e *ent.UserQuery) {
+					applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
+					e.Select(columns...)
+				},
+			)
+		case pet.EdgeFriends:
+			nested, ok := tree[edge]
+			if !ok {
+				return nil, &ErrBadRequest{Err: fmt.Errorf("edge %q must be expanded to select its fields", edge)}
+			}
+			columns, err := selectColumns(PetSelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithFriends(
+				func(e *ent.PetQuery) {
+					applySortingPet(e, "name", "asc", PetSortConfig.NullableFields["name"])
+					e.Limit(1000)
+					expandPet(e, nested)
+					e.Select(columns...)
+				},
+			)
+		case pet.EdgeFollowedBy:
+			nested, ok := tree[edge]
+			if !ok {
+				return nil, &ErrBadRequest{Err: fmt.Errorf("edge %q must be expanded to select its fields", edge)}
+			}
+			columns, err := selectColumns(UserSelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithFollowedBy(
+				func(e *ent.UserQuery) {
+					applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
+					e.Limit(1000)
+					expandUser(e, nested)
+					e.Select(columns...)
+				},
+			)
+		default:
+			return nil, &ErrBadRequest{Err: fmt.Errorf("fields cannot be selected for edge %q", edge)}
+		}
+	}
+	return query, nil
+}
+
+// PostSelectableFields maps the fields which can be requested for a Post
+// entity, using the "fields" query parameter, to their associated columns.
+var PostSelectableFields = map[string]string{
+	"id":         post.FieldID,
+	"created_at": post.FieldCreatedAt,
+	"updated_at": post.FieldUpdatedAt,
+	"title":      post.FieldTitle,
+	"slug":       post.FieldSlug,
+	"body":       post.FieldBody,
+}
+
+// SelectPost limits the columns which are queried for a Post entity (and
+// its edges) to the fields requested by the client. Edges with selected fields are
+// loaded again, so this must be called after [EagerLoadPost].
+func SelectPost(query *ent.PostQuery, fs *FieldSelection) (*ent.PostQuery, error) {
+	if fs == nil {
+		return query, nil
+	}
+
+	columns, err := selectColumns(PostSelectableFields, fs.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		query.Select(columns...)
+	}
+
+	for edge, fields := range fs.Edges {
+		switch edge {
+		case post.EdgeAuthor:
+			columns, err := selectColumns(UserSelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithAuthor(
+				func(e *ent.UserQuery) {
+					applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
+					e.Select(columns...)
+				},
+			)
+		default:
+			return nil, &ErrBadRequest{Err: fmt.Errorf("fields cannot be selected for edge %q", edge)}
+		}
+	}
+	return query, nil
+}
+
+// SettingSelectableFields maps the fields which can be requested for a Setting
+// entity, using the "fields" query parameter, to their associated columns.
+var SettingSelectableFields = map[string]string{
+	"id":            settings.FieldID,
+	"created_at":    settings.FieldCreatedAt,
+	"updated_at":    settings.FieldUpdatedAt,
+	"global_banner": settings.FieldGlobalBanner,
+}
+
+// SelectSetting limits the columns which are queried for a Setting entity (and
+// its edges) to the fields requested by the client. Edges with selected fields are
+// loaded again, so this must be called after [EagerLoadSetting].
+func SelectSetting(query *ent.SettingsQuery, fs *FieldSelection) (*ent.SettingsQuery, error) {
+	if fs == nil {
+		return query, nil
+	}
+
+	columns, err := selectColumns(SettingSelectableFields, fs.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		query.Select(columns...)
+	}
+
+	for edge, fields := range fs.Edges {
+		switch edge {
+		case settings.EdgeAdmins:
+			columns, err := selectColumns(UserSelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithAdmins(
+				func(e *ent.UserQuery) {
+					applySortingUser(e, "name", "asc", UserSortConfig.NullableFields["name"])
+					e.Limit(1000)
+					e.Select(columns...)
+				},
+			)
+		default:
+			return nil, &ErrBadRequest{Err: fmt.Errorf("fields cannot be selected for edge %q", edge)}
+		}
+	}
+	return query, nil
+}
+
+// UserSelectableFields maps the fields which can be requested for a User
+// entity, using the "fields" query parameter, to their associated columns.
+var UserSelectableFields = map[string]string{
+	"id":                    user.FieldID,
+	"created_at":            user.FieldCreatedAt,
+	"updated_at":            user.FieldUpdatedAt,
+	"name":                  user.FieldName,
+	"type":                  user.FieldType,
+	"description":           user.FieldDescription,
+	"enabled":               user.FieldEnabled,
+	"email":                 user.FieldEmail,
+	"avatar":                user.FieldAvatar,
+	"github_data":           user.FieldGithubData,
+	"any_data":              user.FieldAnyData,
+	"profile_url":           user.FieldProfileURL,
+	"last_authenticated_at": user.FieldLastAuthenticatedAt,
+}
+
+// SelectUser limits the columns which are queried for a User entity (and
+// its edges) to the fields requested by the client. Edges with selected fields are
+// loaded again, so this must be called after [EagerLoadUser] and
+// [ExpandUser], with the same expand values.
+func SelectUser(query *ent.UserQuery, fs *FieldSelection, expand []string) (*ent.UserQuery, error) {
+	if fs == nil {
+		return query, nil
+	}
+
+	columns, err := selectColumns(UserSelectableFields, fs.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		query.Select(columns...)
+	}
+	tree := expandTree(splitQueryValues(expand))
+
+	for edge, fields := range fs.Edges {
+		switch edge {
+		case user.EdgePets:
+			nested := tree[edge]
+			columns, err := selectColumns(PetSelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithPets(
+				func(e *ent.PetQuery) {
+					applySortingPet(e, "name", "asc", PetSortConfig.NullableFields["name"])
+					expandPet(e, nested)
+					e.Select(columns...)
+				},
+			)
+		case user.EdgePosts:
+			_, ok := tree[edge]
+			if !ok {
+				return nil, &ErrBadRequest{Err: fmt.Errorf("edge %q must be expanded to select its fields", edge)}
+			}
+			columns, err := selectColumns(PostSelectableFields, fields)
+			if err != nil {
+				return nil, err
+			}
+			query.WithPosts(
+				func(e *ent.PostQuery) {
+					applySortingPost(e, "id", "asc", PostSortConfig.NullableFields["id"])
+					e.Limit(1000)
+					e.Select(columns...)
+				},
+			)
+		default:
+			return nil, &ErrBadRequest{Err: fmt.Errorf("fields cannot be selected for edge %q", edge)}
+		}
+	}
+	return query, nil
+}
+
+// VaccinationSelectableFields maps the fields which can be requested for a Vaccination
+// entity, using the "fields" query parameter, to their associated columns.
+var VaccinationSelectableFields = map[string]string{
+	"id":              vaccination.FieldID,
+	"name":            vaccination.FieldName,
+	"administered_at": vaccination.FieldAdministeredAt,
+}
+
+// SelectVaccination limits the columns which are queried for a Vaccination entity (and
+// its edges) to the fields requested by the client. Edges with selected fields are
+// loaded again, so this must be called after [EagerLoadVaccination].
+func SelectVaccination(query *ent.VaccinationQuery, fs *FieldSelection) (*ent.VaccinationQuery, error) {
+	if fs == nil {
+		return query, nil
+	}
+
+	columns, err := selectColumns(VaccinationSelectableFields, fs.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		query.Select(columns...)
+	}
+	if len(fs.Edges) > 0 {
+		return nil, &ErrBadRequest{Err: errors.New("fields cannot be selected for any edges")}
+	}
+	return query, nil
+}
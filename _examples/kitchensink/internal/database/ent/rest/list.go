@@ -3,8 +3,13 @@
 package rest
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"slices"
 	"time"
@@ -19,15 +24,45 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/predicate"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 // ListResponse is the JSON response array for non-paginated list queries.
 type ListResponse[T any] []*T
 
+// CountResult is the response of count requests.
+type CountResult struct {
+	Count int `json:"count"` // The number of entities which match the provided filters.
+}
+
+// CountMode represents how the total number of results is calculated for paginated queries.
+type CountMode string
+
+const (
+	CountNone     CountMode = "false"    // Skips counting, and fetches one additional result to know if there are more results.
+	CountEstimate CountMode = "estimate" // Estimates the total number of results using [PageConfig.CountEstimator].
+	CountExact    CountMode = "exact"    // Runs a count query to calculate the total number of results.
+)
+
+var (
+	// CountModes defines the available count modes, ordered from least to most expensive.
+	CountModes = []CountMode{CountNone, CountEstimate, CountExact}
+)
+
 type PageConfig struct {
 	MinItemsPerPage int `json:"min_items_per_page"`
 	ItemsPerPage    int `json:"items_per_page"`
 	MaxItemsPerPage int `json:"max_items_per_page"`
+	// CountMode is the default count mode. Clients can request a cheaper count mode
+	// (see [CountModes]) using the "count" query parameter, but not a more expensive one.
+	CountMode CountMode `json:"count_mode"`
+
+	// CountEstimator is used to estimate the total number of results when [CountEstimate]
+	// is requested, for example by using the query planner or table statistics of the
+	// database. The selector contains the query (including filters, but without ordering
+	// or limits), and must not be modified. If nil, the estimator of [DefaultPageConfig]
+	// is used, and if that is also nil, an exact count is used instead.
+	CountEstimator func(ctx context.Context, selector *sql.Selector) (int, error) `json:"-"`
 }
 
 var (
@@ -39,6 +74,7 @@ var (
 		MinItemsPerPage: 1,
 		ItemsPerPage:    10,
 		MaxItemsPerPage: 100,
+		CountMode:       "exact",
 	}
 	// CategoryPageConfig defines the page configuration for LIST-related endpoints
 	// for Category.
@@ -46,6 +82,7 @@ var (
 		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
 		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
 		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
 	}
 	// FollowPageConfig defines the page configuration for LIST-related endpoints
 	// for Follow.
@@ -53,6 +90,7 @@ var (
 		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
 		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
 		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
 	}
 	// FriendshipPageConfig defines the page configuration for LIST-related endpoints
 	// for Friendship.
@@ -60,6 +98,7 @@ var (
 		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
 		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
 		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
 	}
 	// PetPageConfig defines the page configuration for LIST-related endpoints
 	// for Pet.
@@ -67,6 +106,7 @@ var (
 		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
 		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
 		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
 	}
 	// PostPageConfig defines the page configuration for LIST-related endpoints
 	// for Post.
@@ -74,6 +114,7 @@ var (
 		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
 		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
 		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
 	}
 	// SettingPageConfig defines the page configuration for LIST-related endpoints
 	// for Setting.
@@ -81,6 +122,7 @@ var (
 		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
 		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
 		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
 	}
 	// UserPageConfig defines the page configuration for LIST-related endpoints
 	// for User.
@@ -88,6 +130,15 @@ var (
 		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
 		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
 		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
+	}
+	// VaccinationPageConfig defines the page configuration for LIST-related endpoints
+	// for Vaccination.
+	VaccinationPageConfig = &PageConfig{
+		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
+		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
+		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+		CountMode:       DefaultPageConfig.CountMode,
 	}
 )
 
@@ -102,9 +153,10 @@ type PagableQuery[P any, T any] interface {
 // PagedResponse is the JSON response structure for paged queries.
 type PagedResponse[T any] struct {
 	Page       int  `json:"page"`         // Current page number.
-	TotalCount int  `json:"total_count"`  // Total number of items.
-	LastPage   int  `json:"last_page"`    // Last page number.
+	TotalCount *int `json:"total_count"`  // Total number of items, nil if the count was skipped.
+	LastPage   *int `json:"last_page"`    // Last page number, nil if the count was skipped.
 	IsLastPage bool `json:"is_last_page"` // Whether this is the last page.
+	HasMore    bool `json:"has_more"`     // Whether there are more items after this page.
 	Content    []*T `json:"content"`      // Paged data.
 }
 
@@ -113,13 +165,13 @@ func (p *PagedResponse[T]) GetPage() int {
 	return p.Page
 }
 
-// GetTotalCount returns the total number of items.
-func (p *PagedResponse[T]) GetTotalCount() int {
+// GetTotalCount returns the total number of items, or nil if the count was skipped.
+func (p *PagedResponse[T]) GetTotalCount() *int {
 	return p.TotalCount
 }
 
-// GetLastPage returns the last page number.
-func (p *PagedResponse[T]) GetLastPage() int {
+// GetLastPage returns the last page number, or nil if the count was skipped.
+func (p *PagedResponse[T]) GetLastPage() *int {
 	return p.LastPage
 }
 
@@ -128,17 +180,35 @@ func (p *PagedResponse[T]) GetIsLastPage() bool {
 	return p.IsLastPage
 }
 
+// GetHasMore returns whether there are more items after this page.
+func (p *PagedResponse[T]) GetHasMore() bool {
+	return p.HasMore
+}
+
+// isEmpty returns true if the query returned no results at all.
+func (p *PagedResponse[T]) isEmpty() bool {
+	return p.Page == 1 && len(p.Content) == 0
+}
+
 type Paginated[P PagableQuery[P, T], T any] struct {
-	Page         *int `json:"page"     form:"page,omitempty"`
-	ItemsPerPage *int `json:"per_page" form:"per_page,omitempty"`
-	ResultCount  int  `json:"-"        form:"-"` // ResultCount is populated by the query execution inside of ApplyPagination.
-	LastPage     int  `json:"-"        form:"-"` // LastPage is populated by the query execution inside of ApplyPagination.
+	Page         *int       `json:"page"     form:"page,omitempty"`
+	ItemsPerPage *int       `json:"per_page" form:"per_page,omitempty"`
+	Count        *CountMode `json:"count"    form:"count,omitempty"`
+	ResultCount  *int       `json:"-"        form:"-"` // ResultCount is populated by the query execution inside of ApplyPagination, nil if the count was skipped.
+	LastPage     *int       `json:"-"        form:"-"` // LastPage is populated by the query execution inside of ApplyPagination, nil if the count was skipped.
 
-	hasApplied bool `json:"-" form:"-"`
+	hasApplied bool      `json:"-" form:"-"`
+	countMode  CountMode `json:"-" form:"-"`
+
+	// selector resolves the SQL selector of the query, which is provided to the
+	// count estimator. If nil, estimates fall back to an exact count.
+	selector func(ctx context.Context) (*sql.Selector, error) `json:"-" form:"-"`
 }
 
 // ApplyPagination applies offsets and limits, and also runs a count query on the
-// provided query to calculate total results and what the last page number is.
+// provided query (based on the requested count mode) to calculate total results and
+// what the last page number is. If the count is skipped or estimated, one additional
+// result is fetched, to know if there are more results.
 func (p *Paginated[P, T]) ApplyPagination(ctx context.Context, query P, pageConfig *PageConfig) (P, error) {
 	if pageConfig == nil {
 		pageConfig = DefaultPageConfig
@@ -152,38 +222,73 @@ func (p *Paginated[P, T]) ApplyPagination(ctx context.Context, query P, pageConf
 		p.ItemsPerPage = &pageConfig.ItemsPerPage
 	}
 
-	if *p.ItemsPerPage < pageConfig.MinItemsPerPage {
-		return query, &ErrBadRequest{Err: fmt.Errorf("per_page %d is out of bounds, must be >= %d", *p.ItemsPerPage, pageConfig.MinItemsPerPage)}
-	}
-
-	if *p.ItemsPerPage > pageConfig.MaxItemsPerPage {
-		return query, &ErrBadRequest{Err: fmt.Errorf("per_page %d is out of bounds, must be <= %d", *p.ItemsPerPage, pageConfig.MaxItemsPerPage)}
+	if err := validateItemsPerPage(*p.ItemsPerPage, pageConfig); err != nil {
+		return query, err
 	}
 
 	if *p.Page < 1 {
 		return query, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, must be >= 1", *p.Page)}
 	}
 
-	var err error
+	p.countMode = pageConfig.CountMode
+	if p.countMode == "" {
+		p.countMode = CountExact
+	}
 
-	p.ResultCount, err = query.Count(ctx)
-	if err != nil {
-		return query, err
+	if p.Count != nil {
+		if !slices.Contains(CountModes[:slices.Index(CountModes, p.countMode)+1], *p.Count) {
+			return query, &ErrBadRequest{Err: fmt.Errorf("invalid count mode: %s", *p.Count)}
+		}
+		p.countMode = *p.Count
 	}
 
-	// TODO: how to calculate this without knowing the total count?
-	p.LastPage = int(math.Ceil(float64(p.ResultCount) / float64(*p.ItemsPerPage)))
+	p.ResultCount, p.LastPage = nil, nil
+
+	if p.countMode != CountNone {
+		count, err := p.count(ctx, query, pageConfig)
+		if err != nil {
+			return query, err
+		}
+
+		lastPage := max(int(math.Ceil(float64(count)/float64(*p.ItemsPerPage))), 1)
+
+		// Estimates may be off, so the last page is only enforced for exact counts.
+		if p.countMode == CountExact && *p.Page > lastPage {
+			return query, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, last page is %d", *p.Page, lastPage)}
+		}
 
-	if p.LastPage < 1 {
-		p.LastPage = 1
+		p.ResultCount, p.LastPage = &count, &lastPage
 	}
 
-	if *p.Page > p.LastPage {
-		return query, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, last page is %d", *p.Page, p.LastPage)}
+	limit := *p.ItemsPerPage
+	if p.countMode != CountExact {
+		limit++
 	}
 
 	p.hasApplied = true
-	return query.Limit(*p.ItemsPerPage).Offset((*p.Page - 1) * *p.ItemsPerPage), nil
+	return query.Limit(limit).Offset((*p.Page - 1) * *p.ItemsPerPage), nil
+}
+
+// count returns the total number of results of the query. Estimates fall back to an
+// exact count if no estimator or selector is available.
+func (p *Paginated[P, T]) count(ctx context.Context, query P, pageConfig *PageConfig) (int, error) {
+	if p.countMode == CountEstimate {
+		estimator := pageConfig.CountEstimator
+		if estimator == nil {
+			estimator = DefaultPageConfig.CountEstimator
+		}
+
+		if estimator != nil && p.selector != nil {
+			selector, err := p.selector(ctx)
+			if err != nil {
+				return 0, err
+			}
+			return estimator(ctx, selector)
+		}
+
+		p.countMode = CountExact
+	}
+	return query.Count(ctx)
 }
 
 // ExecutePaginated executes the query and returns a paged response. If ApplyPagination
@@ -202,13 +307,280 @@ func (p *Paginated[P, T]) ExecutePaginated(ctx context.Context, query P, pageCon
 		return nil, err
 	}
 
-	return &PagedResponse[T]{
+	resp := &PagedResponse[T]{
 		Page:       *p.Page,
 		TotalCount: p.ResultCount,
 		LastPage:   p.LastPage,
-		IsLastPage: *p.Page == p.LastPage,
-		Content:    data,
-	}, nil
+	}
+
+	if p.countMode == CountExact {
+		resp.HasMore = *p.Page < *p.LastPage
+	} else {
+		resp.HasMore = len(data) > *p.ItemsPerPage
+		if resp.HasMore {
+			data = data[:*p.ItemsPerPage]
+		}
+
+		if p.countMode == CountEstimate {
+			// Correct the estimate where the results prove it wrong.
+			page := *p.Page
+			switch {
+			case !resp.HasMore:
+				total := (page-1)**p.ItemsPerPage + len(data)
+				resp.TotalCount, resp.LastPage = &total, &page
+			case *resp.LastPage <= page:
+				page++
+				resp.LastPage = &page
+			}
+		}
+	}
+
+	resp.IsLastPage = !resp.HasMore
+	resp.Content = data
+	return resp, nil
+}
+
+var errSelectorResolved = errors.New("selector resolved")
+
+// resolveSelector resolves the SQL selector which would be used by a count query,
+// without executing it. count should run a count query with the provided predicate
+// added. The predicate captures the selector, then aborts the query before it is sent
+// to the database.
+func resolveSelector(count func(func(*sql.Selector)) (int, error)) (*sql.Selector, error) {
+	var (
+		selector *sql.Selector
+		buildErr error
+	)
+
+	_, err := count(func(s *sql.Selector) {
+		selector, buildErr = s.Clone(), s.Err()
+		s.AddError(errSelectorResolved)
+	})
+	if selector == nil {
+		if err == nil {
+			err = errors.New("unable to resolve query selector")
+		}
+		return nil, err
+	}
+
+	if buildErr != nil {
+		return nil, buildErr
+	}
+
+	selector.ClearOrder()
+	return selector, nil
+}
+
+// validateItemsPerPage ensures the requested number of items per page is within the
+// bounds of the provided page configuration.
+func validateItemsPerPage(itemsPerPage int, pageConfig *PageConfig) error {
+	if itemsPerPage < pageConfig.MinItemsPerPage {
+		return &ErrBadRequest{Err: fmt.Errorf("per_page %d is out of bounds, must be >= %d", itemsPerPage, pageConfig.MinItemsPerPage)}
+	}
+
+	if itemsPerPage > pageConfig.MaxItemsPerPage {
+		return &ErrBadRequest{Err: fmt.Errorf("per_page %d is out of bounds, must be <= %d", itemsPerPage, pageConfig.MaxItemsPerPage)}
+	}
+	return nil
+}
+
+// Cursor is the decoded form of the opaque cursor used by cursor-paginated LIST-related
+// endpoints. It contains the sort fields and orders that it was created with, and the
+// values of the last returned entity, which the next page continues after.
+type Cursor struct {
+	Terms []CursorTerm    `json:"t"`
+	ID    json.RawMessage `json:"id"`
+}
+
+// CursorTerm is a single sort field of a [Cursor], and the value of that field for the
+// last returned entity.
+type CursorTerm struct {
+	Field string          `json:"f"`
+	Order orderDirection  `json:"o"`
+	Value json.RawMessage `json:"v,omitempty"`
+}
+
+// EncodeCursor encodes the sort terms, and the sort field values and ID of the last
+// returned entity into an opaque cursor. values must contain a value for each term, which
+// should be nil if the sort field is the ID field.
+func EncodeCursor(terms []sortTerm, values []any, id any) (string, error) {
+	if len(terms) != len(values) {
+		return "", errors.New("cursor terms and values must be the same length")
+	}
+
+	c := Cursor{Terms: make([]CursorTerm, len(terms))}
+
+	var err error
+
+	for i, term := range terms {
+		c.Terms[i] = CursorTerm{Field: term.field, Order: term.order}
+
+		if values[i] != nil {
+			c.Terms[i].Value, err = json.Marshal(values[i])
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	c.ID, err = json.Marshal(id)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeCursor decodes a cursor previously created with [EncodeCursor].
+func DecodeCursor(v string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, &ErrBadRequest{Err: fmt.Errorf("invalid cursor: %w", err)}
+	}
+
+	c := &Cursor{}
+	if err = json.Unmarshal(b, c); err != nil {
+		return nil, &ErrBadRequest{Err: fmt.Errorf("invalid cursor: %w", err)}
+	}
+
+	if len(c.Terms) == 0 || c.ID == nil {
+		return nil, &ErrBadRequest{Err: errors.New("invalid cursor: missing sort fields or id")}
+	}
+
+	for _, term := range c.Terms {
+		if term.Field == "" {
+			return nil, &ErrBadRequest{Err: errors.New("invalid cursor: missing sort field")}
+		}
+	}
+	return c, nil
+}
+
+// matches returns true if the cursor was created with the provided (validated) sort terms.
+func (c *Cursor) matches(terms []sortTerm) bool {
+	return slices.EqualFunc(c.Terms, terms, func(ct CursorTerm, t sortTerm) bool {
+		return ct.Field == t.field && ct.Order == t.order
+	})
+}
+
+// unmarshal decodes the sort field value stored in the cursor term into the provided
+// pointer.
+func (t *CursorTerm) unmarshal(value any) error {
+	if err := json.Unmarshal(t.Value, value); err != nil {
+		return &ErrBadRequest{Err: fmt.Errorf("invalid cursor value for %q: %w", t.Field, err)}
+	}
+	return nil
+}
+
+// unmarshalID decodes the ID stored in the cursor into the provided pointer.
+func (c *Cursor) unmarshalID(id any) error {
+	if err := json.Unmarshal(c.ID, id); err != nil {
+		return &ErrBadRequest{Err: fmt.Errorf("invalid cursor id: %w", err)}
+	}
+	return nil
+}
+
+// keysetPredicate returns a predicate which selects all rows after the provided sort
+// field values and ID, based on the order of each sort field. Rows are after the cursor
+// if they are after it on the first sort field, or equal on it and after it on the next
+// sort field, and so on. The ID is used as the final tie-breaker (using the order of
+// the last sort field), unless one of the sort fields is the ID field.
+func keysetPredicate(idField string, terms []CursorTerm, values []any, id any) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		after := func(order orderDirection, field string, value any) *sql.Predicate {
+			if order == orderDesc {
+				return sql.LT(s.C(field), value)
+			}
+			return sql.GT(s.C(field), value)
+		}
+
+		var or, equal []*sql.Predicate
+
+		for i, term := range terms {
+			value := values[i]
+			if term.Field == idField {
+				value = id
+			}
+
+			or = append(or, sql.And(append(slices.Clone(equal), after(term.Order, term.Field, value))...))
+
+			if term.Field == idField { // The ID is unique, so no further fields are needed.
+				s.Where(sql.Or(or...))
+				return
+			}
+			equal = append(equal, sql.EQ(s.C(term.Field), value))
+		}
+
+		or = append(or, sql.And(append(equal, after(terms[len(terms)-1].Order, idField, id))...))
+		s.Where(sql.Or(or...))
+	}
+}
+
+// CursorPagedResponse is the JSON response structure for cursor-paginated queries.
+type CursorPagedResponse[T any] struct {
+	NextCursor *string `json:"next_cursor"`  // Cursor for the next page, nil if this is the last page.
+	IsLastPage bool    `json:"is_last_page"` // Whether this is the last page.
+	Content    []*T    `json:"content"`      // Paged data.
+}
+
+// GetNextCursor returns the cursor for the next page, or an empty string if this is
+// the last page.
+func (p *CursorPagedResponse[T]) GetNextCursor() string {
+	if p.NextCursor == nil {
+		return ""
+	}
+	return *p.NextCursor
+}
+
+// GetIsLastPage returns whether this is the last page.
+func (p *CursorPagedResponse[T]) GetIsLastPage() bool {
+	return p.IsLastPage
+}
+
+type CursorPaginated[P PagableQuery[P, T], T any] struct {
+	Cursor       *string `json:"cursor"   form:"cursor,omitempty"`
+	ItemsPerPage *int    `json:"per_page" form:"per_page,omitempty"`
+}
+
+// ExecuteCursor executes the query and returns a cursor-paged response. The query must
+// already be sorted and have the cursor predicate applied. One additional entity is
+// fetched to know if there are more results, rather than running a count query. next
+// is invoked with the last entity of the page to create the cursor for the next page.
+func (p *CursorPaginated[P, T]) ExecuteCursor(ctx context.Context, query P, pageConfig *PageConfig, next func(*T) (string, error)) (*CursorPagedResponse[T], error) {
+	if pageConfig == nil {
+		pageConfig = DefaultPageConfig
+	}
+
+	if p.ItemsPerPage == nil {
+		p.ItemsPerPage = &pageConfig.ItemsPerPage
+	}
+
+	if err := validateItemsPerPage(*p.ItemsPerPage, pageConfig); err != nil {
+		return nil, err
+	}
+
+	data, err := query.Limit(*p.ItemsPerPage + 1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &CursorPagedResponse[T]{IsLastPage: len(data) <= *p.ItemsPerPage}
+
+	if !resp.IsLastPage {
+		data = data[:*p.ItemsPerPage]
+
+		cursor, err := next(data[len(data)-1])
+		if err != nil {
+			return nil, err
+		}
+		resp.NextCursor = &cursor
+	}
+
+	resp.Content = data
+	return resp, nil
 }
 
 // FilterOperation represents if all or any (one or more) filters should be applied.
@@ -224,9 +596,36 @@ var (
 	FilterOperations = []FilterOperation{FilterOperationAnd, FilterOperationOr}
 )
 
+var (
+	// FilterExpressionMaxDepth is the maximum nesting depth of "and", "or" and "not"
+	// groups within a filter expression.
+	FilterExpressionMaxDepth = 10
+)
+
 type Filtered[P ~func(*sql.Selector)] struct {
 	// FilterOperation controls how multiple predicates are applied together.
 	FilterOperation *FilterOperation `json:"filter_op,omitempty" form:"filter_op,omitempty"`
+
+	// Filter is a JSON-encoded filter expression, which allows grouping filters using
+	// "and", "or" and "not". See [Filtered.ApplyFilterExpression].
+	Filter *FilterExpression `json:"filter,omitempty" form:"filter,omitempty"`
+}
+
+// FilterExpression is a JSON-encoded filter expression. When decoded from JSON (e.g. the
+// body of a search request), the expression can be provided as-is, rather than encoded
+// as a string.
+type FilterExpression string
+
+// UnmarshalJSON implements [json.Unmarshaler], accepting either a JSON object or a string
+// containing one.
+func (e *FilterExpression) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err == nil {
+		*e = FilterExpression(v)
+		return nil
+	}
+	*e = FilterExpression(data)
+	return nil
 }
 
 // ApplyFilterOperation applies the requested filter operation (if provided) to the
@@ -242,10 +641,87 @@ func (f *Filtered[P]) ApplyFilterOperation(predicates ...P) (P, error) {
 	return sql.OrPredicates(predicates...), nil
 }
 
-// ListCategoryParams defines parameters for listing Categories via a GET request.
-type ListCategoryParams struct {
-	Sorted
-	Paginated[*ent.CategoryQuery, ent.Category]
+// ApplyFilterExpression parses the filter expression (if provided), and combines it
+// with the provided predicate using AND. An expression is a JSON object, where each
+// key is either the name of a filter parameter (e.g. "name.eq"), or one of "and" and
+// "or" (an array of expressions), or "not" (a single expression). All keys within the
+// same object are combined using AND. leaf returns the predicate for a single filter.
+func (f *Filtered[P]) ApplyFilterExpression(predicate P, leaf func(key string, value json.RawMessage) (P, error)) (P, error) {
+	if f.Filter == nil {
+		return predicate, nil
+	}
+
+	expr, err := parseFilterExpression([]byte(*f.Filter), leaf, 1)
+	if err != nil {
+		return nil, &ErrBadRequest{Err: fmt.Errorf("invalid filter: %w", err)}
+	}
+	return sql.AndPredicates(predicate, expr), nil
+}
+
+// parseFilterExpression recursively parses the provided filter expression into a
+// predicate. See [Filtered.ApplyFilterExpression] for the expression format.
+func parseFilterExpression[P ~func(*sql.Selector)](data []byte, leaf func(string, json.RawMessage) (P, error), depth int) (P, error) {
+	if depth > FilterExpressionMaxDepth {
+		return nil, fmt.Errorf("expression exceeds the maximum depth of %d", FilterExpressionMaxDepth)
+	}
+
+	var expr map[string]json.RawMessage
+	if err := json.Unmarshal(data, &expr); err != nil || expr == nil {
+		return nil, errors.New("expression must be a JSON object")
+	}
+	if len(expr) == 0 {
+		return nil, errors.New("expression cannot be empty")
+	}
+
+	predicates := make([]P, 0, len(expr))
+
+	// Keys are sorted, so the same expression always results in the same query.
+	for _, key := range slices.Sorted(maps.Keys(expr)) {
+		switch key {
+		case "and", "or":
+			var exprs []json.RawMessage
+			if err := json.Unmarshal(expr[key], &exprs); err != nil || len(exprs) == 0 {
+				return nil, fmt.Errorf("%q must be a non-empty array of expressions", key)
+			}
+
+			group := make([]P, 0, len(exprs))
+			for _, e := range exprs {
+				p, err := parseFilterExpression(e, leaf, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				group = append(group, p)
+			}
+
+			if key == "and" {
+				predicates = append(predicates, sql.AndPredicates(group...))
+			} else {
+				predicates = append(predicates, sql.OrPredicates(group...))
+			}
+		case "not":
+			p, err := parseFilterExpression(expr[key], leaf, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			predicates = append(predicates, sql.NotPredicates(p))
+		default:
+			if bytes.Equal(bytes.TrimSpace(expr[key]), []byte("null")) {
+				return nil, fmt.Errorf("value of filter %q cannot be null", key)
+			}
+
+			p, err := leaf(key, expr[key])
+			if err != nil {
+				return nil, err
+			}
+			predicates = append(predicates, p)
+		}
+	}
+	return sql.AndPredicates(predicates...), nil
+}
+
+// FilterCategoryParams defines the filter-related parameters for Categories, which
+// are shared by all operations which filter Categories (e.g. list and bulk operations).
+type FilterCategoryParams struct {
 	Filtered[predicate.Category]
 
 	// Filters field "id" to be equal to the provided value.
@@ -266,36 +742,107 @@ type ListCategoryParams struct {
 	CategoryUpdatedAtLT *time.Time `form:"updatedAt.lt,omitempty" json:"category_updated_at_lt,omitempty"`
 }
 
+// ListCategoryParams defines parameters for listing Categories via a GET request.
+type ListCategoryParams struct {
+	Sorted
+	Paginated[*ent.CategoryQuery, ent.Category]
+	FilterCategoryParams
+	// Fields contains the fields requested by the client. As "fields" and "fields[<edge>]"
+	// can't be decoded together, this is populated from [ParseFieldSelection] instead.
+	Fields *FieldSelection `json:"-" form:"-"`
+}
+
 // FilterPredicates returns the predicates for filter-related parameters in Category.
-func (l *ListCategoryParams) FilterPredicates() (predicate.Category, error) {
+func (p *FilterCategoryParams) FilterPredicates() (predicate.Category, error) {
 	var predicates []predicate.Category
 
-	if l.CategoryIDEQ != nil {
-		predicates = append(predicates, category.IDEQ(*l.CategoryIDEQ))
+	if p.CategoryIDEQ != nil {
+		predicates = append(predicates, category.IDEQ(*p.CategoryIDEQ))
 	}
-	if l.CategoryIDNEQ != nil {
-		predicates = append(predicates, category.IDNEQ(*l.CategoryIDNEQ))
+	if p.CategoryIDNEQ != nil {
+		predicates = append(predicates, category.IDNEQ(*p.CategoryIDNEQ))
 	}
-	if l.CategoryIDIn != nil {
-		predicates = append(predicates, category.IDIn(l.CategoryIDIn...))
+	if p.CategoryIDIn != nil {
+		predicates = append(predicates, category.IDIn(p.CategoryIDIn...))
 	}
-	if l.CategoryIDNotIn != nil {
-		predicates = append(predicates, category.IDNotIn(l.CategoryIDNotIn...))
+	if p.CategoryIDNotIn != nil {
+		predicates = append(predicates, category.IDNotIn(p.CategoryIDNotIn...))
 	}
-	if l.CategoryCreatedAtGT != nil {
-		predicates = append(predicates, category.CreatedAtGT(*l.CategoryCreatedAtGT))
+	if p.CategoryCreatedAtGT != nil {
+		predicates = append(predicates, category.CreatedAtGT(*p.CategoryCreatedAtGT))
 	}
-	if l.CategoryCreatedAtLT != nil {
-		predicates = append(predicates, category.CreatedAtLT(*l.CategoryCreatedAtLT))
+	if p.CategoryCreatedAtLT != nil {
+		predicates = append(predicates, category.CreatedAtLT(*p.CategoryCreatedAtLT))
 	}
-	if l.CategoryUpdatedAtGT != nil {
-		predicates = append(predicates, category.UpdatedAtGT(*l.CategoryUpdatedAtGT))
+	if p.CategoryUpdatedAtGT != nil {
+		predicates = append(predicates, category.UpdatedAtGT(*p.CategoryUpdatedAtGT))
 	}
-	if l.CategoryUpdatedAtLT != nil {
-		predicates = append(predicates, category.UpdatedAtLT(*l.CategoryUpdatedAtLT))
+	if p.CategoryUpdatedAtLT != nil {
+		predicates = append(predicates, category.UpdatedAtLT(*p.CategoryUpdatedAtLT))
+	}
+
+	pred, err := p.ApplyFilterOperation(predicates...)
+	if err != nil {
+		return nil, err
 	}
+	return p.ApplyFilterExpression(pred, filterPredicateCategory)
+}
 
-	return l.ApplyFilterOperation(predicates...)
+// filterPredicateCategory returns the predicate for a single filter within a filter
+// expression, where key is the name of the associated filter parameter.
+func filterPredicateCategory(key string, value json.RawMessage) (predicate.Category, error) {
+	switch key {
+	case "id.eq":
+		var v struct{ CategoryIDEQ *int }
+		if err := json.Unmarshal(value, &v.CategoryIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.IDEQ(*v.CategoryIDEQ), nil
+	case "id.neq":
+		var v struct{ CategoryIDNEQ *int }
+		if err := json.Unmarshal(value, &v.CategoryIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.IDNEQ(*v.CategoryIDNEQ), nil
+	case "id.in":
+		var v struct{ CategoryIDIn []int }
+		if err := json.Unmarshal(value, &v.CategoryIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.IDIn(v.CategoryIDIn...), nil
+	case "id.notIn":
+		var v struct{ CategoryIDNotIn []int }
+		if err := json.Unmarshal(value, &v.CategoryIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.IDNotIn(v.CategoryIDNotIn...), nil
+	case "createdAt.gt":
+		var v struct{ CategoryCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.CategoryCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.CreatedAtGT(*v.CategoryCreatedAtGT), nil
+	case "createdAt.lt":
+		var v struct{ CategoryCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.CategoryCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.CreatedAtLT(*v.CategoryCreatedAtLT), nil
+	case "updatedAt.gt":
+		var v struct{ CategoryUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.CategoryUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.UpdatedAtGT(*v.CategoryUpdatedAtGT), nil
+	case "updatedAt.lt":
+		var v struct{ CategoryUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.CategoryUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return category.UpdatedAtLT(*v.CategoryUpdatedAtLT), nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q", key)
+	}
 }
 
 // ApplySorting applies sorting to the query based on the provided sort and order fields.
@@ -303,10 +850,16 @@ func (l *ListCategoryParams) ApplySorting(query *ent.CategoryQuery) error {
 	if err := l.Sorted.Validate(CategorySortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if len(l.terms) == 0 { // No custom sort fields provided and no defaults, so don't do anything.
 		return nil
 	}
-	applySortingCategory(query, *l.Field, *l.Order)
+	for _, term := range l.terms {
+		applySortingCategory(query, term.field, term.order, term.nulls)
+	}
+	if !l.sortedBy(category.FieldID) && !l.sortedBy("random") {
+		// Use the ID as a tie-breaker, so the order is stable across pages.
+		query.Order(withFieldSelector(category.FieldID, l.terms[len(l.terms)-1].order, ""))
+	}
 	return nil
 }
 
@@ -318,10 +871,24 @@ func (l *ListCategoryParams) Exec(ctx context.Context, query *ent.CategoryQuery)
 		return nil, err
 	}
 	query.Where(predicates)
-	err = l.ApplySorting(EagerLoadCategory(query))
+	EagerLoadCategory(query)
+	err = l.ApplySorting(query)
+	if err != nil {
+		return nil, err
+	}
+	l.selector = func(ctx context.Context) (*sql.Selector, error) {
+		return resolveSelector(func(fn func(*sql.Selector)) (int, error) {
+			return query.Clone().Where(fn).Count(ctx)
+		})
+	}
+	// Fields are selected after counting, so the count isn't affected by the selected columns.
+	_, err = l.ApplyPagination(ctx, query, CategoryPageConfig)
 	if err != nil {
 		return nil, err
 	}
+	if _, err = SelectCategory(query, l.Fields); err != nil {
+		return nil, err
+	}
 	return l.ExecutePaginated(ctx, query, CategoryPageConfig)
 }
 
@@ -336,27 +903,34 @@ func (l *ListFollowParams) ApplySorting(query *ent.FollowsQuery) error {
 	if err := l.Sorted.Validate(FollowSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if len(l.terms) == 0 { // No custom sort fields provided and no defaults, so don't do anything.
 		return nil
 	}
-	applySortingFollow(query, *l.Field, *l.Order)
+	for _, term := range l.terms {
+		applySortingFollow(query, term.field, term.order, term.nulls)
+	}
 	return nil
 }
 
 // Exec wraps all logic (filtering, sorting, pagination, eager loading) and
 // executes all necessary queries, returning the results.
 func (l *ListFollowParams) Exec(ctx context.Context, query *ent.FollowsQuery) (results *PagedResponse[ent.Follows], err error) {
-	err = l.ApplySorting(EagerLoadFollow(query))
+	EagerLoadFollow(query)
+	err = l.ApplySorting(query)
 	if err != nil {
 		return nil, err
 	}
+	l.selector = func(ctx context.Context) (*sql.Selector, error) {
+		return resolveSelector(func(fn func(*sql.Selector)) (int, error) {
+			return query.Clone().Where(fn).Count(ctx)
+		})
+	}
 	return l.ExecutePaginated(ctx, query, FollowPageConfig)
 }
 
-// ListFriendshipParams defines parameters for listing Friendships via a GET request.
-type ListFriendshipParams struct {
-	Sorted
-	Paginated[*ent.FriendshipQuery, ent.Friendship]
+// FilterFriendshipParams defines the filter-related parameters for Friendships, which
+// are shared by all operations which filter Friendships (e.g. list and bulk operations).
+type FilterFriendshipParams struct {
 	Filtered[predicate.Friendship]
 
 	// Filters field "id" to be equal to the provided value.
@@ -525,332 +1099,890 @@ type ListFriendshipParams struct {
 	EdgeFriendLastAuthenticatedAtIsNil *bool `form:"friend.lastAuthenticatedAt.null,omitempty" json:"edge_friend_last_authenticated_at_is_nil,omitempty"`
 }
 
+// ListFriendshipParams defines parameters for listing Friendships via a GET request.
+type ListFriendshipParams struct {
+	Sorted
+	Paginated[*ent.FriendshipQuery, ent.Friendship]
+	FilterFriendshipParams
+	// Fields contains the fields requested by the client. As "fields" and "fields[<edge>]"
+	// can't be decoded together, this is populated from [ParseFieldSelection] instead.
+	Fields *FieldSelection `json:"-" form:"-"`
+}
+
 // FilterPredicates returns the predicates for filter-related parameters in Friendship.
-func (l *ListFriendshipParams) FilterPredicates() (predicate.Friendship, error) {
+func (p *FilterFriendshipParams) FilterPredicates() (predicate.Friendship, error) {
 	var predicates []predicate.Friendship
 
-	if l.FriendshipIDEQ != nil {
-		predicates = append(predicates, friendship.IDEQ(*l.FriendshipIDEQ))
+	if p.FriendshipIDEQ != nil {
+		predicates = append(predicates, friendship.IDEQ(*p.FriendshipIDEQ))
 	}
-	if l.FriendshipIDNEQ != nil {
-		predicates = append(predicates, friendship.IDNEQ(*l.FriendshipIDNEQ))
+	if p.FriendshipIDNEQ != nil {
+		predicates = append(predicates, friendship.IDNEQ(*p.FriendshipIDNEQ))
 	}
-	if l.FriendshipIDIn != nil {
-		predicates = append(predicates, friendship.IDIn(l.FriendshipIDIn...))
+	if p.FriendshipIDIn != nil {
+		predicates = append(predicates, friendship.IDIn(p.FriendshipIDIn...))
 	}
-	if l.FriendshipIDNotIn != nil {
-		predicates = append(predicates, friendship.IDNotIn(l.FriendshipIDNotIn...))
+	if p.FriendshipIDNotIn != nil {
+		predicates = append(predicates, friendship.IDNotIn(p.FriendshipIDNotIn...))
 	}
-	if l.FriendshipUserIDEQ != nil {
-		predicates = append(predicates, friendship.UserIDEQ(*l.FriendshipUserIDEQ))
+	if p.FriendshipUserIDEQ != nil {
+		predicates = append(predicates, friendship.UserIDEQ(*p.FriendshipUserIDEQ))
 	}
-	if l.FriendshipUserIDNEQ != nil {
-		predicates = append(predicates, friendship.UserIDNEQ(*l.FriendshipUserIDNEQ))
+	if p.FriendshipUserIDNEQ != nil {
+		predicates = append(predicates, friendship.UserIDNEQ(*p.FriendshipUserIDNEQ))
 	}
-	if l.FriendshipUserIDIn != nil {
-		predicates = append(predicates, friendship.UserIDIn(l.FriendshipUserIDIn...))
+	if p.FriendshipUserIDIn != nil {
+		predicates = append(predicates, friendship.UserIDIn(p.FriendshipUserIDIn...))
 	}
-	if l.FriendshipUserIDNotIn != nil {
-		predicates = append(predicates, friendship.UserIDNotIn(l.FriendshipUserIDNotIn...))
+	if p.FriendshipUserIDNotIn != nil {
+		predicates = append(predicates, friendship.UserIDNotIn(p.FriendshipUserIDNotIn...))
 	}
-	if l.FriendshipFriendIDEQ != nil {
-		predicates = append(predicates, friendship.FriendIDEQ(*l.FriendshipFriendIDEQ))
+	if p.FriendshipFriendIDEQ != nil {
+		predicates = append(predicates, friendship.FriendIDEQ(*p.FriendshipFriendIDEQ))
 	}
-	if l.FriendshipFriendIDNEQ != nil {
-		predicates = append(predicates, friendship.FriendIDNEQ(*l.FriendshipFriendIDNEQ))
+	if p.FriendshipFriendIDNEQ != nil {
+		predicates = append(predicates, friendship.FriendIDNEQ(*p.FriendshipFriendIDNEQ))
 	}
-	if l.FriendshipFriendIDIn != nil {
-		predicates = append(predicates, friendship.FriendIDIn(l.FriendshipFriendIDIn...))
+	if p.FriendshipFriendIDIn != nil {
+		predicates = append(predicates, friendship.FriendIDIn(p.FriendshipFriendIDIn...))
 	}
-	if l.FriendshipFriendIDNotIn != nil {
-		predicates = append(predicates, friendship.FriendIDNotIn(l.FriendshipFriendIDNotIn...))
+	if p.FriendshipFriendIDNotIn != nil {
+		predicates = append(predicates, friendship.FriendIDNotIn(p.FriendshipFriendIDNotIn...))
 	}
-	if l.EdgeHasUser != nil {
-		if *l.EdgeHasUser {
+	if p.EdgeHasUser != nil {
+		if *p.EdgeHasUser {
 			predicates = append(predicates, friendship.HasUser())
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasUser()))
 		}
 	}
-	if l.EdgeUserCreatedAtGT != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.CreatedAtGT(*l.EdgeUserCreatedAtGT)))
+	if p.EdgeUserCreatedAtGT != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.CreatedAtGT(*p.EdgeUserCreatedAtGT)))
 	}
-	if l.EdgeUserCreatedAtLT != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.CreatedAtLT(*l.EdgeUserCreatedAtLT)))
+	if p.EdgeUserCreatedAtLT != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.CreatedAtLT(*p.EdgeUserCreatedAtLT)))
 	}
-	if l.EdgeUserUpdatedAtGT != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.UpdatedAtGT(*l.EdgeUserUpdatedAtGT)))
+	if p.EdgeUserUpdatedAtGT != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.UpdatedAtGT(*p.EdgeUserUpdatedAtGT)))
 	}
-	if l.EdgeUserUpdatedAtLT != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.UpdatedAtLT(*l.EdgeUserUpdatedAtLT)))
+	if p.EdgeUserUpdatedAtLT != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.UpdatedAtLT(*p.EdgeUserUpdatedAtLT)))
 	}
-	if l.EdgeUserNameEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameEQ(*l.EdgeUserNameEQ)))
+	if p.EdgeUserNameEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameEQ(*p.EdgeUserNameEQ)))
 	}
-	if l.EdgeUserNameNEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameNEQ(*l.EdgeUserNameNEQ)))
+	if p.EdgeUserNameNEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameNEQ(*p.EdgeUserNameNEQ)))
 	}
-	if l.EdgeUserNameIn != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameIn(l.EdgeUserNameIn...)))
+	if p.EdgeUserNameIn != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameIn(p.EdgeUserNameIn...)))
 	}
-	if l.EdgeUserNameNotIn != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameNotIn(l.EdgeUserNameNotIn...)))
+	if p.EdgeUserNameNotIn != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameNotIn(p.EdgeUserNameNotIn...)))
 	}
-	if l.EdgeUserNameEqualFold != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameEqualFold(*l.EdgeUserNameEqualFold)))
+	if p.EdgeUserNameEqualFold != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameEqualFold(*p.EdgeUserNameEqualFold)))
 	}
-	if l.EdgeUserNameContains != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameContains(*l.EdgeUserNameContains)))
+	if p.EdgeUserNameContains != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameContains(*p.EdgeUserNameContains)))
 	}
-	if l.EdgeUserNameContainsFold != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameContainsFold(*l.EdgeUserNameContainsFold)))
+	if p.EdgeUserNameContainsFold != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameContainsFold(*p.EdgeUserNameContainsFold)))
 	}
-	if l.EdgeUserNameHasPrefix != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameHasPrefix(*l.EdgeUserNameHasPrefix)))
+	if p.EdgeUserNameHasPrefix != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameHasPrefix(*p.EdgeUserNameHasPrefix)))
 	}
-	if l.EdgeUserNameHasSuffix != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.NameHasSuffix(*l.EdgeUserNameHasSuffix)))
+	if p.EdgeUserNameHasSuffix != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.NameHasSuffix(*p.EdgeUserNameHasSuffix)))
 	}
-	if l.EdgeUserTypeEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.TypeEQ(*l.EdgeUserTypeEQ)))
+	if p.EdgeUserTypeEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.TypeEQ(*p.EdgeUserTypeEQ)))
 	}
-	if l.EdgeUserTypeNEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.TypeNEQ(*l.EdgeUserTypeNEQ)))
+	if p.EdgeUserTypeNEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.TypeNEQ(*p.EdgeUserTypeNEQ)))
 	}
-	if l.EdgeUserTypeIn != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.TypeIn(l.EdgeUserTypeIn...)))
+	if p.EdgeUserTypeIn != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.TypeIn(p.EdgeUserTypeIn...)))
 	}
-	if l.EdgeUserTypeNotIn != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.TypeNotIn(l.EdgeUserTypeNotIn...)))
+	if p.EdgeUserTypeNotIn != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.TypeNotIn(p.EdgeUserTypeNotIn...)))
 	}
-	if l.EdgeUserDescriptionIsNil != nil {
-		if *l.EdgeUserDescriptionIsNil {
+	if p.EdgeUserDescriptionIsNil != nil {
+		if *p.EdgeUserDescriptionIsNil {
 			predicates = append(predicates, friendship.HasUserWith(user.DescriptionIsNil()))
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasUserWith(user.DescriptionIsNil())))
 		}
 	}
-	if l.EdgeUserDescriptionContains != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.DescriptionContains(*l.EdgeUserDescriptionContains)))
+	if p.EdgeUserDescriptionContains != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.DescriptionContains(*p.EdgeUserDescriptionContains)))
 	}
-	if l.EdgeUserDescriptionContainsFold != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.DescriptionContainsFold(*l.EdgeUserDescriptionContainsFold)))
+	if p.EdgeUserDescriptionContainsFold != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.DescriptionContainsFold(*p.EdgeUserDescriptionContainsFold)))
 	}
-	if l.EdgeUserEnabledEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EnabledEQ(*l.EdgeUserEnabledEQ)))
+	if p.EdgeUserEnabledEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EnabledEQ(*p.EdgeUserEnabledEQ)))
 	}
-	if l.EdgeUserEmailEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailEQ(*l.EdgeUserEmailEQ)))
+	if p.EdgeUserEmailEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailEQ(*p.EdgeUserEmailEQ)))
 	}
-	if l.EdgeUserEmailNEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailNEQ(*l.EdgeUserEmailNEQ)))
+	if p.EdgeUserEmailNEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailNEQ(*p.EdgeUserEmailNEQ)))
 	}
-	if l.EdgeUserEmailIsNil != nil {
-		if *l.EdgeUserEmailIsNil {
+	if p.EdgeUserEmailIsNil != nil {
+		if *p.EdgeUserEmailIsNil {
 			predicates = append(predicates, friendship.HasUserWith(user.EmailIsNil()))
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasUserWith(user.EmailIsNil())))
 		}
 	}
-	if l.EdgeUserEmailIn != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailIn(l.EdgeUserEmailIn...)))
+	if p.EdgeUserEmailIn != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailIn(p.EdgeUserEmailIn...)))
 	}
-	if l.EdgeUserEmailNotIn != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailNotIn(l.EdgeUserEmailNotIn...)))
+	if p.EdgeUserEmailNotIn != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailNotIn(p.EdgeUserEmailNotIn...)))
 	}
-	if l.EdgeUserEmailEqualFold != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailEqualFold(*l.EdgeUserEmailEqualFold)))
+	if p.EdgeUserEmailEqualFold != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailEqualFold(*p.EdgeUserEmailEqualFold)))
 	}
-	if l.EdgeUserEmailContains != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailContains(*l.EdgeUserEmailContains)))
+	if p.EdgeUserEmailContains != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailContains(*p.EdgeUserEmailContains)))
 	}
-	if l.EdgeUserEmailContainsFold != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailContainsFold(*l.EdgeUserEmailContainsFold)))
+	if p.EdgeUserEmailContainsFold != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailContainsFold(*p.EdgeUserEmailContainsFold)))
 	}
-	if l.EdgeUserEmailHasPrefix != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailHasPrefix(*l.EdgeUserEmailHasPrefix)))
+	if p.EdgeUserEmailHasPrefix != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailHasPrefix(*p.EdgeUserEmailHasPrefix)))
 	}
-	if l.EdgeUserEmailHasSuffix != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.EmailHasSuffix(*l.EdgeUserEmailHasSuffix)))
+	if p.EdgeUserEmailHasSuffix != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.EmailHasSuffix(*p.EdgeUserEmailHasSuffix)))
 	}
-	if l.EdgeUserLastAuthenticatedAtEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.LastAuthenticatedAtEQ(*l.EdgeUserLastAuthenticatedAtEQ)))
+	if p.EdgeUserLastAuthenticatedAtEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.LastAuthenticatedAtEQ(*p.EdgeUserLastAuthenticatedAtEQ)))
 	}
-	if l.EdgeUserLastAuthenticatedAtNEQ != nil {
-		predicates = append(predicates, friendship.HasUserWith(user.LastAuthenticatedAtNEQ(*l.EdgeUserLastAuthenticatedAtNEQ)))
+	if p.EdgeUserLastAuthenticatedAtNEQ != nil {
+		predicates = append(predicates, friendship.HasUserWith(user.LastAuthenticatedAtNEQ(*p.EdgeUserLastAuthenticatedAtNEQ)))
 	}
-	if l.EdgeUserLastAuthenticatedAtIsNil != nil {
-		if *l.EdgeUserLastAuthenticatedAtIsNil {
+	if p.EdgeUserLastAuthenticatedAtIsNil != nil {
+		if *p.EdgeUserLastAuthenticatedAtIsNil {
 			predicates = append(predicates, friendship.HasUserWith(user.LastAuthenticatedAtIsNil()))
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasUserWith(user.LastAuthenticatedAtIsNil())))
 		}
 	}
-	if l.EdgeHasFriend != nil {
-		if *l.EdgeHasFriend {
+	if p.EdgeHasFriend != nil {
+		if *p.EdgeHasFriend {
 			predicates = append(predicates, friendship.HasFriend())
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasFriend()))
 		}
 	}
-	if l.EdgeFriendCreatedAtGT != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.CreatedAtGT(*l.EdgeFriendCreatedAtGT)))
+	if p.EdgeFriendCreatedAtGT != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.CreatedAtGT(*p.EdgeFriendCreatedAtGT)))
 	}
-	if l.EdgeFriendCreatedAtLT != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.CreatedAtLT(*l.EdgeFriendCreatedAtLT)))
+	if p.EdgeFriendCreatedAtLT != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.CreatedAtLT(*p.EdgeFriendCreatedAtLT)))
 	}
-	if l.EdgeFriendUpdatedAtGT != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.UpdatedAtGT(*l.EdgeFriendUpdatedAtGT)))
+	if p.EdgeFriendUpdatedAtGT != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.UpdatedAtGT(*p.EdgeFriendUpdatedAtGT)))
 	}
-	if l.EdgeFriendUpdatedAtLT != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.UpdatedAtLT(*l.EdgeFriendUpdatedAtLT)))
+	if p.EdgeFriendUpdatedAtLT != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.UpdatedAtLT(*p.EdgeFriendUpdatedAtLT)))
 	}
-	if l.EdgeFriendNameEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameEQ(*l.EdgeFriendNameEQ)))
+	if p.EdgeFriendNameEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameEQ(*p.EdgeFriendNameEQ)))
 	}
-	if l.EdgeFriendNameNEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameNEQ(*l.EdgeFriendNameNEQ)))
+	if p.EdgeFriendNameNEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameNEQ(*p.EdgeFriendNameNEQ)))
 	}
-	if l.EdgeFriendNameIn != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameIn(l.EdgeFriendNameIn...)))
+	if p.EdgeFriendNameIn != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameIn(p.EdgeFriendNameIn...)))
 	}
-	if l.EdgeFriendNameNotIn != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameNotIn(l.EdgeFriendNameNotIn...)))
+	if p.EdgeFriendNameNotIn != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameNotIn(p.EdgeFriendNameNotIn...)))
 	}
-	if l.EdgeFriendNameEqualFold != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameEqualFold(*l.EdgeFriendNameEqualFold)))
+	if p.EdgeFriendNameEqualFold != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameEqualFold(*p.EdgeFriendNameEqualFold)))
 	}
-	if l.EdgeFriendNameContains != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameContains(*l.EdgeFriendNameContains)))
+	if p.EdgeFriendNameContains != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameContains(*p.EdgeFriendNameContains)))
 	}
-	if l.EdgeFriendNameContainsFold != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameContainsFold(*l.EdgeFriendNameContainsFold)))
+	if p.EdgeFriendNameContainsFold != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameContainsFold(*p.EdgeFriendNameContainsFold)))
 	}
-	if l.EdgeFriendNameHasPrefix != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameHasPrefix(*l.EdgeFriendNameHasPrefix)))
+	if p.EdgeFriendNameHasPrefix != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameHasPrefix(*p.EdgeFriendNameHasPrefix)))
 	}
-	if l.EdgeFriendNameHasSuffix != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.NameHasSuffix(*l.EdgeFriendNameHasSuffix)))
+	if p.EdgeFriendNameHasSuffix != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.NameHasSuffix(*p.EdgeFriendNameHasSuffix)))
 	}
-	if l.EdgeFriendTypeEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.TypeEQ(*l.EdgeFriendTypeEQ)))
+	if p.EdgeFriendTypeEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.TypeEQ(*p.EdgeFriendTypeEQ)))
 	}
-	if l.EdgeFriendTypeNEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.TypeNEQ(*l.EdgeFriendTypeNEQ)))
+	if p.EdgeFriendTypeNEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.TypeNEQ(*p.EdgeFriendTypeNEQ)))
 	}
-	if l.EdgeFriendTypeIn != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.TypeIn(l.EdgeFriendTypeIn...)))
+	if p.EdgeFriendTypeIn != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.TypeIn(p.EdgeFriendTypeIn...)))
 	}
-	if l.EdgeFriendTypeNotIn != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.TypeNotIn(l.EdgeFriendTypeNotIn...)))
+	if p.EdgeFriendTypeNotIn != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.TypeNotIn(p.EdgeFriendTypeNotIn...)))
 	}
-	if l.EdgeFriendDescriptionIsNil != nil {
-		if *l.EdgeFriendDescriptionIsNil {
+	if p.EdgeFriendDescriptionIsNil != nil {
+		if *p.EdgeFriendDescriptionIsNil {
 			predicates = append(predicates, friendship.HasFriendWith(user.DescriptionIsNil()))
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasFriendWith(user.DescriptionIsNil())))
 		}
 	}
-	if l.EdgeFriendDescriptionContains != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.DescriptionContains(*l.EdgeFriendDescriptionContains)))
+	if p.EdgeFriendDescriptionContains != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.DescriptionContains(*p.EdgeFriendDescriptionContains)))
 	}
-	if l.EdgeFriendDescriptionContainsFold != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.DescriptionContainsFold(*l.EdgeFriendDescriptionContainsFold)))
+	if p.EdgeFriendDescriptionContainsFold != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.DescriptionContainsFold(*p.EdgeFriendDescriptionContainsFold)))
 	}
-	if l.EdgeFriendEnabledEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EnabledEQ(*l.EdgeFriendEnabledEQ)))
+	if p.EdgeFriendEnabledEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EnabledEQ(*p.EdgeFriendEnabledEQ)))
 	}
-	if l.EdgeFriendEmailEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailEQ(*l.EdgeFriendEmailEQ)))
+	if p.EdgeFriendEmailEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailEQ(*p.EdgeFriendEmailEQ)))
 	}
-	if l.EdgeFriendEmailNEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailNEQ(*l.EdgeFriendEmailNEQ)))
+	if p.EdgeFriendEmailNEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailNEQ(*p.EdgeFriendEmailNEQ)))
 	}
-	if l.EdgeFriendEmailIsNil != nil {
-		if *l.EdgeFriendEmailIsNil {
+	if p.EdgeFriendEmailIsNil != nil {
+		if *p.EdgeFriendEmailIsNil {
 			predicates = append(predicates, friendship.HasFriendWith(user.EmailIsNil()))
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasFriendWith(user.EmailIsNil())))
 		}
 	}
-	if l.EdgeFriendEmailIn != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailIn(l.EdgeFriendEmailIn...)))
+	if p.EdgeFriendEmailIn != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailIn(p.EdgeFriendEmailIn...)))
 	}
-	if l.EdgeFriendEmailNotIn != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailNotIn(l.EdgeFriendEmailNotIn...)))
+	if p.EdgeFriendEmailNotIn != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailNotIn(p.EdgeFriendEmailNotIn...)))
 	}
-	if l.EdgeFriendEmailEqualFold != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailEqualFold(*l.EdgeFriendEmailEqualFold)))
+	if p.EdgeFriendEmailEqualFold != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailEqualFold(*p.EdgeFriendEmailEqualFold)))
 	}
-	if l.EdgeFriendEmailContains != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailContains(*l.EdgeFriendEmailContains)))
+	if p.EdgeFriendEmailContains != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailContains(*p.EdgeFriendEmailContains)))
 	}
-	if l.EdgeFriendEmailContainsFold != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailContainsFold(*l.EdgeFriendEmailContainsFold)))
+	if p.EdgeFriendEmailContainsFold != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailContainsFold(*p.EdgeFriendEmailContainsFold)))
 	}
-	if l.EdgeFriendEmailHasPrefix != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailHasPrefix(*l.EdgeFriendEmailHasPrefix)))
+	if p.EdgeFriendEmailHasPrefix != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailHasPrefix(*p.EdgeFriendEmailHasPrefix)))
 	}
-	if l.EdgeFriendEmailHasSuffix != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.EmailHasSuffix(*l.EdgeFriendEmailHasSuffix)))
+	if p.EdgeFriendEmailHasSuffix != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.EmailHasSuffix(*p.EdgeFriendEmailHasSuffix)))
 	}
-	if l.EdgeFriendLastAuthenticatedAtEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.LastAuthenticatedAtEQ(*l.EdgeFriendLastAuthenticatedAtEQ)))
+	if p.EdgeFriendLastAuthenticatedAtEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.LastAuthenticatedAtEQ(*p.EdgeFriendLastAuthenticatedAtEQ)))
 	}
-	if l.EdgeFriendLastAuthenticatedAtNEQ != nil {
-		predicates = append(predicates, friendship.HasFriendWith(user.LastAuthenticatedAtNEQ(*l.EdgeFriendLastAuthenticatedAtNEQ)))
+	if p.EdgeFriendLastAuthenticatedAtNEQ != nil {
+		predicates = append(predicates, friendship.HasFriendWith(user.LastAuthenticatedAtNEQ(*p.EdgeFriendLastAuthenticatedAtNEQ)))
 	}
-	if l.EdgeFriendLastAuthenticatedAtIsNil != nil {
-		if *l.EdgeFriendLastAuthenticatedAtIsNil {
+	if p.EdgeFriendLastAuthenticatedAtIsNil != nil {
+		if *p.EdgeFriendLastAuthenticatedAtIsNil {
 			predicates = append(predicates, friendship.HasFriendWith(user.LastAuthenticatedAtIsNil()))
 		} else {
 			predicates = append(predicates, friendship.Not(friendship.HasFriendWith(user.LastAuthenticatedAtIsNil())))
 		}
 	}
 
-	return l.ApplyFilterOperation(predicates...)
-}
-
-// ApplySorting applies sorting to the query based on the provided sort and order fields.
-func (l *ListFriendshipParams) ApplySorting(query *ent.FriendshipQuery) error {
-	if err := l.Sorted.Validate(FriendshipSortConfig); err != nil {
-		return err
-	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
-		return nil
-	}
-	applySortingFriendship(query, *l.Field, *l.Order)
-	return nil
-}
-
-// Exec wraps all logic (filtering, sorting, pagination, eager loading) and
-// executes all necessary queries, returning the results.
-func (l *ListFriendshipParams) Exec(ctx context.Context, query *ent.FriendshipQuery) (results *PagedResponse[ent.Friendship], err error) {
-	predicates, err := l.FilterPredicates()
-	if err != nil {
-		return nil, err
-	}
-	query.Where(predicates)
-	err = l.ApplySorting(EagerLoadFriendship(query))
+	pred, err := p.ApplyFilterOperation(predicates...)
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, FriendshipPageConfig)
+	return p.ApplyFilterExpression(pred, filterPredicateFriendship)
 }
 
-// ListPetParams defines parameters for listing Pets via a GET request.
-type ListPetParams struct {
-	Sorted
-	Paginated[*ent.PetQuery, ent.Pet]
-	Filtered[predicate.Pet]
-
-	// Filters field "id" to be equal to the provided value.
-	PetIDEQ *int `form:"id.eq,omitempty" json:"pet_ideq,omitempty"`
-	// Filters field "id" to be not equal to the provided value.
-	PetIDNEQ *int `form:"id.neq,omitempty" json:"pet_idneq,omitempty"`
-	// Filters field "id" to be within the provided values.
-	PetIDIn []int `form:"id.in,omitempty" json:"pet_id_in,omitempty"`
-	// Filters field "id" to be not within the provided values.
+// filterPredicateFriendship returns the predicate for a single filter within a filter
+// expression, where key is the name of the associated filter parameter.
+func filterPredicateFriendship(key string, value json.RawMessage) (predicate.Friendship, error) {
+	switch key {
+	case "id.eq":
+		var v struct{ FriendshipIDEQ *int }
+		if err := json.Unmarshal(value, &v.FriendshipIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.IDEQ(*v.FriendshipIDEQ), nil
+	case "id.neq":
+		var v struct{ FriendshipIDNEQ *int }
+		if err := json.Unmarshal(value, &v.FriendshipIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.IDNEQ(*v.FriendshipIDNEQ), nil
+	case "id.in":
+		var v struct{ FriendshipIDIn []int }
+		if err := json.Unmarshal(value, &v.FriendshipIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.IDIn(v.FriendshipIDIn...), nil
+	case "id.notIn":
+		var v struct{ FriendshipIDNotIn []int }
+		if err := json.Unmarshal(value, &v.FriendshipIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.IDNotIn(v.FriendshipIDNotIn...), nil
+	case "userID.eq":
+		var v struct{ FriendshipUserIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipUserIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.UserIDEQ(*v.FriendshipUserIDEQ), nil
+	case "userID.neq":
+		var v struct{ FriendshipUserIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipUserIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.UserIDNEQ(*v.FriendshipUserIDNEQ), nil
+	case "userID.in":
+		var v struct{ FriendshipUserIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipUserIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.UserIDIn(v.FriendshipUserIDIn...), nil
+	case "userID.notIn":
+		var v struct{ FriendshipUserIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipUserIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.UserIDNotIn(v.FriendshipUserIDNotIn...), nil
+	case "friendID.eq":
+		var v struct{ FriendshipFriendIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipFriendIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.FriendIDEQ(*v.FriendshipFriendIDEQ), nil
+	case "friendID.neq":
+		var v struct{ FriendshipFriendIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipFriendIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.FriendIDNEQ(*v.FriendshipFriendIDNEQ), nil
+	case "friendID.in":
+		var v struct{ FriendshipFriendIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipFriendIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.FriendIDIn(v.FriendshipFriendIDIn...), nil
+	case "friendID.notIn":
+		var v struct{ FriendshipFriendIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.FriendshipFriendIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.FriendIDNotIn(v.FriendshipFriendIDNotIn...), nil
+	case "has.user":
+		var v struct{ EdgeHasUser *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasUser); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasUser {
+			return friendship.Not(friendship.HasUser()), nil
+		}
+		return friendship.HasUser(), nil
+	case "user.createdAt.gt":
+		var v struct{ EdgeUserCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeUserCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.CreatedAtGT(*v.EdgeUserCreatedAtGT)), nil
+	case "user.createdAt.lt":
+		var v struct{ EdgeUserCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeUserCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.CreatedAtLT(*v.EdgeUserCreatedAtLT)), nil
+	case "user.updatedAt.gt":
+		var v struct{ EdgeUserUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeUserUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.UpdatedAtGT(*v.EdgeUserUpdatedAtGT)), nil
+	case "user.updatedAt.lt":
+		var v struct{ EdgeUserUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeUserUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.UpdatedAtLT(*v.EdgeUserUpdatedAtLT)), nil
+	case "user.name.eq":
+		var v struct{ EdgeUserNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameEQ(*v.EdgeUserNameEQ)), nil
+	case "user.name.neq":
+		var v struct{ EdgeUserNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameNEQ(*v.EdgeUserNameNEQ)), nil
+	case "user.name.in":
+		var v struct{ EdgeUserNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameIn(v.EdgeUserNameIn...)), nil
+	case "user.name.notIn":
+		var v struct{ EdgeUserNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameNotIn(v.EdgeUserNameNotIn...)), nil
+	case "user.name.ieq":
+		var v struct{ EdgeUserNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameEqualFold(*v.EdgeUserNameEqualFold)), nil
+	case "user.name.has":
+		var v struct{ EdgeUserNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameContains(*v.EdgeUserNameContains)), nil
+	case "user.name.ihas":
+		var v struct{ EdgeUserNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameContainsFold(*v.EdgeUserNameContainsFold)), nil
+	case "user.name.prefix":
+		var v struct{ EdgeUserNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameHasPrefix(*v.EdgeUserNameHasPrefix)), nil
+	case "user.name.suffix":
+		var v struct{ EdgeUserNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeUserNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.NameHasSuffix(*v.EdgeUserNameHasSuffix)), nil
+	case "user.type.eq":
+		var v struct{ EdgeUserTypeEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeUserTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.TypeEQ(*v.EdgeUserTypeEQ)), nil
+	case "user.type.neq":
+		var v struct{ EdgeUserTypeNEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeUserTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.TypeNEQ(*v.EdgeUserTypeNEQ)), nil
+	case "user.type.in":
+		var v struct{ EdgeUserTypeIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeUserTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.TypeIn(v.EdgeUserTypeIn...)), nil
+	case "user.type.notIn":
+		var v struct{ EdgeUserTypeNotIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeUserTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.TypeNotIn(v.EdgeUserTypeNotIn...)), nil
+	case "user.description.null":
+		var v struct{ EdgeUserDescriptionIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeUserDescriptionIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeUserDescriptionIsNil {
+			return friendship.Not(friendship.HasUserWith(user.DescriptionIsNil())), nil
+		}
+		return friendship.HasUserWith(user.DescriptionIsNil()), nil
+	case "user.description.has":
+		var v struct{ EdgeUserDescriptionContains *string }
+		if err := json.Unmarshal(value, &v.EdgeUserDescriptionContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.DescriptionContains(*v.EdgeUserDescriptionContains)), nil
+	case "user.description.ihas":
+		var v struct{ EdgeUserDescriptionContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeUserDescriptionContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.DescriptionContainsFold(*v.EdgeUserDescriptionContainsFold)), nil
+	case "user.enabled.eq":
+		var v struct{ EdgeUserEnabledEQ *bool }
+		if err := json.Unmarshal(value, &v.EdgeUserEnabledEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EnabledEQ(*v.EdgeUserEnabledEQ)), nil
+	case "user.email.eq":
+		var v struct{ EdgeUserEmailEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailEQ(*v.EdgeUserEmailEQ)), nil
+	case "user.email.neq":
+		var v struct{ EdgeUserEmailNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailNEQ(*v.EdgeUserEmailNEQ)), nil
+	case "user.email.null":
+		var v struct{ EdgeUserEmailIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeUserEmailIsNil {
+			return friendship.Not(friendship.HasUserWith(user.EmailIsNil())), nil
+		}
+		return friendship.HasUserWith(user.EmailIsNil()), nil
+	case "user.email.in":
+		var v struct{ EdgeUserEmailIn []string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailIn(v.EdgeUserEmailIn...)), nil
+	case "user.email.notIn":
+		var v struct{ EdgeUserEmailNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailNotIn(v.EdgeUserEmailNotIn...)), nil
+	case "user.email.ieq":
+		var v struct{ EdgeUserEmailEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailEqualFold(*v.EdgeUserEmailEqualFold)), nil
+	case "user.email.has":
+		var v struct{ EdgeUserEmailContains *string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailContains(*v.EdgeUserEmailContains)), nil
+	case "user.email.ihas":
+		var v struct{ EdgeUserEmailContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailContainsFold(*v.EdgeUserEmailContainsFold)), nil
+	case "user.email.prefix":
+		var v struct{ EdgeUserEmailHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailHasPrefix(*v.EdgeUserEmailHasPrefix)), nil
+	case "user.email.suffix":
+		var v struct{ EdgeUserEmailHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeUserEmailHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.EmailHasSuffix(*v.EdgeUserEmailHasSuffix)), nil
+	case "user.lastAuthenticatedAt.eq":
+		var v struct{ EdgeUserLastAuthenticatedAtEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeUserLastAuthenticatedAtEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.LastAuthenticatedAtEQ(*v.EdgeUserLastAuthenticatedAtEQ)), nil
+	case "user.lastAuthenticatedAt.neq":
+		var v struct{ EdgeUserLastAuthenticatedAtNEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeUserLastAuthenticatedAtNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasUserWith(user.LastAuthenticatedAtNEQ(*v.EdgeUserLastAuthenticatedAtNEQ)), nil
+	case "user.lastAuthenticatedAt.null":
+		var v struct{ EdgeUserLastAuthenticatedAtIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeUserLastAuthenticatedAtIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeUserLastAuthenticatedAtIsNil {
+			return friendship.Not(friendship.HasUserWith(user.LastAuthenticatedAtIsNil())), nil
+		}
+		return friendship.HasUserWith(user.LastAuthenticatedAtIsNil()), nil
+	case "has.friend":
+		var v struct{ EdgeHasFriend *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFriend); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFriend {
+			return friendship.Not(friendship.HasFriend()), nil
+		}
+		return friendship.HasFriend(), nil
+	case "friend.createdAt.gt":
+		var v struct{ EdgeFriendCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.CreatedAtGT(*v.EdgeFriendCreatedAtGT)), nil
+	case "friend.createdAt.lt":
+		var v struct{ EdgeFriendCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.CreatedAtLT(*v.EdgeFriendCreatedAtLT)), nil
+	case "friend.updatedAt.gt":
+		var v struct{ EdgeFriendUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.UpdatedAtGT(*v.EdgeFriendUpdatedAtGT)), nil
+	case "friend.updatedAt.lt":
+		var v struct{ EdgeFriendUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.UpdatedAtLT(*v.EdgeFriendUpdatedAtLT)), nil
+	case "friend.name.eq":
+		var v struct{ EdgeFriendNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameEQ(*v.EdgeFriendNameEQ)), nil
+	case "friend.name.neq":
+		var v struct{ EdgeFriendNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameNEQ(*v.EdgeFriendNameNEQ)), nil
+	case "friend.name.in":
+		var v struct{ EdgeFriendNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameIn(v.EdgeFriendNameIn...)), nil
+	case "friend.name.notIn":
+		var v struct{ EdgeFriendNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameNotIn(v.EdgeFriendNameNotIn...)), nil
+	case "friend.name.ieq":
+		var v struct{ EdgeFriendNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameEqualFold(*v.EdgeFriendNameEqualFold)), nil
+	case "friend.name.has":
+		var v struct{ EdgeFriendNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameContains(*v.EdgeFriendNameContains)), nil
+	case "friend.name.ihas":
+		var v struct{ EdgeFriendNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameContainsFold(*v.EdgeFriendNameContainsFold)), nil
+	case "friend.name.prefix":
+		var v struct{ EdgeFriendNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameHasPrefix(*v.EdgeFriendNameHasPrefix)), nil
+	case "friend.name.suffix":
+		var v struct{ EdgeFriendNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.NameHasSuffix(*v.EdgeFriendNameHasSuffix)), nil
+	case "friend.type.eq":
+		var v struct{ EdgeFriendTypeEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.TypeEQ(*v.EdgeFriendTypeEQ)), nil
+	case "friend.type.neq":
+		var v struct{ EdgeFriendTypeNEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.TypeNEQ(*v.EdgeFriendTypeNEQ)), nil
+	case "friend.type.in":
+		var v struct{ EdgeFriendTypeIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.TypeIn(v.EdgeFriendTypeIn...)), nil
+	case "friend.type.notIn":
+		var v struct{ EdgeFriendTypeNotIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.TypeNotIn(v.EdgeFriendTypeNotIn...)), nil
+	case "friend.description.null":
+		var v struct{ EdgeFriendDescriptionIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendDescriptionIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFriendDescriptionIsNil {
+			return friendship.Not(friendship.HasFriendWith(user.DescriptionIsNil())), nil
+		}
+		return friendship.HasFriendWith(user.DescriptionIsNil()), nil
+	case "friend.description.has":
+		var v struct{ EdgeFriendDescriptionContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendDescriptionContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.DescriptionContains(*v.EdgeFriendDescriptionContains)), nil
+	case "friend.description.ihas":
+		var v struct{ EdgeFriendDescriptionContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendDescriptionContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.DescriptionContainsFold(*v.EdgeFriendDescriptionContainsFold)), nil
+	case "friend.enabled.eq":
+		var v struct{ EdgeFriendEnabledEQ *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendEnabledEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EnabledEQ(*v.EdgeFriendEnabledEQ)), nil
+	case "friend.email.eq":
+		var v struct{ EdgeFriendEmailEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailEQ(*v.EdgeFriendEmailEQ)), nil
+	case "friend.email.neq":
+		var v struct{ EdgeFriendEmailNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailNEQ(*v.EdgeFriendEmailNEQ)), nil
+	case "friend.email.null":
+		var v struct{ EdgeFriendEmailIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFriendEmailIsNil {
+			return friendship.Not(friendship.HasFriendWith(user.EmailIsNil())), nil
+		}
+		return friendship.HasFriendWith(user.EmailIsNil()), nil
+	case "friend.email.in":
+		var v struct{ EdgeFriendEmailIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailIn(v.EdgeFriendEmailIn...)), nil
+	case "friend.email.notIn":
+		var v struct{ EdgeFriendEmailNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailNotIn(v.EdgeFriendEmailNotIn...)), nil
+	case "friend.email.ieq":
+		var v struct{ EdgeFriendEmailEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailEqualFold(*v.EdgeFriendEmailEqualFold)), nil
+	case "friend.email.has":
+		var v struct{ EdgeFriendEmailContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailContains(*v.EdgeFriendEmailContains)), nil
+	case "friend.email.ihas":
+		var v struct{ EdgeFriendEmailContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailContainsFold(*v.EdgeFriendEmailContainsFold)), nil
+	case "friend.email.prefix":
+		var v struct{ EdgeFriendEmailHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailHasPrefix(*v.EdgeFriendEmailHasPrefix)), nil
+	case "friend.email.suffix":
+		var v struct{ EdgeFriendEmailHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.EmailHasSuffix(*v.EdgeFriendEmailHasSuffix)), nil
+	case "friend.lastAuthenticatedAt.eq":
+		var v struct{ EdgeFriendLastAuthenticatedAtEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendLastAuthenticatedAtEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.LastAuthenticatedAtEQ(*v.EdgeFriendLastAuthenticatedAtEQ)), nil
+	case "friend.lastAuthenticatedAt.neq":
+		var v struct{ EdgeFriendLastAuthenticatedAtNEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendLastAuthenticatedAtNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return friendship.HasFriendWith(user.LastAuthenticatedAtNEQ(*v.EdgeFriendLastAuthenticatedAtNEQ)), nil
+	case "friend.lastAuthenticatedAt.null":
+		var v struct{ EdgeFriendLastAuthenticatedAtIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendLastAuthenticatedAtIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFriendLastAuthenticatedAtIsNil {
+			return friendship.Not(friendship.HasFriendWith(user.LastAuthenticatedAtIsNil())), nil
+		}
+		return friendship.HasFriendWith(user.LastAuthenticatedAtIsNil()), nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q", key)
+	}
+}
+
+// ApplySorting applies sorting to the query based on the provided sort and order fields.
+func (l *ListFriendshipParams) ApplySorting(query *ent.FriendshipQuery) error {
+	if err := l.Sorted.Validate(FriendshipSortConfig); err != nil {
+		return err
+	}
+	if len(l.terms) == 0 { // No custom sort fields provided and no defaults, so don't do anything.
+		return nil
+	}
+	for _, term := range l.terms {
+		applySortingFriendship(query, term.field, term.order, term.nulls)
+	}
+	if !l.sortedBy(friendship.FieldID) && !l.sortedBy("random") {
+		// Use the ID as a tie-breaker, so the order is stable across pages.
+		query.Order(withFieldSelector(friendship.FieldID, l.terms[len(l.terms)-1].order, ""))
+	}
+	return nil
+}
+
+// Exec wraps all logic (filtering, sorting, pagination, eager loading) and
+// executes all necessary queries, returning the results.
+func (l *ListFriendshipParams) Exec(ctx context.Context, query *ent.FriendshipQuery) (results *PagedResponse[ent.Friendship], err error) {
+	predicates, err := l.FilterPredicates()
+	if err != nil {
+		return nil, err
+	}
+	query.Where(predicates)
+	EagerLoadFriendship(query)
+	err = l.ApplySorting(query)
+	if err != nil {
+		return nil, err
+	}
+	l.selector = func(ctx context.Context) (*sql.Selector, error) {
+		return resolveSelector(func(fn func(*sql.Selector)) (int, error) {
+			return query.Clone().Where(fn).Count(ctx)
+		})
+	}
+	// Fields are selected after counting, so the count isn't affected by the selected columns.
+	_, err = l.ApplyPagination(ctx, query, FriendshipPageConfig)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = SelectFriendship(query, l.Fields); err != nil {
+		return nil, err
+	}
+	return l.ExecutePaginated(ctx, query, FriendshipPageConfig)
+}
+
+// FilterPetParams defines the filter-related parameters for Pets, which
+// are shared by all operations which filter Pets (e.g. list and bulk operations).
+type FilterPetParams struct {
+	Filtered[predicate.Pet]
+
+	// Filters field "id" to be equal to the provided value.
+	PetIDEQ *int `form:"id.eq,omitempty" json:"pet_ideq,omitempty"`
+	// Filters field "id" to be not equal to the provided value.
+	PetIDNEQ *int `form:"id.neq,omitempty" json:"pet_idneq,omitempty"`
+	// Filters field "id" to be within the provided values.
+	PetIDIn []int `form:"id.in,omitempty" json:"pet_id_in,omitempty"`
+	// Filters field "id" to be not within the provided values.
 	PetIDNotIn []int `form:"id.notIn,omitempty" json:"pet_id_not_in,omitempty"`
 	// Filters field "name" to be equal to the provided value.
 	PetNameEQ *string `form:"name.eq,omitempty" json:"pet_name_eq,omitempty"`
@@ -1120,475 +2252,1361 @@ type ListPetParams struct {
 	EdgeHasFollowing *bool `form:"has.following,omitempty" json:"edge_has_following,omitempty"`
 }
 
+// ListPetParams defines parameters for listing Pets via a GET request.
+type ListPetParams struct {
+	Sorted
+	Paginated[*ent.PetQuery, ent.Pet]
+	FilterPetParams
+	// Expand contains the edges requested to be eager-loaded by the client.
+	Expand []string `json:"expand,omitempty" form:"expand,omitempty"`
+	// Fields contains the fields requested by the client. As "fields" and "fields[<edge>]"
+	// can't be decoded together, this is populated from [ParseFieldSelection] instead.
+	Fields *FieldSelection `json:"-" form:"-"`
+}
+
 // FilterPredicates returns the predicates for filter-related parameters in Pet.
-func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
+func (p *FilterPetParams) FilterPredicates() (predicate.Pet, error) {
 	var predicates []predicate.Pet
 
-	if l.PetIDEQ != nil {
-		predicates = append(predicates, pet.IDEQ(*l.PetIDEQ))
+	if p.PetIDEQ != nil {
+		predicates = append(predicates, pet.IDEQ(*p.PetIDEQ))
 	}
-	if l.PetIDNEQ != nil {
-		predicates = append(predicates, pet.IDNEQ(*l.PetIDNEQ))
+	if p.PetIDNEQ != nil {
+		predicates = append(predicates, pet.IDNEQ(*p.PetIDNEQ))
 	}
-	if l.PetIDIn != nil {
-		predicates = append(predicates, pet.IDIn(l.PetIDIn...))
+	if p.PetIDIn != nil {
+		predicates = append(predicates, pet.IDIn(p.PetIDIn...))
 	}
-	if l.PetIDNotIn != nil {
-		predicates = append(predicates, pet.IDNotIn(l.PetIDNotIn...))
+	if p.PetIDNotIn != nil {
+		predicates = append(predicates, pet.IDNotIn(p.PetIDNotIn...))
 	}
-	if l.PetNameEQ != nil {
-		predicates = append(predicates, pet.NameEQ(*l.PetNameEQ))
+	if p.PetNameEQ != nil {
+		predicates = append(predicates, pet.NameEQ(*p.PetNameEQ))
 	}
-	if l.PetNameNEQ != nil {
-		predicates = append(predicates, pet.NameNEQ(*l.PetNameNEQ))
+	if p.PetNameNEQ != nil {
+		predicates = append(predicates, pet.NameNEQ(*p.PetNameNEQ))
 	}
-	if l.PetNameIn != nil {
-		predicates = append(predicates, pet.NameIn(l.PetNameIn...))
+	if p.PetNameIn != nil {
+		predicates = append(predicates, pet.NameIn(p.PetNameIn...))
 	}
-	if l.PetNameNotIn != nil {
-		predicates = append(predicates, pet.NameNotIn(l.PetNameNotIn...))
+	if p.PetNameNotIn != nil {
+		predicates = append(predicates, pet.NameNotIn(p.PetNameNotIn...))
 	}
-	if l.PetNameEqualFold != nil {
-		predicates = append(predicates, pet.NameEqualFold(*l.PetNameEqualFold))
+	if p.PetNameEqualFold != nil {
+		predicates = append(predicates, pet.NameEqualFold(*p.PetNameEqualFold))
 	}
-	if l.PetNameContains != nil {
-		predicates = append(predicates, pet.NameContains(*l.PetNameContains))
+	if p.PetNameContains != nil {
+		predicates = append(predicates, pet.NameContains(*p.PetNameContains))
 	}
-	if l.PetNameContainsFold != nil {
-		predicates = append(predicates, pet.NameContainsFold(*l.PetNameContainsFold))
+	if p.PetNameContainsFold != nil {
+		predicates = append(predicates, pet.NameContainsFold(*p.PetNameContainsFold))
 	}
-	if l.PetNameHasPrefix != nil {
-		predicates = append(predicates, pet.NameHasPrefix(*l.PetNameHasPrefix))
+	if p.PetNameHasPrefix != nil {
+		predicates = append(predicates, pet.NameHasPrefix(*p.PetNameHasPrefix))
 	}
-	if l.PetNameHasSuffix != nil {
-		predicates = append(predicates, pet.NameHasSuffix(*l.PetNameHasSuffix))
+	if p.PetNameHasSuffix != nil {
+		predicates = append(predicates, pet.NameHasSuffix(*p.PetNameHasSuffix))
 	}
-	if l.PetNicknamesIsNil != nil {
-		if *l.PetNicknamesIsNil {
+	if p.PetNicknamesIsNil != nil {
+		if *p.PetNicknamesIsNil {
 			predicates = append(predicates, pet.NicknamesIsNil())
 		} else {
 			predicates = append(predicates, pet.Not(pet.NicknamesIsNil()))
 		}
 	}
-	if l.PetAgeEQ != nil {
-		predicates = append(predicates, pet.AgeEQ(*l.PetAgeEQ))
+	if p.PetAgeEQ != nil {
+		predicates = append(predicates, pet.AgeEQ(*p.PetAgeEQ))
 	}
-	if l.PetAgeNEQ != nil {
-		predicates = append(predicates, pet.AgeNEQ(*l.PetAgeNEQ))
+	if p.PetAgeNEQ != nil {
+		predicates = append(predicates, pet.AgeNEQ(*p.PetAgeNEQ))
 	}
-	if l.PetAgeGT != nil {
-		predicates = append(predicates, pet.AgeGT(*l.PetAgeGT))
+	if p.PetAgeGT != nil {
+		predicates = append(predicates, pet.AgeGT(*p.PetAgeGT))
 	}
-	if l.PetAgeLT != nil {
-		predicates = append(predicates, pet.AgeLT(*l.PetAgeLT))
+	if p.PetAgeLT != nil {
+		predicates = append(predicates, pet.AgeLT(*p.PetAgeLT))
 	}
-	if l.PetAgeIn != nil {
-		predicates = append(predicates, pet.AgeIn(l.PetAgeIn...))
+	if p.PetAgeIn != nil {
+		predicates = append(predicates, pet.AgeIn(p.PetAgeIn...))
 	}
-	if l.PetAgeNotIn != nil {
-		predicates = append(predicates, pet.AgeNotIn(l.PetAgeNotIn...))
+	if p.PetAgeNotIn != nil {
+		predicates = append(predicates, pet.AgeNotIn(p.PetAgeNotIn...))
 	}
-	if l.PetTypeEQ != nil {
-		predicates = append(predicates, pet.TypeEQ(*l.PetTypeEQ))
+	if p.PetTypeEQ != nil {
+		predicates = append(predicates, pet.TypeEQ(*p.PetTypeEQ))
 	}
-	if l.PetTypeNEQ != nil {
-		predicates = append(predicates, pet.TypeNEQ(*l.PetTypeNEQ))
+	if p.PetTypeNEQ != nil {
+		predicates = append(predicates, pet.TypeNEQ(*p.PetTypeNEQ))
 	}
-	if l.PetTypeIn != nil {
-		predicates = append(predicates, pet.TypeIn(l.PetTypeIn...))
+	if p.PetTypeIn != nil {
+		predicates = append(predicates, pet.TypeIn(p.PetTypeIn...))
 	}
-	if l.PetTypeNotIn != nil {
-		predicates = append(predicates, pet.TypeNotIn(l.PetTypeNotIn...))
+	if p.PetTypeNotIn != nil {
+		predicates = append(predicates, pet.TypeNotIn(p.PetTypeNotIn...))
 	}
-	if l.EdgeHasCategory != nil {
-		if *l.EdgeHasCategory {
+	if p.EdgeHasCategory != nil {
+		if *p.EdgeHasCategory {
 			predicates = append(predicates, pet.HasCategories())
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasCategories()))
 		}
 	}
-	if l.EdgeCategoryIDEQ != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.IDEQ(*l.EdgeCategoryIDEQ)))
+	if p.EdgeCategoryIDEQ != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.IDEQ(*p.EdgeCategoryIDEQ)))
 	}
-	if l.EdgeCategoryIDNEQ != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.IDNEQ(*l.EdgeCategoryIDNEQ)))
+	if p.EdgeCategoryIDNEQ != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.IDNEQ(*p.EdgeCategoryIDNEQ)))
 	}
-	if l.EdgeCategoryIDIn != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.IDIn(l.EdgeCategoryIDIn...)))
+	if p.EdgeCategoryIDIn != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.IDIn(p.EdgeCategoryIDIn...)))
 	}
-	if l.EdgeCategoryIDNotIn != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.IDNotIn(l.EdgeCategoryIDNotIn...)))
+	if p.EdgeCategoryIDNotIn != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.IDNotIn(p.EdgeCategoryIDNotIn...)))
 	}
-	if l.EdgeCategoryCreatedAtGT != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.CreatedAtGT(*l.EdgeCategoryCreatedAtGT)))
+	if p.EdgeCategoryCreatedAtGT != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.CreatedAtGT(*p.EdgeCategoryCreatedAtGT)))
 	}
-	if l.EdgeCategoryCreatedAtLT != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.CreatedAtLT(*l.EdgeCategoryCreatedAtLT)))
+	if p.EdgeCategoryCreatedAtLT != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.CreatedAtLT(*p.EdgeCategoryCreatedAtLT)))
 	}
-	if l.EdgeCategoryUpdatedAtGT != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.UpdatedAtGT(*l.EdgeCategoryUpdatedAtGT)))
+	if p.EdgeCategoryUpdatedAtGT != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.UpdatedAtGT(*p.EdgeCategoryUpdatedAtGT)))
 	}
-	if l.EdgeCategoryUpdatedAtLT != nil {
-		predicates = append(predicates, pet.HasCategoriesWith(category.UpdatedAtLT(*l.EdgeCategoryUpdatedAtLT)))
+	if p.EdgeCategoryUpdatedAtLT != nil {
+		predicates = append(predicates, pet.HasCategoriesWith(category.UpdatedAtLT(*p.EdgeCategoryUpdatedAtLT)))
 	}
-	if l.EdgeHasOwner != nil {
-		if *l.EdgeHasOwner {
+	if p.EdgeHasOwner != nil {
+		if *p.EdgeHasOwner {
 			predicates = append(predicates, pet.HasOwner())
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasOwner()))
 		}
 	}
-	if l.EdgeOwnerIDEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.IDEQ(*l.EdgeOwnerIDEQ)))
+	if p.EdgeOwnerIDEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.IDEQ(*p.EdgeOwnerIDEQ)))
 	}
-	if l.EdgeOwnerIDNEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.IDNEQ(*l.EdgeOwnerIDNEQ)))
+	if p.EdgeOwnerIDNEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.IDNEQ(*p.EdgeOwnerIDNEQ)))
 	}
-	if l.EdgeOwnerIDIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.IDIn(l.EdgeOwnerIDIn...)))
+	if p.EdgeOwnerIDIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.IDIn(p.EdgeOwnerIDIn...)))
 	}
-	if l.EdgeOwnerIDNotIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.IDNotIn(l.EdgeOwnerIDNotIn...)))
+	if p.EdgeOwnerIDNotIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.IDNotIn(p.EdgeOwnerIDNotIn...)))
 	}
-	if l.EdgeOwnerCreatedAtGT != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.CreatedAtGT(*l.EdgeOwnerCreatedAtGT)))
+	if p.EdgeOwnerCreatedAtGT != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.CreatedAtGT(*p.EdgeOwnerCreatedAtGT)))
 	}
-	if l.EdgeOwnerCreatedAtLT != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.CreatedAtLT(*l.EdgeOwnerCreatedAtLT)))
+	if p.EdgeOwnerCreatedAtLT != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.CreatedAtLT(*p.EdgeOwnerCreatedAtLT)))
 	}
-	if l.EdgeOwnerUpdatedAtGT != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.UpdatedAtGT(*l.EdgeOwnerUpdatedAtGT)))
+	if p.EdgeOwnerUpdatedAtGT != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UpdatedAtGT(*p.EdgeOwnerUpdatedAtGT)))
 	}
-	if l.EdgeOwnerUpdatedAtLT != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.UpdatedAtLT(*l.EdgeOwnerUpdatedAtLT)))
+	if p.EdgeOwnerUpdatedAtLT != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UpdatedAtLT(*p.EdgeOwnerUpdatedAtLT)))
 	}
-	if l.EdgeOwnerNameEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameEQ(*l.EdgeOwnerNameEQ)))
+	if p.EdgeOwnerNameEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameEQ(*p.EdgeOwnerNameEQ)))
 	}
-	if l.EdgeOwnerNameNEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameNEQ(*l.EdgeOwnerNameNEQ)))
+	if p.EdgeOwnerNameNEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameNEQ(*p.EdgeOwnerNameNEQ)))
 	}
-	if l.EdgeOwnerNameIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameIn(l.EdgeOwnerNameIn...)))
+	if p.EdgeOwnerNameIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameIn(p.EdgeOwnerNameIn...)))
 	}
-	if l.EdgeOwnerNameNotIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameNotIn(l.EdgeOwnerNameNotIn...)))
+	if p.EdgeOwnerNameNotIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameNotIn(p.EdgeOwnerNameNotIn...)))
 	}
-	if l.EdgeOwnerNameEqualFold != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameEqualFold(*l.EdgeOwnerNameEqualFold)))
+	if p.EdgeOwnerNameEqualFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameEqualFold(*p.EdgeOwnerNameEqualFold)))
 	}
-	if l.EdgeOwnerNameContains != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameContains(*l.EdgeOwnerNameContains)))
+	if p.EdgeOwnerNameContains != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameContains(*p.EdgeOwnerNameContains)))
 	}
-	if l.EdgeOwnerNameContainsFold != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameContainsFold(*l.EdgeOwnerNameContainsFold)))
+	if p.EdgeOwnerNameContainsFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameContainsFold(*p.EdgeOwnerNameContainsFold)))
 	}
-	if l.EdgeOwnerNameHasPrefix != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameHasPrefix(*l.EdgeOwnerNameHasPrefix)))
+	if p.EdgeOwnerNameHasPrefix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameHasPrefix(*p.EdgeOwnerNameHasPrefix)))
 	}
-	if l.EdgeOwnerNameHasSuffix != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.NameHasSuffix(*l.EdgeOwnerNameHasSuffix)))
+	if p.EdgeOwnerNameHasSuffix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.NameHasSuffix(*p.EdgeOwnerNameHasSuffix)))
 	}
-	if l.EdgeOwnerTypeEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.TypeEQ(*l.EdgeOwnerTypeEQ)))
+	if p.EdgeOwnerTypeEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.TypeEQ(*p.EdgeOwnerTypeEQ)))
 	}
-	if l.EdgeOwnerTypeNEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.TypeNEQ(*l.EdgeOwnerTypeNEQ)))
+	if p.EdgeOwnerTypeNEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.TypeNEQ(*p.EdgeOwnerTypeNEQ)))
 	}
-	if l.EdgeOwnerTypeIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.TypeIn(l.EdgeOwnerTypeIn...)))
+	if p.EdgeOwnerTypeIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.TypeIn(p.EdgeOwnerTypeIn...)))
 	}
-	if l.EdgeOwnerTypeNotIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.TypeNotIn(l.EdgeOwnerTypeNotIn...)))
+	if p.EdgeOwnerTypeNotIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.TypeNotIn(p.EdgeOwnerTypeNotIn...)))
 	}
-	if l.EdgeOwnerDescriptionIsNil != nil {
-		if *l.EdgeOwnerDescriptionIsNil {
+	if p.EdgeOwnerDescriptionIsNil != nil {
+		if *p.EdgeOwnerDescriptionIsNil {
 			predicates = append(predicates, pet.HasOwnerWith(user.DescriptionIsNil()))
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasOwnerWith(user.DescriptionIsNil())))
 		}
 	}
-	if l.EdgeOwnerDescriptionContains != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.DescriptionContains(*l.EdgeOwnerDescriptionContains)))
+	if p.EdgeOwnerDescriptionContains != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DescriptionContains(*p.EdgeOwnerDescriptionContains)))
 	}
-	if l.EdgeOwnerDescriptionContainsFold != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.DescriptionContainsFold(*l.EdgeOwnerDescriptionContainsFold)))
+	if p.EdgeOwnerDescriptionContainsFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DescriptionContainsFold(*p.EdgeOwnerDescriptionContainsFold)))
 	}
-	if l.EdgeOwnerEnabledEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EnabledEQ(*l.EdgeOwnerEnabledEQ)))
+	if p.EdgeOwnerEnabledEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EnabledEQ(*p.EdgeOwnerEnabledEQ)))
 	}
-	if l.EdgeOwnerEmailEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailEQ(*l.EdgeOwnerEmailEQ)))
+	if p.EdgeOwnerEmailEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailEQ(*p.EdgeOwnerEmailEQ)))
 	}
-	if l.EdgeOwnerEmailNEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailNEQ(*l.EdgeOwnerEmailNEQ)))
+	if p.EdgeOwnerEmailNEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailNEQ(*p.EdgeOwnerEmailNEQ)))
 	}
-	if l.EdgeOwnerEmailIsNil != nil {
-		if *l.EdgeOwnerEmailIsNil {
+	if p.EdgeOwnerEmailIsNil != nil {
+		if *p.EdgeOwnerEmailIsNil {
 			predicates = append(predicates, pet.HasOwnerWith(user.EmailIsNil()))
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasOwnerWith(user.EmailIsNil())))
 		}
 	}
-	if l.EdgeOwnerEmailIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailIn(l.EdgeOwnerEmailIn...)))
+	if p.EdgeOwnerEmailIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailIn(p.EdgeOwnerEmailIn...)))
 	}
-	if l.EdgeOwnerEmailNotIn != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailNotIn(l.EdgeOwnerEmailNotIn...)))
+	if p.EdgeOwnerEmailNotIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailNotIn(p.EdgeOwnerEmailNotIn...)))
 	}
-	if l.EdgeOwnerEmailEqualFold != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailEqualFold(*l.EdgeOwnerEmailEqualFold)))
+	if p.EdgeOwnerEmailEqualFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailEqualFold(*p.EdgeOwnerEmailEqualFold)))
 	}
-	if l.EdgeOwnerEmailContains != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailContains(*l.EdgeOwnerEmailContains)))
+	if p.EdgeOwnerEmailContains != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailContains(*p.EdgeOwnerEmailContains)))
 	}
-	if l.EdgeOwnerEmailContainsFold != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailContainsFold(*l.EdgeOwnerEmailContainsFold)))
+	if p.EdgeOwnerEmailContainsFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailContainsFold(*p.EdgeOwnerEmailContainsFold)))
 	}
-	if l.EdgeOwnerEmailHasPrefix != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailHasPrefix(*l.EdgeOwnerEmailHasPrefix)))
+	if p.EdgeOwnerEmailHasPrefix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailHasPrefix(*p.EdgeOwnerEmailHasPrefix)))
 	}
-	if l.EdgeOwnerEmailHasSuffix != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.EmailHasSuffix(*l.EdgeOwnerEmailHasSuffix)))
+	if p.EdgeOwnerEmailHasSuffix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailHasSuffix(*p.EdgeOwnerEmailHasSuffix)))
 	}
-	if l.EdgeOwnerLastAuthenticatedAtEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.LastAuthenticatedAtEQ(*l.EdgeOwnerLastAuthenticatedAtEQ)))
+	if p.EdgeOwnerLastAuthenticatedAtEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.LastAuthenticatedAtEQ(*p.EdgeOwnerLastAuthenticatedAtEQ)))
 	}
-	if l.EdgeOwnerLastAuthenticatedAtNEQ != nil {
-		predicates = append(predicates, pet.HasOwnerWith(user.LastAuthenticatedAtNEQ(*l.EdgeOwnerLastAuthenticatedAtNEQ)))
+	if p.EdgeOwnerLastAuthenticatedAtNEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.LastAuthenticatedAtNEQ(*p.EdgeOwnerLastAuthenticatedAtNEQ)))
 	}
-	if l.EdgeOwnerLastAuthenticatedAtIsNil != nil {
-		if *l.EdgeOwnerLastAuthenticatedAtIsNil {
+	if p.EdgeOwnerLastAuthenticatedAtIsNil != nil {
+		if *p.EdgeOwnerLastAuthenticatedAtIsNil {
 			predicates = append(predicates, pet.HasOwnerWith(user.LastAuthenticatedAtIsNil()))
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasOwnerWith(user.LastAuthenticatedAtIsNil())))
 		}
 	}
-	if l.EdgeHasFriend != nil {
-		if *l.EdgeHasFriend {
+	if p.EdgeHasFriend != nil {
+		if *p.EdgeHasFriend {
 			predicates = append(predicates, pet.HasFriends())
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasFriends()))
 		}
 	}
-	if l.EdgeFriendIDEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.IDEQ(*l.EdgeFriendIDEQ)))
+	if p.EdgeFriendIDEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.IDEQ(*p.EdgeFriendIDEQ)))
 	}
-	if l.EdgeFriendIDNEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.IDNEQ(*l.EdgeFriendIDNEQ)))
+	if p.EdgeFriendIDNEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.IDNEQ(*p.EdgeFriendIDNEQ)))
 	}
-	if l.EdgeFriendIDIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.IDIn(l.EdgeFriendIDIn...)))
+	if p.EdgeFriendIDIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.IDIn(p.EdgeFriendIDIn...)))
 	}
-	if l.EdgeFriendIDNotIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.IDNotIn(l.EdgeFriendIDNotIn...)))
+	if p.EdgeFriendIDNotIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.IDNotIn(p.EdgeFriendIDNotIn...)))
 	}
-	if l.EdgeFriendNameEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameEQ(*l.EdgeFriendNameEQ)))
+	if p.EdgeFriendNameEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameEQ(*p.EdgeFriendNameEQ)))
 	}
-	if l.EdgeFriendNameNEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameNEQ(*l.EdgeFriendNameNEQ)))
+	if p.EdgeFriendNameNEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameNEQ(*p.EdgeFriendNameNEQ)))
 	}
-	if l.EdgeFriendNameIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameIn(l.EdgeFriendNameIn...)))
+	if p.EdgeFriendNameIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameIn(p.EdgeFriendNameIn...)))
 	}
-	if l.EdgeFriendNameNotIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameNotIn(l.EdgeFriendNameNotIn...)))
+	if p.EdgeFriendNameNotIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameNotIn(p.EdgeFriendNameNotIn...)))
 	}
-	if l.EdgeFriendNameEqualFold != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameEqualFold(*l.EdgeFriendNameEqualFold)))
+	if p.EdgeFriendNameEqualFold != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameEqualFold(*p.EdgeFriendNameEqualFold)))
 	}
-	if l.EdgeFriendNameContains != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameContains(*l.EdgeFriendNameContains)))
+	if p.EdgeFriendNameContains != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameContains(*p.EdgeFriendNameContains)))
 	}
-	if l.EdgeFriendNameContainsFold != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameContainsFold(*l.EdgeFriendNameContainsFold)))
+	if p.EdgeFriendNameContainsFold != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameContainsFold(*p.EdgeFriendNameContainsFold)))
 	}
-	if l.EdgeFriendNameHasPrefix != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameHasPrefix(*l.EdgeFriendNameHasPrefix)))
+	if p.EdgeFriendNameHasPrefix != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameHasPrefix(*p.EdgeFriendNameHasPrefix)))
 	}
-	if l.EdgeFriendNameHasSuffix != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.NameHasSuffix(*l.EdgeFriendNameHasSuffix)))
+	if p.EdgeFriendNameHasSuffix != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameHasSuffix(*p.EdgeFriendNameHasSuffix)))
 	}
-	if l.EdgeFriendNicknamesIsNil != nil {
-		if *l.EdgeFriendNicknamesIsNil {
+	if p.EdgeFriendNicknamesIsNil != nil {
+		if *p.EdgeFriendNicknamesIsNil {
 			predicates = append(predicates, pet.HasFriendsWith(pet.NicknamesIsNil()))
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasFriendsWith(pet.NicknamesIsNil())))
 		}
 	}
-	if l.EdgeFriendAgeEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.AgeEQ(*l.EdgeFriendAgeEQ)))
+	if p.EdgeFriendAgeEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeEQ(*p.EdgeFriendAgeEQ)))
 	}
-	if l.EdgeFriendAgeNEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNEQ(*l.EdgeFriendAgeNEQ)))
+	if p.EdgeFriendAgeNEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNEQ(*p.EdgeFriendAgeNEQ)))
 	}
-	if l.EdgeFriendAgeGT != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.AgeGT(*l.EdgeFriendAgeGT)))
+	if p.EdgeFriendAgeGT != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeGT(*p.EdgeFriendAgeGT)))
 	}
-	if l.EdgeFriendAgeLT != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.AgeLT(*l.EdgeFriendAgeLT)))
+	if p.EdgeFriendAgeLT != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeLT(*p.EdgeFriendAgeLT)))
 	}
-	if l.EdgeFriendAgeIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.AgeIn(l.EdgeFriendAgeIn...)))
+	if p.EdgeFriendAgeIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeIn(p.EdgeFriendAgeIn...)))
 	}
-	if l.EdgeFriendAgeNotIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNotIn(l.EdgeFriendAgeNotIn...)))
+	if p.EdgeFriendAgeNotIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNotIn(p.EdgeFriendAgeNotIn...)))
 	}
-	if l.EdgeFriendTypeEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.TypeEQ(*l.EdgeFriendTypeEQ)))
+	if p.EdgeFriendTypeEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeEQ(*p.EdgeFriendTypeEQ)))
 	}
-	if l.EdgeFriendTypeNEQ != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNEQ(*l.EdgeFriendTypeNEQ)))
+	if p.EdgeFriendTypeNEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNEQ(*p.EdgeFriendTypeNEQ)))
 	}
-	if l.EdgeFriendTypeIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.TypeIn(l.EdgeFriendTypeIn...)))
+	if p.EdgeFriendTypeIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeIn(p.EdgeFriendTypeIn...)))
 	}
-	if l.EdgeFriendTypeNotIn != nil {
-		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNotIn(l.EdgeFriendTypeNotIn...)))
+	if p.EdgeFriendTypeNotIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNotIn(p.EdgeFriendTypeNotIn...)))
 	}
-	if l.EdgeHasFollowedBy != nil {
-		if *l.EdgeHasFollowedBy {
+	if p.EdgeHasFollowedBy != nil {
+		if *p.EdgeHasFollowedBy {
 			predicates = append(predicates, pet.HasFollowedBy())
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasFollowedBy()))
 		}
 	}
-	if l.EdgeFollowedByIDEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.IDEQ(*l.EdgeFollowedByIDEQ)))
+	if p.EdgeFollowedByIDEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.IDEQ(*p.EdgeFollowedByIDEQ)))
 	}
-	if l.EdgeFollowedByIDNEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.IDNEQ(*l.EdgeFollowedByIDNEQ)))
+	if p.EdgeFollowedByIDNEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.IDNEQ(*p.EdgeFollowedByIDNEQ)))
 	}
-	if l.EdgeFollowedByIDIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.IDIn(l.EdgeFollowedByIDIn...)))
+	if p.EdgeFollowedByIDIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.IDIn(p.EdgeFollowedByIDIn...)))
 	}
-	if l.EdgeFollowedByIDNotIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.IDNotIn(l.EdgeFollowedByIDNotIn...)))
+	if p.EdgeFollowedByIDNotIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.IDNotIn(p.EdgeFollowedByIDNotIn...)))
 	}
-	if l.EdgeFollowedByCreatedAtGT != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.CreatedAtGT(*l.EdgeFollowedByCreatedAtGT)))
+	if p.EdgeFollowedByCreatedAtGT != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.CreatedAtGT(*p.EdgeFollowedByCreatedAtGT)))
 	}
-	if l.EdgeFollowedByCreatedAtLT != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.CreatedAtLT(*l.EdgeFollowedByCreatedAtLT)))
+	if p.EdgeFollowedByCreatedAtLT != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.CreatedAtLT(*p.EdgeFollowedByCreatedAtLT)))
 	}
-	if l.EdgeFollowedByUpdatedAtGT != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.UpdatedAtGT(*l.EdgeFollowedByUpdatedAtGT)))
+	if p.EdgeFollowedByUpdatedAtGT != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.UpdatedAtGT(*p.EdgeFollowedByUpdatedAtGT)))
 	}
-	if l.EdgeFollowedByUpdatedAtLT != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.UpdatedAtLT(*l.EdgeFollowedByUpdatedAtLT)))
+	if p.EdgeFollowedByUpdatedAtLT != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.UpdatedAtLT(*p.EdgeFollowedByUpdatedAtLT)))
 	}
-	if l.EdgeFollowedByNameEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameEQ(*l.EdgeFollowedByNameEQ)))
+	if p.EdgeFollowedByNameEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameEQ(*p.EdgeFollowedByNameEQ)))
 	}
-	if l.EdgeFollowedByNameNEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameNEQ(*l.EdgeFollowedByNameNEQ)))
+	if p.EdgeFollowedByNameNEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameNEQ(*p.EdgeFollowedByNameNEQ)))
 	}
-	if l.EdgeFollowedByNameIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameIn(l.EdgeFollowedByNameIn...)))
+	if p.EdgeFollowedByNameIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameIn(p.EdgeFollowedByNameIn...)))
 	}
-	if l.EdgeFollowedByNameNotIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameNotIn(l.EdgeFollowedByNameNotIn...)))
+	if p.EdgeFollowedByNameNotIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameNotIn(p.EdgeFollowedByNameNotIn...)))
 	}
-	if l.EdgeFollowedByNameEqualFold != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameEqualFold(*l.EdgeFollowedByNameEqualFold)))
+	if p.EdgeFollowedByNameEqualFold != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameEqualFold(*p.EdgeFollowedByNameEqualFold)))
 	}
-	if l.EdgeFollowedByNameContains != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameContains(*l.EdgeFollowedByNameContains)))
+	if p.EdgeFollowedByNameContains != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameContains(*p.EdgeFollowedByNameContains)))
 	}
-	if l.EdgeFollowedByNameContainsFold != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameContainsFold(*l.EdgeFollowedByNameContainsFold)))
+	if p.EdgeFollowedByNameContainsFold != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameContainsFold(*p.EdgeFollowedByNameContainsFold)))
 	}
-	if l.EdgeFollowedByNameHasPrefix != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameHasPrefix(*l.EdgeFollowedByNameHasPrefix)))
+	if p.EdgeFollowedByNameHasPrefix != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameHasPrefix(*p.EdgeFollowedByNameHasPrefix)))
 	}
-	if l.EdgeFollowedByNameHasSuffix != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.NameHasSuffix(*l.EdgeFollowedByNameHasSuffix)))
+	if p.EdgeFollowedByNameHasSuffix != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.NameHasSuffix(*p.EdgeFollowedByNameHasSuffix)))
 	}
-	if l.EdgeFollowedByTypeEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.TypeEQ(*l.EdgeFollowedByTypeEQ)))
+	if p.EdgeFollowedByTypeEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.TypeEQ(*p.EdgeFollowedByTypeEQ)))
 	}
-	if l.EdgeFollowedByTypeNEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.TypeNEQ(*l.EdgeFollowedByTypeNEQ)))
+	if p.EdgeFollowedByTypeNEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.TypeNEQ(*p.EdgeFollowedByTypeNEQ)))
 	}
-	if l.EdgeFollowedByTypeIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.TypeIn(l.EdgeFollowedByTypeIn...)))
+	if p.EdgeFollowedByTypeIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.TypeIn(p.EdgeFollowedByTypeIn...)))
 	}
-	if l.EdgeFollowedByTypeNotIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.TypeNotIn(l.EdgeFollowedByTypeNotIn...)))
+	if p.EdgeFollowedByTypeNotIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.TypeNotIn(p.EdgeFollowedByTypeNotIn...)))
 	}
-	if l.EdgeFollowedByDescriptionIsNil != nil {
-		if *l.EdgeFollowedByDescriptionIsNil {
+	if p.EdgeFollowedByDescriptionIsNil != nil {
+		if *p.EdgeFollowedByDescriptionIsNil {
 			predicates = append(predicates, pet.HasFollowedByWith(user.DescriptionIsNil()))
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasFollowedByWith(user.DescriptionIsNil())))
 		}
 	}
-	if l.EdgeFollowedByDescriptionContains != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.DescriptionContains(*l.EdgeFollowedByDescriptionContains)))
+	if p.EdgeFollowedByDescriptionContains != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.DescriptionContains(*p.EdgeFollowedByDescriptionContains)))
 	}
-	if l.EdgeFollowedByDescriptionContainsFold != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.DescriptionContainsFold(*l.EdgeFollowedByDescriptionContainsFold)))
+	if p.EdgeFollowedByDescriptionContainsFold != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.DescriptionContainsFold(*p.EdgeFollowedByDescriptionContainsFold)))
 	}
-	if l.EdgeFollowedByEnabledEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EnabledEQ(*l.EdgeFollowedByEnabledEQ)))
+	if p.EdgeFollowedByEnabledEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EnabledEQ(*p.EdgeFollowedByEnabledEQ)))
 	}
-	if l.EdgeFollowedByEmailEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailEQ(*l.EdgeFollowedByEmailEQ)))
+	if p.EdgeFollowedByEmailEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailEQ(*p.EdgeFollowedByEmailEQ)))
 	}
-	if l.EdgeFollowedByEmailNEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailNEQ(*l.EdgeFollowedByEmailNEQ)))
+	if p.EdgeFollowedByEmailNEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailNEQ(*p.EdgeFollowedByEmailNEQ)))
 	}
-	if l.EdgeFollowedByEmailIsNil != nil {
-		if *l.EdgeFollowedByEmailIsNil {
+	if p.EdgeFollowedByEmailIsNil != nil {
+		if *p.EdgeFollowedByEmailIsNil {
 			predicates = append(predicates, pet.HasFollowedByWith(user.EmailIsNil()))
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasFollowedByWith(user.EmailIsNil())))
 		}
 	}
-	if l.EdgeFollowedByEmailIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailIn(l.EdgeFollowedByEmailIn...)))
+	if p.EdgeFollowedByEmailIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailIn(p.EdgeFollowedByEmailIn...)))
 	}
-	if l.EdgeFollowedByEmailNotIn != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailNotIn(l.EdgeFollowedByEmailNotIn...)))
+	if p.EdgeFollowedByEmailNotIn != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailNotIn(p.EdgeFollowedByEmailNotIn...)))
 	}
-	if l.EdgeFollowedByEmailEqualFold != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailEqualFold(*l.EdgeFollowedByEmailEqualFold)))
+	if p.EdgeFollowedByEmailEqualFold != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailEqualFold(*p.EdgeFollowedByEmailEqualFold)))
 	}
-	if l.EdgeFollowedByEmailContains != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailContains(*l.EdgeFollowedByEmailContains)))
+	if p.EdgeFollowedByEmailContains != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailContains(*p.EdgeFollowedByEmailContains)))
 	}
-	if l.EdgeFollowedByEmailContainsFold != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailContainsFold(*l.EdgeFollowedByEmailContainsFold)))
+	if p.EdgeFollowedByEmailContainsFold != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailContainsFold(*p.EdgeFollowedByEmailContainsFold)))
 	}
-	if l.EdgeFollowedByEmailHasPrefix != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailHasPrefix(*l.EdgeFollowedByEmailHasPrefix)))
+	if p.EdgeFollowedByEmailHasPrefix != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailHasPrefix(*p.EdgeFollowedByEmailHasPrefix)))
 	}
-	if l.EdgeFollowedByEmailHasSuffix != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.EmailHasSuffix(*l.EdgeFollowedByEmailHasSuffix)))
+	if p.EdgeFollowedByEmailHasSuffix != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.EmailHasSuffix(*p.EdgeFollowedByEmailHasSuffix)))
 	}
-	if l.EdgeFollowedByLastAuthenticatedAtEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.LastAuthenticatedAtEQ(*l.EdgeFollowedByLastAuthenticatedAtEQ)))
+	if p.EdgeFollowedByLastAuthenticatedAtEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.LastAuthenticatedAtEQ(*p.EdgeFollowedByLastAuthenticatedAtEQ)))
 	}
-	if l.EdgeFollowedByLastAuthenticatedAtNEQ != nil {
-		predicates = append(predicates, pet.HasFollowedByWith(user.LastAuthenticatedAtNEQ(*l.EdgeFollowedByLastAuthenticatedAtNEQ)))
+	if p.EdgeFollowedByLastAuthenticatedAtNEQ != nil {
+		predicates = append(predicates, pet.HasFollowedByWith(user.LastAuthenticatedAtNEQ(*p.EdgeFollowedByLastAuthenticatedAtNEQ)))
 	}
-	if l.EdgeFollowedByLastAuthenticatedAtIsNil != nil {
-		if *l.EdgeFollowedByLastAuthenticatedAtIsNil {
+	if p.EdgeFollowedByLastAuthenticatedAtIsNil != nil {
+		if *p.EdgeFollowedByLastAuthenticatedAtIsNil {
 			predicates = append(predicates, pet.HasFollowedByWith(user.LastAuthenticatedAtIsNil()))
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasFollowedByWith(user.LastAuthenticatedAtIsNil())))
 		}
 	}
-	if l.EdgeHasFollowing != nil {
-		if *l.EdgeHasFollowing {
+	if p.EdgeHasFollowing != nil {
+		if *p.EdgeHasFollowing {
 			predicates = append(predicates, pet.HasFollowing())
 		} else {
 			predicates = append(predicates, pet.Not(pet.HasFollowing()))
 		}
 	}
 
-	return l.ApplyFilterOperation(predicates...)
+	pred, err := p.ApplyFilterOperation(predicates...)
+	if err != nil {
+		return nil, err
+	}
+	return p.ApplyFilterExpression(pred, filterPredicatePet)
+}
+
+// filterPredicatePet returns the predicate for a single filter within a filter
+// expression, where key is the name of the associated filter parameter.
+func filterPredicatePet(key string, value json.RawMessage) (predicate.Pet, error) {
+	switch key {
+	case "id.eq":
+		var v struct{ PetIDEQ *int }
+		if err := json.Unmarshal(value, &v.PetIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.IDEQ(*v.PetIDEQ), nil
+	case "id.neq":
+		var v struct{ PetIDNEQ *int }
+		if err := json.Unmarshal(value, &v.PetIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.IDNEQ(*v.PetIDNEQ), nil
+	case "id.in":
+		var v struct{ PetIDIn []int }
+		if err := json.Unmarshal(value, &v.PetIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.IDIn(v.PetIDIn...), nil
+	case "id.notIn":
+		var v struct{ PetIDNotIn []int }
+		if err := json.Unmarshal(value, &v.PetIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.IDNotIn(v.PetIDNotIn...), nil
+	case "name.eq":
+		var v struct{ PetNameEQ *string }
+		if err := json.Unmarshal(value, &v.PetNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameEQ(*v.PetNameEQ), nil
+	case "name.neq":
+		var v struct{ PetNameNEQ *string }
+		if err := json.Unmarshal(value, &v.PetNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameNEQ(*v.PetNameNEQ), nil
+	case "name.in":
+		var v struct{ PetNameIn []string }
+		if err := json.Unmarshal(value, &v.PetNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameIn(v.PetNameIn...), nil
+	case "name.notIn":
+		var v struct{ PetNameNotIn []string }
+		if err := json.Unmarshal(value, &v.PetNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameNotIn(v.PetNameNotIn...), nil
+	case "name.ieq":
+		var v struct{ PetNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.PetNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameEqualFold(*v.PetNameEqualFold), nil
+	case "name.has":
+		var v struct{ PetNameContains *string }
+		if err := json.Unmarshal(value, &v.PetNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameContains(*v.PetNameContains), nil
+	case "name.ihas":
+		var v struct{ PetNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.PetNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameContainsFold(*v.PetNameContainsFold), nil
+	case "name.prefix":
+		var v struct{ PetNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.PetNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameHasPrefix(*v.PetNameHasPrefix), nil
+	case "name.suffix":
+		var v struct{ PetNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.PetNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.NameHasSuffix(*v.PetNameHasSuffix), nil
+	case "nicknames.null":
+		var v struct{ PetNicknamesIsNil *bool }
+		if err := json.Unmarshal(value, &v.PetNicknamesIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.PetNicknamesIsNil {
+			return pet.Not(pet.NicknamesIsNil()), nil
+		}
+		return pet.NicknamesIsNil(), nil
+	case "age.eq":
+		var v struct{ PetAgeEQ *int }
+		if err := json.Unmarshal(value, &v.PetAgeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.AgeEQ(*v.PetAgeEQ), nil
+	case "age.neq":
+		var v struct{ PetAgeNEQ *int }
+		if err := json.Unmarshal(value, &v.PetAgeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.AgeNEQ(*v.PetAgeNEQ), nil
+	case "age.gt":
+		var v struct{ PetAgeGT *int }
+		if err := json.Unmarshal(value, &v.PetAgeGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.AgeGT(*v.PetAgeGT), nil
+	case "age.lt":
+		var v struct{ PetAgeLT *int }
+		if err := json.Unmarshal(value, &v.PetAgeLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.AgeLT(*v.PetAgeLT), nil
+	case "age.in":
+		var v struct{ PetAgeIn []int }
+		if err := json.Unmarshal(value, &v.PetAgeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.AgeIn(v.PetAgeIn...), nil
+	case "age.notIn":
+		var v struct{ PetAgeNotIn []int }
+		if err := json.Unmarshal(value, &v.PetAgeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.AgeNotIn(v.PetAgeNotIn...), nil
+	case "type.eq":
+		var v struct{ PetTypeEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.PetTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.TypeEQ(*v.PetTypeEQ), nil
+	case "type.neq":
+		var v struct{ PetTypeNEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.PetTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.TypeNEQ(*v.PetTypeNEQ), nil
+	case "type.in":
+		var v struct{ PetTypeIn []pet.Type }
+		if err := json.Unmarshal(value, &v.PetTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.TypeIn(v.PetTypeIn...), nil
+	case "type.notIn":
+		var v struct{ PetTypeNotIn []pet.Type }
+		if err := json.Unmarshal(value, &v.PetTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.TypeNotIn(v.PetTypeNotIn...), nil
+	case "has.category":
+		var v struct{ EdgeHasCategory *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasCategory); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasCategory {
+			return pet.Not(pet.HasCategories()), nil
+		}
+		return pet.HasCategories(), nil
+	case "category.id.eq":
+		var v struct{ EdgeCategoryIDEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeCategoryIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.IDEQ(*v.EdgeCategoryIDEQ)), nil
+	case "category.id.neq":
+		var v struct{ EdgeCategoryIDNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeCategoryIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.IDNEQ(*v.EdgeCategoryIDNEQ)), nil
+	case "category.id.in":
+		var v struct{ EdgeCategoryIDIn []int }
+		if err := json.Unmarshal(value, &v.EdgeCategoryIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.IDIn(v.EdgeCategoryIDIn...)), nil
+	case "category.id.notIn":
+		var v struct{ EdgeCategoryIDNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgeCategoryIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.IDNotIn(v.EdgeCategoryIDNotIn...)), nil
+	case "category.createdAt.gt":
+		var v struct{ EdgeCategoryCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeCategoryCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.CreatedAtGT(*v.EdgeCategoryCreatedAtGT)), nil
+	case "category.createdAt.lt":
+		var v struct{ EdgeCategoryCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeCategoryCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.CreatedAtLT(*v.EdgeCategoryCreatedAtLT)), nil
+	case "category.updatedAt.gt":
+		var v struct{ EdgeCategoryUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeCategoryUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.UpdatedAtGT(*v.EdgeCategoryUpdatedAtGT)), nil
+	case "category.updatedAt.lt":
+		var v struct{ EdgeCategoryUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeCategoryUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasCategoriesWith(category.UpdatedAtLT(*v.EdgeCategoryUpdatedAtLT)), nil
+	case "has.owner":
+		var v struct{ EdgeHasOwner *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasOwner); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasOwner {
+			return pet.Not(pet.HasOwner()), nil
+		}
+		return pet.HasOwner(), nil
+	case "owner.id.eq":
+		var v struct{ EdgeOwnerIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeOwnerIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.IDEQ(*v.EdgeOwnerIDEQ)), nil
+	case "owner.id.neq":
+		var v struct{ EdgeOwnerIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeOwnerIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.IDNEQ(*v.EdgeOwnerIDNEQ)), nil
+	case "owner.id.in":
+		var v struct{ EdgeOwnerIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeOwnerIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.IDIn(v.EdgeOwnerIDIn...)), nil
+	case "owner.id.notIn":
+		var v struct{ EdgeOwnerIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeOwnerIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.IDNotIn(v.EdgeOwnerIDNotIn...)), nil
+	case "owner.createdAt.gt":
+		var v struct{ EdgeOwnerCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeOwnerCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.CreatedAtGT(*v.EdgeOwnerCreatedAtGT)), nil
+	case "owner.createdAt.lt":
+		var v struct{ EdgeOwnerCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeOwnerCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.CreatedAtLT(*v.EdgeOwnerCreatedAtLT)), nil
+	case "owner.updatedAt.gt":
+		var v struct{ EdgeOwnerUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeOwnerUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.UpdatedAtGT(*v.EdgeOwnerUpdatedAtGT)), nil
+	case "owner.updatedAt.lt":
+		var v struct{ EdgeOwnerUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeOwnerUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.UpdatedAtLT(*v.EdgeOwnerUpdatedAtLT)), nil
+	case "owner.name.eq":
+		var v struct{ EdgeOwnerNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameEQ(*v.EdgeOwnerNameEQ)), nil
+	case "owner.name.neq":
+		var v struct{ EdgeOwnerNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameNEQ(*v.EdgeOwnerNameNEQ)), nil
+	case "owner.name.in":
+		var v struct{ EdgeOwnerNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameIn(v.EdgeOwnerNameIn...)), nil
+	case "owner.name.notIn":
+		var v struct{ EdgeOwnerNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameNotIn(v.EdgeOwnerNameNotIn...)), nil
+	case "owner.name.ieq":
+		var v struct{ EdgeOwnerNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameEqualFold(*v.EdgeOwnerNameEqualFold)), nil
+	case "owner.name.has":
+		var v struct{ EdgeOwnerNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameContains(*v.EdgeOwnerNameContains)), nil
+	case "owner.name.ihas":
+		var v struct{ EdgeOwnerNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameContainsFold(*v.EdgeOwnerNameContainsFold)), nil
+	case "owner.name.prefix":
+		var v struct{ EdgeOwnerNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameHasPrefix(*v.EdgeOwnerNameHasPrefix)), nil
+	case "owner.name.suffix":
+		var v struct{ EdgeOwnerNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.NameHasSuffix(*v.EdgeOwnerNameHasSuffix)), nil
+	case "owner.type.eq":
+		var v struct{ EdgeOwnerTypeEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeOwnerTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.TypeEQ(*v.EdgeOwnerTypeEQ)), nil
+	case "owner.type.neq":
+		var v struct{ EdgeOwnerTypeNEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeOwnerTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.TypeNEQ(*v.EdgeOwnerTypeNEQ)), nil
+	case "owner.type.in":
+		var v struct{ EdgeOwnerTypeIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeOwnerTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.TypeIn(v.EdgeOwnerTypeIn...)), nil
+	case "owner.type.notIn":
+		var v struct{ EdgeOwnerTypeNotIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeOwnerTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.TypeNotIn(v.EdgeOwnerTypeNotIn...)), nil
+	case "owner.description.null":
+		var v struct{ EdgeOwnerDescriptionIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeOwnerDescriptionIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeOwnerDescriptionIsNil {
+			return pet.Not(pet.HasOwnerWith(user.DescriptionIsNil())), nil
+		}
+		return pet.HasOwnerWith(user.DescriptionIsNil()), nil
+	case "owner.description.has":
+		var v struct{ EdgeOwnerDescriptionContains *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerDescriptionContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.DescriptionContains(*v.EdgeOwnerDescriptionContains)), nil
+	case "owner.description.ihas":
+		var v struct{ EdgeOwnerDescriptionContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerDescriptionContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.DescriptionContainsFold(*v.EdgeOwnerDescriptionContainsFold)), nil
+	case "owner.enabled.eq":
+		var v struct{ EdgeOwnerEnabledEQ *bool }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEnabledEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EnabledEQ(*v.EdgeOwnerEnabledEQ)), nil
+	case "owner.email.eq":
+		var v struct{ EdgeOwnerEmailEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailEQ(*v.EdgeOwnerEmailEQ)), nil
+	case "owner.email.neq":
+		var v struct{ EdgeOwnerEmailNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailNEQ(*v.EdgeOwnerEmailNEQ)), nil
+	case "owner.email.null":
+		var v struct{ EdgeOwnerEmailIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeOwnerEmailIsNil {
+			return pet.Not(pet.HasOwnerWith(user.EmailIsNil())), nil
+		}
+		return pet.HasOwnerWith(user.EmailIsNil()), nil
+	case "owner.email.in":
+		var v struct{ EdgeOwnerEmailIn []string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailIn(v.EdgeOwnerEmailIn...)), nil
+	case "owner.email.notIn":
+		var v struct{ EdgeOwnerEmailNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailNotIn(v.EdgeOwnerEmailNotIn...)), nil
+	case "owner.email.ieq":
+		var v struct{ EdgeOwnerEmailEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailEqualFold(*v.EdgeOwnerEmailEqualFold)), nil
+	case "owner.email.has":
+		var v struct{ EdgeOwnerEmailContains *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailContains(*v.EdgeOwnerEmailContains)), nil
+	case "owner.email.ihas":
+		var v struct{ EdgeOwnerEmailContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailContainsFold(*v.EdgeOwnerEmailContainsFold)), nil
+	case "owner.email.prefix":
+		var v struct{ EdgeOwnerEmailHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailHasPrefix(*v.EdgeOwnerEmailHasPrefix)), nil
+	case "owner.email.suffix":
+		var v struct{ EdgeOwnerEmailHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeOwnerEmailHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.EmailHasSuffix(*v.EdgeOwnerEmailHasSuffix)), nil
+	case "owner.lastAuthenticatedAt.eq":
+		var v struct{ EdgeOwnerLastAuthenticatedAtEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeOwnerLastAuthenticatedAtEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.LastAuthenticatedAtEQ(*v.EdgeOwnerLastAuthenticatedAtEQ)), nil
+	case "owner.lastAuthenticatedAt.neq":
+		var v struct{ EdgeOwnerLastAuthenticatedAtNEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeOwnerLastAuthenticatedAtNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasOwnerWith(user.LastAuthenticatedAtNEQ(*v.EdgeOwnerLastAuthenticatedAtNEQ)), nil
+	case "owner.lastAuthenticatedAt.null":
+		var v struct{ EdgeOwnerLastAuthenticatedAtIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeOwnerLastAuthenticatedAtIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeOwnerLastAuthenticatedAtIsNil {
+			return pet.Not(pet.HasOwnerWith(user.LastAuthenticatedAtIsNil())), nil
+		}
+		return pet.HasOwnerWith(user.LastAuthenticatedAtIsNil()), nil
+	case "has.friend":
+		var v struct{ EdgeHasFriend *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFriend); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFriend {
+			return pet.Not(pet.HasFriends()), nil
+		}
+		return pet.HasFriends(), nil
+	case "friend.id.eq":
+		var v struct{ EdgeFriendIDEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.IDEQ(*v.EdgeFriendIDEQ)), nil
+	case "friend.id.neq":
+		var v struct{ EdgeFriendIDNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.IDNEQ(*v.EdgeFriendIDNEQ)), nil
+	case "friend.id.in":
+		var v struct{ EdgeFriendIDIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.IDIn(v.EdgeFriendIDIn...)), nil
+	case "friend.id.notIn":
+		var v struct{ EdgeFriendIDNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.IDNotIn(v.EdgeFriendIDNotIn...)), nil
+	case "friend.name.eq":
+		var v struct{ EdgeFriendNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameEQ(*v.EdgeFriendNameEQ)), nil
+	case "friend.name.neq":
+		var v struct{ EdgeFriendNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameNEQ(*v.EdgeFriendNameNEQ)), nil
+	case "friend.name.in":
+		var v struct{ EdgeFriendNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameIn(v.EdgeFriendNameIn...)), nil
+	case "friend.name.notIn":
+		var v struct{ EdgeFriendNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameNotIn(v.EdgeFriendNameNotIn...)), nil
+	case "friend.name.ieq":
+		var v struct{ EdgeFriendNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameEqualFold(*v.EdgeFriendNameEqualFold)), nil
+	case "friend.name.has":
+		var v struct{ EdgeFriendNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameContains(*v.EdgeFriendNameContains)), nil
+	case "friend.name.ihas":
+		var v struct{ EdgeFriendNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameContainsFold(*v.EdgeFriendNameContainsFold)), nil
+	case "friend.name.prefix":
+		var v struct{ EdgeFriendNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameHasPrefix(*v.EdgeFriendNameHasPrefix)), nil
+	case "friend.name.suffix":
+		var v struct{ EdgeFriendNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.NameHasSuffix(*v.EdgeFriendNameHasSuffix)), nil
+	case "friend.nicknames.null":
+		var v struct{ EdgeFriendNicknamesIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendNicknamesIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFriendNicknamesIsNil {
+			return pet.Not(pet.HasFriendsWith(pet.NicknamesIsNil())), nil
+		}
+		return pet.HasFriendsWith(pet.NicknamesIsNil()), nil
+	case "friend.age.eq":
+		var v struct{ EdgeFriendAgeEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendAgeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.AgeEQ(*v.EdgeFriendAgeEQ)), nil
+	case "friend.age.neq":
+		var v struct{ EdgeFriendAgeNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendAgeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.AgeNEQ(*v.EdgeFriendAgeNEQ)), nil
+	case "friend.age.gt":
+		var v struct{ EdgeFriendAgeGT *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendAgeGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.AgeGT(*v.EdgeFriendAgeGT)), nil
+	case "friend.age.lt":
+		var v struct{ EdgeFriendAgeLT *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendAgeLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.AgeLT(*v.EdgeFriendAgeLT)), nil
+	case "friend.age.in":
+		var v struct{ EdgeFriendAgeIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFriendAgeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.AgeIn(v.EdgeFriendAgeIn...)), nil
+	case "friend.age.notIn":
+		var v struct{ EdgeFriendAgeNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFriendAgeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.AgeNotIn(v.EdgeFriendAgeNotIn...)), nil
+	case "friend.type.eq":
+		var v struct{ EdgeFriendTypeEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.TypeEQ(*v.EdgeFriendTypeEQ)), nil
+	case "friend.type.neq":
+		var v struct{ EdgeFriendTypeNEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.TypeNEQ(*v.EdgeFriendTypeNEQ)), nil
+	case "friend.type.in":
+		var v struct{ EdgeFriendTypeIn []pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.TypeIn(v.EdgeFriendTypeIn...)), nil
+	case "friend.type.notIn":
+		var v struct{ EdgeFriendTypeNotIn []pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFriendsWith(pet.TypeNotIn(v.EdgeFriendTypeNotIn...)), nil
+	case "has.followedBy":
+		var v struct{ EdgeHasFollowedBy *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFollowedBy); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFollowedBy {
+			return pet.Not(pet.HasFollowedBy()), nil
+		}
+		return pet.HasFollowedBy(), nil
+	case "followedBy.id.eq":
+		var v struct{ EdgeFollowedByIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.IDEQ(*v.EdgeFollowedByIDEQ)), nil
+	case "followedBy.id.neq":
+		var v struct{ EdgeFollowedByIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.IDNEQ(*v.EdgeFollowedByIDNEQ)), nil
+	case "followedBy.id.in":
+		var v struct{ EdgeFollowedByIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.IDIn(v.EdgeFollowedByIDIn...)), nil
+	case "followedBy.id.notIn":
+		var v struct{ EdgeFollowedByIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.IDNotIn(v.EdgeFollowedByIDNotIn...)), nil
+	case "followedBy.createdAt.gt":
+		var v struct{ EdgeFollowedByCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.CreatedAtGT(*v.EdgeFollowedByCreatedAtGT)), nil
+	case "followedBy.createdAt.lt":
+		var v struct{ EdgeFollowedByCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.CreatedAtLT(*v.EdgeFollowedByCreatedAtLT)), nil
+	case "followedBy.updatedAt.gt":
+		var v struct{ EdgeFollowedByUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.UpdatedAtGT(*v.EdgeFollowedByUpdatedAtGT)), nil
+	case "followedBy.updatedAt.lt":
+		var v struct{ EdgeFollowedByUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.UpdatedAtLT(*v.EdgeFollowedByUpdatedAtLT)), nil
+	case "followedBy.name.eq":
+		var v struct{ EdgeFollowedByNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameEQ(*v.EdgeFollowedByNameEQ)), nil
+	case "followedBy.name.neq":
+		var v struct{ EdgeFollowedByNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameNEQ(*v.EdgeFollowedByNameNEQ)), nil
+	case "followedBy.name.in":
+		var v struct{ EdgeFollowedByNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameIn(v.EdgeFollowedByNameIn...)), nil
+	case "followedBy.name.notIn":
+		var v struct{ EdgeFollowedByNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameNotIn(v.EdgeFollowedByNameNotIn...)), nil
+	case "followedBy.name.ieq":
+		var v struct{ EdgeFollowedByNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameEqualFold(*v.EdgeFollowedByNameEqualFold)), nil
+	case "followedBy.name.has":
+		var v struct{ EdgeFollowedByNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameContains(*v.EdgeFollowedByNameContains)), nil
+	case "followedBy.name.ihas":
+		var v struct{ EdgeFollowedByNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameContainsFold(*v.EdgeFollowedByNameContainsFold)), nil
+	case "followedBy.name.prefix":
+		var v struct{ EdgeFollowedByNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameHasPrefix(*v.EdgeFollowedByNameHasPrefix)), nil
+	case "followedBy.name.suffix":
+		var v struct{ EdgeFollowedByNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.NameHasSuffix(*v.EdgeFollowedByNameHasSuffix)), nil
+	case "followedBy.type.eq":
+		var v struct{ EdgeFollowedByTypeEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.TypeEQ(*v.EdgeFollowedByTypeEQ)), nil
+	case "followedBy.type.neq":
+		var v struct{ EdgeFollowedByTypeNEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.TypeNEQ(*v.EdgeFollowedByTypeNEQ)), nil
+	case "followedBy.type.in":
+		var v struct{ EdgeFollowedByTypeIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.TypeIn(v.EdgeFollowedByTypeIn...)), nil
+	case "followedBy.type.notIn":
+		var v struct{ EdgeFollowedByTypeNotIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.TypeNotIn(v.EdgeFollowedByTypeNotIn...)), nil
+	case "followedBy.description.null":
+		var v struct{ EdgeFollowedByDescriptionIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByDescriptionIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFollowedByDescriptionIsNil {
+			return pet.Not(pet.HasFollowedByWith(user.DescriptionIsNil())), nil
+		}
+		return pet.HasFollowedByWith(user.DescriptionIsNil()), nil
+	case "followedBy.description.has":
+		var v struct{ EdgeFollowedByDescriptionContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByDescriptionContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.DescriptionContains(*v.EdgeFollowedByDescriptionContains)), nil
+	case "followedBy.description.ihas":
+		var v struct{ EdgeFollowedByDescriptionContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByDescriptionContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.DescriptionContainsFold(*v.EdgeFollowedByDescriptionContainsFold)), nil
+	case "followedBy.enabled.eq":
+		var v struct{ EdgeFollowedByEnabledEQ *bool }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEnabledEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EnabledEQ(*v.EdgeFollowedByEnabledEQ)), nil
+	case "followedBy.email.eq":
+		var v struct{ EdgeFollowedByEmailEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailEQ(*v.EdgeFollowedByEmailEQ)), nil
+	case "followedBy.email.neq":
+		var v struct{ EdgeFollowedByEmailNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailNEQ(*v.EdgeFollowedByEmailNEQ)), nil
+	case "followedBy.email.null":
+		var v struct{ EdgeFollowedByEmailIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFollowedByEmailIsNil {
+			return pet.Not(pet.HasFollowedByWith(user.EmailIsNil())), nil
+		}
+		return pet.HasFollowedByWith(user.EmailIsNil()), nil
+	case "followedBy.email.in":
+		var v struct{ EdgeFollowedByEmailIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailIn(v.EdgeFollowedByEmailIn...)), nil
+	case "followedBy.email.notIn":
+		var v struct{ EdgeFollowedByEmailNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailNotIn(v.EdgeFollowedByEmailNotIn...)), nil
+	case "followedBy.email.ieq":
+		var v struct{ EdgeFollowedByEmailEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailEqualFold(*v.EdgeFollowedByEmailEqualFold)), nil
+	case "followedBy.email.has":
+		var v struct{ EdgeFollowedByEmailContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailContains(*v.EdgeFollowedByEmailContains)), nil
+	case "followedBy.email.ihas":
+		var v struct{ EdgeFollowedByEmailContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailContainsFold(*v.EdgeFollowedByEmailContainsFold)), nil
+	case "followedBy.email.prefix":
+		var v struct{ EdgeFollowedByEmailHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailHasPrefix(*v.EdgeFollowedByEmailHasPrefix)), nil
+	case "followedBy.email.suffix":
+		var v struct{ EdgeFollowedByEmailHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByEmailHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.EmailHasSuffix(*v.EdgeFollowedByEmailHasSuffix)), nil
+	case "followedBy.lastAuthenticatedAt.eq":
+		var v struct{ EdgeFollowedByLastAuthenticatedAtEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByLastAuthenticatedAtEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.LastAuthenticatedAtEQ(*v.EdgeFollowedByLastAuthenticatedAtEQ)), nil
+	case "followedBy.lastAuthenticatedAt.neq":
+		var v struct{ EdgeFollowedByLastAuthenticatedAtNEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByLastAuthenticatedAtNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return pet.HasFollowedByWith(user.LastAuthenticatedAtNEQ(*v.EdgeFollowedByLastAuthenticatedAtNEQ)), nil
+	case "followedBy.lastAuthenticatedAt.null":
+		var v struct{ EdgeFollowedByLastAuthenticatedAtIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFollowedByLastAuthenticatedAtIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFollowedByLastAuthenticatedAtIsNil {
+			return pet.Not(pet.HasFollowedByWith(user.LastAuthenticatedAtIsNil())), nil
+		}
+		return pet.HasFollowedByWith(user.LastAuthenticatedAtIsNil()), nil
+	case "has.following":
+		var v struct{ EdgeHasFollowing *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFollowing); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFollowing {
+			return pet.Not(pet.HasFollowing()), nil
+		}
+		return pet.HasFollowing(), nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q", key)
+	}
 }
 
 // ApplySorting applies sorting to the query based on the provided sort and order fields.
@@ -1596,10 +3614,16 @@ func (l *ListPetParams) ApplySorting(query *ent.PetQuery) error {
 	if err := l.Sorted.Validate(PetSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if len(l.terms) == 0 { // No custom sort fields provided and no defaults, so don't do anything.
 		return nil
 	}
-	applySortingPet(query, *l.Field, *l.Order)
+	for _, term := range l.terms {
+		applySortingPet(query, term.field, term.order, term.nulls)
+	}
+	if !l.sortedBy(pet.FieldID) && !l.sortedBy("random") {
+		// Use the ID as a tie-breaker, so the order is stable across pages.
+		query.Order(withFieldSelector(pet.FieldID, l.terms[len(l.terms)-1].order, ""))
+	}
 	return nil
 }
 
@@ -1611,17 +3635,49 @@ func (l *ListPetParams) Exec(ctx context.Context, query *ent.PetQuery) (results
 		return nil, err
 	}
 	query.Where(predicates)
-	err = l.ApplySorting(EagerLoadPet(query))
+	EagerLoadPet(query)
+	if _, err = ExpandPet(query, l.Expand); err != nil {
+		return nil, err
+	}
+	err = l.ApplySorting(query)
 	if err != nil {
 		return nil, err
 	}
+	l.selector = func(ctx context.Context) (*sql.Selector, error) {
+		return resolveSelector(func(fn func(*sql.Selector)) (int, error) {
+			return query.Clone().Where(fn).Count(ctx)
+		})
+	}
+	// Fields are selected after counting, so the count isn't affected by the selected columns.
+	_, err = l.ApplyPagination(ctx, query, PetPageConfig)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = SelectPet(query, l.Fields, l.Expand); err != nil {
+		return nil, err
+	}
 	return l.ExecutePaginated(ctx, query, PetPageConfig)
 }
 
-// ListPostParams defines parameters for listing Posts via a GET request.
-type ListPostParams struct {
-	Sorted
-	Paginated[*ent.PostQuery, ent.Post]
+// ExecCount applies the filters (if any), and returns the number of matching entities.
+// Sorting, pagination and eager loading are skipped.
+func (l *ListPetParams) ExecCount(ctx context.Context, query *ent.PetQuery) (*CountResult, error) {
+	predicates, err := l.FilterPredicates()
+	if err != nil {
+		return nil, err
+	}
+	query.Where(predicates)
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CountResult{Count: count}, nil
+}
+
+// FilterPostParams defines the filter-related parameters for Posts, which
+// are shared by all operations which filter Posts (e.g. list and bulk operations).
+type FilterPostParams struct {
 	Filtered[predicate.Post]
 
 	// Filters field "id" to be equal to the provided value.
@@ -1720,169 +3776,486 @@ type ListPostParams struct {
 	EdgeAuthorLastAuthenticatedAtIsNil *bool `form:"author.lastAuthenticatedAt.null,omitempty" json:"edge_author_last_authenticated_at_is_nil,omitempty"`
 }
 
+// ListPostParams defines parameters for listing Posts via a GET request.
+type ListPostParams struct {
+	Sorted
+	CursorPaginated[*ent.PostQuery, ent.Post]
+	FilterPostParams
+	// Fields contains the fields requested by the client. As "fields" and "fields[<edge>]"
+	// can't be decoded together, this is populated from [ParseFieldSelection] instead.
+	Fields *FieldSelection `json:"-" form:"-"`
+}
+
 // FilterPredicates returns the predicates for filter-related parameters in Post.
-func (l *ListPostParams) FilterPredicates() (predicate.Post, error) {
+func (p *FilterPostParams) FilterPredicates() (predicate.Post, error) {
 	var predicates []predicate.Post
 
-	if l.PostIDEQ != nil {
-		predicates = append(predicates, post.IDEQ(*l.PostIDEQ))
+	if p.PostIDEQ != nil {
+		predicates = append(predicates, post.IDEQ(*p.PostIDEQ))
 	}
-	if l.PostIDNEQ != nil {
-		predicates = append(predicates, post.IDNEQ(*l.PostIDNEQ))
+	if p.PostIDNEQ != nil {
+		predicates = append(predicates, post.IDNEQ(*p.PostIDNEQ))
 	}
-	if l.PostIDIn != nil {
-		predicates = append(predicates, post.IDIn(l.PostIDIn...))
+	if p.PostIDIn != nil {
+		predicates = append(predicates, post.IDIn(p.PostIDIn...))
 	}
-	if l.PostIDNotIn != nil {
-		predicates = append(predicates, post.IDNotIn(l.PostIDNotIn...))
+	if p.PostIDNotIn != nil {
+		predicates = append(predicates, post.IDNotIn(p.PostIDNotIn...))
 	}
-	if l.PostCreatedAtGT != nil {
-		predicates = append(predicates, post.CreatedAtGT(*l.PostCreatedAtGT))
+	if p.PostCreatedAtGT != nil {
+		predicates = append(predicates, post.CreatedAtGT(*p.PostCreatedAtGT))
 	}
-	if l.PostCreatedAtLT != nil {
-		predicates = append(predicates, post.CreatedAtLT(*l.PostCreatedAtLT))
+	if p.PostCreatedAtLT != nil {
+		predicates = append(predicates, post.CreatedAtLT(*p.PostCreatedAtLT))
 	}
-	if l.PostUpdatedAtGT != nil {
-		predicates = append(predicates, post.UpdatedAtGT(*l.PostUpdatedAtGT))
+	if p.PostUpdatedAtGT != nil {
+		predicates = append(predicates, post.UpdatedAtGT(*p.PostUpdatedAtGT))
 	}
-	if l.PostUpdatedAtLT != nil {
-		predicates = append(predicates, post.UpdatedAtLT(*l.PostUpdatedAtLT))
+	if p.PostUpdatedAtLT != nil {
+		predicates = append(predicates, post.UpdatedAtLT(*p.PostUpdatedAtLT))
 	}
-	if l.EdgeHasAuthor != nil {
-		if *l.EdgeHasAuthor {
+	if p.EdgeHasAuthor != nil {
+		if *p.EdgeHasAuthor {
 			predicates = append(predicates, post.HasAuthor())
 		} else {
 			predicates = append(predicates, post.Not(post.HasAuthor()))
 		}
 	}
-	if l.EdgeAuthorIDEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.IDEQ(*l.EdgeAuthorIDEQ)))
+	if p.EdgeAuthorIDEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.IDEQ(*p.EdgeAuthorIDEQ)))
 	}
-	if l.EdgeAuthorIDNEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.IDNEQ(*l.EdgeAuthorIDNEQ)))
+	if p.EdgeAuthorIDNEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.IDNEQ(*p.EdgeAuthorIDNEQ)))
 	}
-	if l.EdgeAuthorIDIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.IDIn(l.EdgeAuthorIDIn...)))
+	if p.EdgeAuthorIDIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.IDIn(p.EdgeAuthorIDIn...)))
 	}
-	if l.EdgeAuthorIDNotIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.IDNotIn(l.EdgeAuthorIDNotIn...)))
+	if p.EdgeAuthorIDNotIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.IDNotIn(p.EdgeAuthorIDNotIn...)))
 	}
-	if l.EdgeAuthorCreatedAtGT != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.CreatedAtGT(*l.EdgeAuthorCreatedAtGT)))
+	if p.EdgeAuthorCreatedAtGT != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.CreatedAtGT(*p.EdgeAuthorCreatedAtGT)))
 	}
-	if l.EdgeAuthorCreatedAtLT != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.CreatedAtLT(*l.EdgeAuthorCreatedAtLT)))
+	if p.EdgeAuthorCreatedAtLT != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.CreatedAtLT(*p.EdgeAuthorCreatedAtLT)))
 	}
-	if l.EdgeAuthorUpdatedAtGT != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.UpdatedAtGT(*l.EdgeAuthorUpdatedAtGT)))
+	if p.EdgeAuthorUpdatedAtGT != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.UpdatedAtGT(*p.EdgeAuthorUpdatedAtGT)))
 	}
-	if l.EdgeAuthorUpdatedAtLT != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.UpdatedAtLT(*l.EdgeAuthorUpdatedAtLT)))
+	if p.EdgeAuthorUpdatedAtLT != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.UpdatedAtLT(*p.EdgeAuthorUpdatedAtLT)))
 	}
-	if l.EdgeAuthorNameEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameEQ(*l.EdgeAuthorNameEQ)))
+	if p.EdgeAuthorNameEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameEQ(*p.EdgeAuthorNameEQ)))
 	}
-	if l.EdgeAuthorNameNEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameNEQ(*l.EdgeAuthorNameNEQ)))
+	if p.EdgeAuthorNameNEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameNEQ(*p.EdgeAuthorNameNEQ)))
 	}
-	if l.EdgeAuthorNameIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameIn(l.EdgeAuthorNameIn...)))
+	if p.EdgeAuthorNameIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameIn(p.EdgeAuthorNameIn...)))
 	}
-	if l.EdgeAuthorNameNotIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameNotIn(l.EdgeAuthorNameNotIn...)))
+	if p.EdgeAuthorNameNotIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameNotIn(p.EdgeAuthorNameNotIn...)))
 	}
-	if l.EdgeAuthorNameEqualFold != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameEqualFold(*l.EdgeAuthorNameEqualFold)))
+	if p.EdgeAuthorNameEqualFold != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameEqualFold(*p.EdgeAuthorNameEqualFold)))
 	}
-	if l.EdgeAuthorNameContains != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameContains(*l.EdgeAuthorNameContains)))
+	if p.EdgeAuthorNameContains != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameContains(*p.EdgeAuthorNameContains)))
 	}
-	if l.EdgeAuthorNameContainsFold != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameContainsFold(*l.EdgeAuthorNameContainsFold)))
+	if p.EdgeAuthorNameContainsFold != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameContainsFold(*p.EdgeAuthorNameContainsFold)))
 	}
-	if l.EdgeAuthorNameHasPrefix != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameHasPrefix(*l.EdgeAuthorNameHasPrefix)))
+	if p.EdgeAuthorNameHasPrefix != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameHasPrefix(*p.EdgeAuthorNameHasPrefix)))
 	}
-	if l.EdgeAuthorNameHasSuffix != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.NameHasSuffix(*l.EdgeAuthorNameHasSuffix)))
+	if p.EdgeAuthorNameHasSuffix != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.NameHasSuffix(*p.EdgeAuthorNameHasSuffix)))
 	}
-	if l.EdgeAuthorTypeEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.TypeEQ(*l.EdgeAuthorTypeEQ)))
+	if p.EdgeAuthorTypeEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.TypeEQ(*p.EdgeAuthorTypeEQ)))
 	}
-	if l.EdgeAuthorTypeNEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.TypeNEQ(*l.EdgeAuthorTypeNEQ)))
+	if p.EdgeAuthorTypeNEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.TypeNEQ(*p.EdgeAuthorTypeNEQ)))
 	}
-	if l.EdgeAuthorTypeIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.TypeIn(l.EdgeAuthorTypeIn...)))
+	if p.EdgeAuthorTypeIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.TypeIn(p.EdgeAuthorTypeIn...)))
 	}
-	if l.EdgeAuthorTypeNotIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.TypeNotIn(l.EdgeAuthorTypeNotIn...)))
+	if p.EdgeAuthorTypeNotIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.TypeNotIn(p.EdgeAuthorTypeNotIn...)))
 	}
-	if l.EdgeAuthorDescriptionIsNil != nil {
-		if *l.EdgeAuthorDescriptionIsNil {
+	if p.EdgeAuthorDescriptionIsNil != nil {
+		if *p.EdgeAuthorDescriptionIsNil {
 			predicates = append(predicates, post.HasAuthorWith(user.DescriptionIsNil()))
 		} else {
 			predicates = append(predicates, post.Not(post.HasAuthorWith(user.DescriptionIsNil())))
 		}
 	}
-	if l.EdgeAuthorDescriptionContains != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.DescriptionContains(*l.EdgeAuthorDescriptionContains)))
+	if p.EdgeAuthorDescriptionContains != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.DescriptionContains(*p.EdgeAuthorDescriptionContains)))
 	}
-	if l.EdgeAuthorDescriptionContainsFold != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.DescriptionContainsFold(*l.EdgeAuthorDescriptionContainsFold)))
+	if p.EdgeAuthorDescriptionContainsFold != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.DescriptionContainsFold(*p.EdgeAuthorDescriptionContainsFold)))
 	}
-	if l.EdgeAuthorEnabledEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EnabledEQ(*l.EdgeAuthorEnabledEQ)))
+	if p.EdgeAuthorEnabledEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EnabledEQ(*p.EdgeAuthorEnabledEQ)))
 	}
-	if l.EdgeAuthorEmailEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailEQ(*l.EdgeAuthorEmailEQ)))
+	if p.EdgeAuthorEmailEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailEQ(*p.EdgeAuthorEmailEQ)))
 	}
-	if l.EdgeAuthorEmailNEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailNEQ(*l.EdgeAuthorEmailNEQ)))
+	if p.EdgeAuthorEmailNEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailNEQ(*p.EdgeAuthorEmailNEQ)))
 	}
-	if l.EdgeAuthorEmailIsNil != nil {
-		if *l.EdgeAuthorEmailIsNil {
+	if p.EdgeAuthorEmailIsNil != nil {
+		if *p.EdgeAuthorEmailIsNil {
 			predicates = append(predicates, post.HasAuthorWith(user.EmailIsNil()))
 		} else {
 			predicates = append(predicates, post.Not(post.HasAuthorWith(user.EmailIsNil())))
 		}
 	}
-	if l.EdgeAuthorEmailIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailIn(l.EdgeAuthorEmailIn...)))
+	if p.EdgeAuthorEmailIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailIn(p.EdgeAuthorEmailIn...)))
 	}
-	if l.EdgeAuthorEmailNotIn != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailNotIn(l.EdgeAuthorEmailNotIn...)))
+	if p.EdgeAuthorEmailNotIn != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailNotIn(p.EdgeAuthorEmailNotIn...)))
 	}
-	if l.EdgeAuthorEmailEqualFold != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailEqualFold(*l.EdgeAuthorEmailEqualFold)))
+	if p.EdgeAuthorEmailEqualFold != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailEqualFold(*p.EdgeAuthorEmailEqualFold)))
 	}
-	if l.EdgeAuthorEmailContains != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailContains(*l.EdgeAuthorEmailContains)))
+	if p.EdgeAuthorEmailContains != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailContains(*p.EdgeAuthorEmailContains)))
 	}
-	if l.EdgeAuthorEmailContainsFold != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailContainsFold(*l.EdgeAuthorEmailContainsFold)))
+	if p.EdgeAuthorEmailContainsFold != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailContainsFold(*p.EdgeAuthorEmailContainsFold)))
 	}
-	if l.EdgeAuthorEmailHasPrefix != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailHasPrefix(*l.EdgeAuthorEmailHasPrefix)))
+	if p.EdgeAuthorEmailHasPrefix != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailHasPrefix(*p.EdgeAuthorEmailHasPrefix)))
 	}
-	if l.EdgeAuthorEmailHasSuffix != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.EmailHasSuffix(*l.EdgeAuthorEmailHasSuffix)))
+	if p.EdgeAuthorEmailHasSuffix != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.EmailHasSuffix(*p.EdgeAuthorEmailHasSuffix)))
 	}
-	if l.EdgeAuthorLastAuthenticatedAtEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.LastAuthenticatedAtEQ(*l.EdgeAuthorLastAuthenticatedAtEQ)))
+	if p.EdgeAuthorLastAuthenticatedAtEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.LastAuthenticatedAtEQ(*p.EdgeAuthorLastAuthenticatedAtEQ)))
 	}
-	if l.EdgeAuthorLastAuthenticatedAtNEQ != nil {
-		predicates = append(predicates, post.HasAuthorWith(user.LastAuthenticatedAtNEQ(*l.EdgeAuthorLastAuthenticatedAtNEQ)))
+	if p.EdgeAuthorLastAuthenticatedAtNEQ != nil {
+		predicates = append(predicates, post.HasAuthorWith(user.LastAuthenticatedAtNEQ(*p.EdgeAuthorLastAuthenticatedAtNEQ)))
 	}
-	if l.EdgeAuthorLastAuthenticatedAtIsNil != nil {
-		if *l.EdgeAuthorLastAuthenticatedAtIsNil {
+	if p.EdgeAuthorLastAuthenticatedAtIsNil != nil {
+		if *p.EdgeAuthorLastAuthenticatedAtIsNil {
 			predicates = append(predicates, post.HasAuthorWith(user.LastAuthenticatedAtIsNil()))
 		} else {
 			predicates = append(predicates, post.Not(post.HasAuthorWith(user.LastAuthenticatedAtIsNil())))
 		}
 	}
 
-	return l.ApplyFilterOperation(predicates...)
+	pred, err := p.ApplyFilterOperation(predicates...)
+	if err != nil {
+		return nil, err
+	}
+	return p.ApplyFilterExpression(pred, filterPredicatePost)
+}
+
+// filterPredicatePost returns the predicate for a single filter within a filter
+// expression, where key is the name of the associated filter parameter.
+func filterPredicatePost(key string, value json.RawMessage) (predicate.Post, error) {
+	switch key {
+	case "id.eq":
+		var v struct{ PostIDEQ *int }
+		if err := json.Unmarshal(value, &v.PostIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.IDEQ(*v.PostIDEQ), nil
+	case "id.neq":
+		var v struct{ PostIDNEQ *int }
+		if err := json.Unmarshal(value, &v.PostIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.IDNEQ(*v.PostIDNEQ), nil
+	case "id.in":
+		var v struct{ PostIDIn []int }
+		if err := json.Unmarshal(value, &v.PostIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.IDIn(v.PostIDIn...), nil
+	case "id.notIn":
+		var v struct{ PostIDNotIn []int }
+		if err := json.Unmarshal(value, &v.PostIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.IDNotIn(v.PostIDNotIn...), nil
+	case "createdAt.gt":
+		var v struct{ PostCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.PostCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.CreatedAtGT(*v.PostCreatedAtGT), nil
+	case "createdAt.lt":
+		var v struct{ PostCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.PostCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.CreatedAtLT(*v.PostCreatedAtLT), nil
+	case "updatedAt.gt":
+		var v struct{ PostUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.PostUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.UpdatedAtGT(*v.PostUpdatedAtGT), nil
+	case "updatedAt.lt":
+		var v struct{ PostUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.PostUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.UpdatedAtLT(*v.PostUpdatedAtLT), nil
+	case "has.author":
+		var v struct{ EdgeHasAuthor *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasAuthor); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasAuthor {
+			return post.Not(post.HasAuthor()), nil
+		}
+		return post.HasAuthor(), nil
+	case "author.id.eq":
+		var v struct{ EdgeAuthorIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeAuthorIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.IDEQ(*v.EdgeAuthorIDEQ)), nil
+	case "author.id.neq":
+		var v struct{ EdgeAuthorIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeAuthorIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.IDNEQ(*v.EdgeAuthorIDNEQ)), nil
+	case "author.id.in":
+		var v struct{ EdgeAuthorIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeAuthorIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.IDIn(v.EdgeAuthorIDIn...)), nil
+	case "author.id.notIn":
+		var v struct{ EdgeAuthorIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeAuthorIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.IDNotIn(v.EdgeAuthorIDNotIn...)), nil
+	case "author.createdAt.gt":
+		var v struct{ EdgeAuthorCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeAuthorCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.CreatedAtGT(*v.EdgeAuthorCreatedAtGT)), nil
+	case "author.createdAt.lt":
+		var v struct{ EdgeAuthorCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeAuthorCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.CreatedAtLT(*v.EdgeAuthorCreatedAtLT)), nil
+	case "author.updatedAt.gt":
+		var v struct{ EdgeAuthorUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeAuthorUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.UpdatedAtGT(*v.EdgeAuthorUpdatedAtGT)), nil
+	case "author.updatedAt.lt":
+		var v struct{ EdgeAuthorUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeAuthorUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.UpdatedAtLT(*v.EdgeAuthorUpdatedAtLT)), nil
+	case "author.name.eq":
+		var v struct{ EdgeAuthorNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameEQ(*v.EdgeAuthorNameEQ)), nil
+	case "author.name.neq":
+		var v struct{ EdgeAuthorNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameNEQ(*v.EdgeAuthorNameNEQ)), nil
+	case "author.name.in":
+		var v struct{ EdgeAuthorNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameIn(v.EdgeAuthorNameIn...)), nil
+	case "author.name.notIn":
+		var v struct{ EdgeAuthorNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameNotIn(v.EdgeAuthorNameNotIn...)), nil
+	case "author.name.ieq":
+		var v struct{ EdgeAuthorNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameEqualFold(*v.EdgeAuthorNameEqualFold)), nil
+	case "author.name.has":
+		var v struct{ EdgeAuthorNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameContains(*v.EdgeAuthorNameContains)), nil
+	case "author.name.ihas":
+		var v struct{ EdgeAuthorNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameContainsFold(*v.EdgeAuthorNameContainsFold)), nil
+	case "author.name.prefix":
+		var v struct{ EdgeAuthorNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameHasPrefix(*v.EdgeAuthorNameHasPrefix)), nil
+	case "author.name.suffix":
+		var v struct{ EdgeAuthorNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.NameHasSuffix(*v.EdgeAuthorNameHasSuffix)), nil
+	case "author.type.eq":
+		var v struct{ EdgeAuthorTypeEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeAuthorTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.TypeEQ(*v.EdgeAuthorTypeEQ)), nil
+	case "author.type.neq":
+		var v struct{ EdgeAuthorTypeNEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeAuthorTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.TypeNEQ(*v.EdgeAuthorTypeNEQ)), nil
+	case "author.type.in":
+		var v struct{ EdgeAuthorTypeIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeAuthorTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.TypeIn(v.EdgeAuthorTypeIn...)), nil
+	case "author.type.notIn":
+		var v struct{ EdgeAuthorTypeNotIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeAuthorTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.TypeNotIn(v.EdgeAuthorTypeNotIn...)), nil
+	case "author.description.null":
+		var v struct{ EdgeAuthorDescriptionIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeAuthorDescriptionIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeAuthorDescriptionIsNil {
+			return post.Not(post.HasAuthorWith(user.DescriptionIsNil())), nil
+		}
+		return post.HasAuthorWith(user.DescriptionIsNil()), nil
+	case "author.description.has":
+		var v struct{ EdgeAuthorDescriptionContains *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorDescriptionContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.DescriptionContains(*v.EdgeAuthorDescriptionContains)), nil
+	case "author.description.ihas":
+		var v struct{ EdgeAuthorDescriptionContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorDescriptionContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.DescriptionContainsFold(*v.EdgeAuthorDescriptionContainsFold)), nil
+	case "author.enabled.eq":
+		var v struct{ EdgeAuthorEnabledEQ *bool }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEnabledEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EnabledEQ(*v.EdgeAuthorEnabledEQ)), nil
+	case "author.email.eq":
+		var v struct{ EdgeAuthorEmailEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailEQ(*v.EdgeAuthorEmailEQ)), nil
+	case "author.email.neq":
+		var v struct{ EdgeAuthorEmailNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailNEQ(*v.EdgeAuthorEmailNEQ)), nil
+	case "author.email.null":
+		var v struct{ EdgeAuthorEmailIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeAuthorEmailIsNil {
+			return post.Not(post.HasAuthorWith(user.EmailIsNil())), nil
+		}
+		return post.HasAuthorWith(user.EmailIsNil()), nil
+	case "author.email.in":
+		var v struct{ EdgeAuthorEmailIn []string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailIn(v.EdgeAuthorEmailIn...)), nil
+	case "author.email.notIn":
+		var v struct{ EdgeAuthorEmailNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailNotIn(v.EdgeAuthorEmailNotIn...)), nil
+	case "author.email.ieq":
+		var v struct{ EdgeAuthorEmailEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailEqualFold(*v.EdgeAuthorEmailEqualFold)), nil
+	case "author.email.has":
+		var v struct{ EdgeAuthorEmailContains *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailContains(*v.EdgeAuthorEmailContains)), nil
+	case "author.email.ihas":
+		var v struct{ EdgeAuthorEmailContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailContainsFold(*v.EdgeAuthorEmailContainsFold)), nil
+	case "author.email.prefix":
+		var v struct{ EdgeAuthorEmailHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailHasPrefix(*v.EdgeAuthorEmailHasPrefix)), nil
+	case "author.email.suffix":
+		var v struct{ EdgeAuthorEmailHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeAuthorEmailHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.EmailHasSuffix(*v.EdgeAuthorEmailHasSuffix)), nil
+	case "author.lastAuthenticatedAt.eq":
+		var v struct{ EdgeAuthorLastAuthenticatedAtEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeAuthorLastAuthenticatedAtEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.LastAuthenticatedAtEQ(*v.EdgeAuthorLastAuthenticatedAtEQ)), nil
+	case "author.lastAuthenticatedAt.neq":
+		var v struct{ EdgeAuthorLastAuthenticatedAtNEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeAuthorLastAuthenticatedAtNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return post.HasAuthorWith(user.LastAuthenticatedAtNEQ(*v.EdgeAuthorLastAuthenticatedAtNEQ)), nil
+	case "author.lastAuthenticatedAt.null":
+		var v struct{ EdgeAuthorLastAuthenticatedAtIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeAuthorLastAuthenticatedAtIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeAuthorLastAuthenticatedAtIsNil {
+			return post.Not(post.HasAuthorWith(user.LastAuthenticatedAtIsNil())), nil
+		}
+		return post.HasAuthorWith(user.LastAuthenticatedAtIsNil()), nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q", key)
+	}
 }
 
 // ApplySorting applies sorting to the query based on the provided sort and order fields.
@@ -1890,32 +4263,112 @@ func (l *ListPostParams) ApplySorting(query *ent.PostQuery) error {
 	if err := l.Sorted.Validate(PostSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if len(l.terms) == 0 {
+		// No custom sort fields provided and no defaults. Cursors are keyed on the sort
+		// fields, so fall back to sorting by the ID.
+		l.terms = []sortTerm{{field: post.FieldID, order: PostSortConfig.DefaultOrder}}
+	}
+	for _, term := range l.terms {
+		applySortingPost(query, term.field, term.order, term.nulls)
+	}
+	if !l.sortedBy(post.FieldID) && !l.sortedBy("random") {
+		// Use the ID as a tie-breaker, so the order is stable across pages.
+		query.Order(withFieldSelector(post.FieldID, l.terms[len(l.terms)-1].order, ""))
+	}
+	return nil
+}
+
+// ApplyCursor applies the keyset predicate from the provided cursor (if any). The cursor
+// is keyed on all sort fields (and the ID, as a tie-breaker), so it must be used with the
+// same sort fields and orders that it was created with. Must be called after ApplySorting.
+func (l *ListPostParams) ApplyCursor(query *ent.PostQuery) error {
+	if l.Cursor == nil {
 		return nil
 	}
-	applySortingPost(query, *l.Field, *l.Order)
+
+	c, err := DecodeCursor(*l.Cursor)
+	if err != nil {
+		return err
+	}
+
+	if !c.matches(l.terms) {
+		return &ErrBadRequest{Err: errors.New("cursor does not match the provided sort fields and order")}
+	}
+
+	var id int
+	if err = c.unmarshalID(&id); err != nil {
+		return err
+	}
+
+	values := make([]any, len(c.Terms))
+	for i := range c.Terms {
+		switch c.Terms[i].Field {
+		case post.FieldCreatedAt:
+			var v time.Time
+			if err = c.Terms[i].unmarshal(&v); err != nil {
+				return err
+			}
+			values[i] = v
+		case post.FieldUpdatedAt:
+			var v time.Time
+			if err = c.Terms[i].unmarshal(&v); err != nil {
+				return err
+			}
+			values[i] = v
+		}
+	}
+
+	query.Where(predicate.Post(keysetPredicate(post.FieldID, c.Terms, values, id)))
 	return nil
 }
 
-// Exec wraps all logic (filtering, sorting, pagination, eager loading) and
+// nextCursor returns the cursor for the page following the provided Post.
+func (l *ListPostParams) nextCursor(e *ent.Post) (string, error) {
+	values := make([]any, len(l.terms))
+	for i := range l.terms {
+		switch l.terms[i].field {
+		case post.FieldCreatedAt:
+			values[i] = e.CreatedAt
+		case post.FieldUpdatedAt:
+			values[i] = e.UpdatedAt
+		}
+	}
+	return EncodeCursor(l.terms, values, e.ID)
+}
+
+// Exec wraps all logic (filtering, sorting, cursor pagination, eager loading) and
 // executes all necessary queries, returning the results.
-func (l *ListPostParams) Exec(ctx context.Context, query *ent.PostQuery) (results *PagedResponse[ent.Post], err error) {
+func (l *ListPostParams) Exec(ctx context.Context, query *ent.PostQuery) (results *CursorPagedResponse[ent.Post], err error) {
 	predicates, err := l.FilterPredicates()
 	if err != nil {
 		return nil, err
 	}
 	query.Where(predicates)
-	err = l.ApplySorting(EagerLoadPost(query))
+	EagerLoadPost(query)
+	err = l.ApplySorting(query)
+	if err != nil {
+		return nil, err
+	}
+	err = l.ApplyCursor(query)
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, PostPageConfig)
+	if _, err = SelectPost(query, l.Fields); err != nil {
+		return nil, err
+	}
+	if l.Fields != nil && len(l.Fields.Fields) > 0 {
+		for _, term := range l.terms {
+			if !slices.Contains(l.Fields.Fields, term.field) {
+				query.Select(term.field) // Needed to build the next cursor.
+			}
+		}
+	}
+	return l.ExecuteCursor(ctx, query, PostPageConfig, l.nextCursor)
 }
 
-// ListSettingParams defines parameters for listing Settings via a GET request.
-type ListSettingParams struct {
-	Sorted
-	Paginated[*ent.SettingsQuery, ent.Settings]
+// FilterSettingParams defines the filter-related parameters for Settings, which
+// are shared by all operations which filter Settings (e.g. list and bulk operations).
+type FilterSettingParams struct {
 	Filtered[predicate.Settings]
 
 	// Filters field "id" to be equal to the provided value.
@@ -1936,36 +4389,107 @@ type ListSettingParams struct {
 	SettingsUpdatedAtLT *time.Time `form:"updatedAt.lt,omitempty" json:"settings_updated_at_lt,omitempty"`
 }
 
+// ListSettingParams defines parameters for listing Settings via a GET request.
+type ListSettingParams struct {
+	Sorted
+	Paginated[*ent.SettingsQuery, ent.Settings]
+	FilterSettingParams
+	// Fields contains the fields requested by the client. As "fields" and "fields[<edge>]"
+	// can't be decoded together, this is populated from [ParseFieldSelection] instead.
+	Fields *FieldSelection `json:"-" form:"-"`
+}
+
 // FilterPredicates returns the predicates for filter-related parameters in Setting.
-func (l *ListSettingParams) FilterPredicates() (predicate.Settings, error) {
+func (p *FilterSettingParams) FilterPredicates() (predicate.Settings, error) {
 	var predicates []predicate.Settings
 
-	if l.SettingsIDEQ != nil {
-		predicates = append(predicates, settings.IDEQ(*l.SettingsIDEQ))
+	if p.SettingsIDEQ != nil {
+		predicates = append(predicates, settings.IDEQ(*p.SettingsIDEQ))
 	}
-	if l.SettingsIDNEQ != nil {
-		predicates = append(predicates, settings.IDNEQ(*l.SettingsIDNEQ))
+	if p.SettingsIDNEQ != nil {
+		predicates = append(predicates, settings.IDNEQ(*p.SettingsIDNEQ))
 	}
-	if l.SettingsIDIn != nil {
-		predicates = append(predicates, settings.IDIn(l.SettingsIDIn...))
+	if p.SettingsIDIn != nil {
+		predicates = append(predicates, settings.IDIn(p.SettingsIDIn...))
 	}
-	if l.SettingsIDNotIn != nil {
-		predicates = append(predicates, settings.IDNotIn(l.SettingsIDNotIn...))
+	if p.SettingsIDNotIn != nil {
+		predicates = append(predicates, settings.IDNotIn(p.SettingsIDNotIn...))
 	}
-	if l.SettingsCreatedAtGT != nil {
-		predicates = append(predicates, settings.CreatedAtGT(*l.SettingsCreatedAtGT))
+	if p.SettingsCreatedAtGT != nil {
+		predicates = append(predicates, settings.CreatedAtGT(*p.SettingsCreatedAtGT))
 	}
-	if l.SettingsCreatedAtLT != nil {
-		predicates = append(predicates, settings.CreatedAtLT(*l.SettingsCreatedAtLT))
+	if p.SettingsCreatedAtLT != nil {
+		predicates = append(predicates, settings.CreatedAtLT(*p.SettingsCreatedAtLT))
 	}
-	if l.SettingsUpdatedAtGT != nil {
-		predicates = append(predicates, settings.UpdatedAtGT(*l.SettingsUpdatedAtGT))
+	if p.SettingsUpdatedAtGT != nil {
+		predicates = append(predicates, settings.UpdatedAtGT(*p.SettingsUpdatedAtGT))
 	}
-	if l.SettingsUpdatedAtLT != nil {
-		predicates = append(predicates, settings.UpdatedAtLT(*l.SettingsUpdatedAtLT))
+	if p.SettingsUpdatedAtLT != nil {
+		predicates = append(predicates, settings.UpdatedAtLT(*p.SettingsUpdatedAtLT))
+	}
+
+	pred, err := p.ApplyFilterOperation(predicates...)
+	if err != nil {
+		return nil, err
+	}
+	return p.ApplyFilterExpression(pred, filterPredicateSetting)
+}
+
+// filterPredicateSetting returns the predicate for a single filter within a filter
+// expression, where key is the name of the associated filter parameter.
+func filterPredicateSetting(key string, value json.RawMessage) (predicate.Settings, error) {
+	switch key {
+	case "id.eq":
+		var v struct{ SettingsIDEQ *int }
+		if err := json.Unmarshal(value, &v.SettingsIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.IDEQ(*v.SettingsIDEQ), nil
+	case "id.neq":
+		var v struct{ SettingsIDNEQ *int }
+		if err := json.Unmarshal(value, &v.SettingsIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.IDNEQ(*v.SettingsIDNEQ), nil
+	case "id.in":
+		var v struct{ SettingsIDIn []int }
+		if err := json.Unmarshal(value, &v.SettingsIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.IDIn(v.SettingsIDIn...), nil
+	case "id.notIn":
+		var v struct{ SettingsIDNotIn []int }
+		if err := json.Unmarshal(value, &v.SettingsIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.IDNotIn(v.SettingsIDNotIn...), nil
+	case "createdAt.gt":
+		var v struct{ SettingsCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.SettingsCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.CreatedAtGT(*v.SettingsCreatedAtGT), nil
+	case "createdAt.lt":
+		var v struct{ SettingsCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.SettingsCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.CreatedAtLT(*v.SettingsCreatedAtLT), nil
+	case "updatedAt.gt":
+		var v struct{ SettingsUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.SettingsUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.UpdatedAtGT(*v.SettingsUpdatedAtGT), nil
+	case "updatedAt.lt":
+		var v struct{ SettingsUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.SettingsUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return settings.UpdatedAtLT(*v.SettingsUpdatedAtLT), nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q", key)
 	}
-
-	return l.ApplyFilterOperation(predicates...)
 }
 
 // ApplySorting applies sorting to the query based on the provided sort and order fields.
@@ -1973,10 +4497,16 @@ func (l *ListSettingParams) ApplySorting(query *ent.SettingsQuery) error {
 	if err := l.Sorted.Validate(SettingSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if len(l.terms) == 0 { // No custom sort fields provided and no defaults, so don't do anything.
 		return nil
 	}
-	applySortingSetting(query, *l.Field, *l.Order)
+	for _, term := range l.terms {
+		applySortingSetting(query, term.field, term.order, term.nulls)
+	}
+	if !l.sortedBy(settings.FieldID) && !l.sortedBy("random") {
+		// Use the ID as a tie-breaker, so the order is stable across pages.
+		query.Order(withFieldSelector(settings.FieldID, l.terms[len(l.terms)-1].order, ""))
+	}
 	return nil
 }
 
@@ -1988,17 +4518,30 @@ func (l *ListSettingParams) Exec(ctx context.Context, query *ent.SettingsQuery)
 		return nil, err
 	}
 	query.Where(predicates)
-	err = l.ApplySorting(EagerLoadSetting(query))
+	EagerLoadSetting(query)
+	err = l.ApplySorting(query)
+	if err != nil {
+		return nil, err
+	}
+	l.selector = func(ctx context.Context) (*sql.Selector, error) {
+		return resolveSelector(func(fn func(*sql.Selector)) (int, error) {
+			return query.Clone().Where(fn).Count(ctx)
+		})
+	}
+	// Fields are selected after counting, so the count isn't affected by the selected columns.
+	_, err = l.ApplyPagination(ctx, query, SettingPageConfig)
 	if err != nil {
 		return nil, err
 	}
+	if _, err = SelectSetting(query, l.Fields); err != nil {
+		return nil, err
+	}
 	return l.ExecutePaginated(ctx, query, SettingPageConfig)
 }
 
-// ListUserParams defines parameters for listing Users via a GET request.
-type ListUserParams struct {
-	Sorted
-	Paginated[*ent.UserQuery, ent.User]
+// FilterUserParams defines the filter-related parameters for Users, which
+// are shared by all operations which filter Users (e.g. list and bulk operations).
+type FilterUserParams struct {
 	Filtered[predicate.User]
 
 	// Filters field "id" to be equal to the provided value.
@@ -2304,550 +4847,1550 @@ type ListUserParams struct {
 	UserFilterGroupSearchHasSuffix *string `form:"search.suffix,omitempty" json:"user_filter_group_search_has_suffix,omitempty"`
 }
 
+// ListUserParams defines parameters for listing Users via a GET request.
+type ListUserParams struct {
+	Sorted
+	Paginated[*ent.UserQuery, ent.User]
+	FilterUserParams
+	// Expand contains the edges requested to be eager-loaded by the client.
+	Expand []string `json:"expand,omitempty" form:"expand,omitempty"`
+	// Fields contains the fields requested by the client. As "fields" and "fields[<edge>]"
+	// can't be decoded together, this is populated from [ParseFieldSelection] instead.
+	Fields *FieldSelection `json:"-" form:"-"`
+}
+
 // FilterPredicates returns the predicates for filter-related parameters in User.
-func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
+func (p *FilterUserParams) FilterPredicates() (predicate.User, error) {
 	var predicates []predicate.User
 
-	if l.UserIDEQ != nil {
-		predicates = append(predicates, user.IDEQ(*l.UserIDEQ))
+	if p.UserIDEQ != nil {
+		predicates = append(predicates, user.IDEQ(*p.UserIDEQ))
 	}
-	if l.UserIDNEQ != nil {
-		predicates = append(predicates, user.IDNEQ(*l.UserIDNEQ))
+	if p.UserIDNEQ != nil {
+		predicates = append(predicates, user.IDNEQ(*p.UserIDNEQ))
 	}
-	if l.UserIDIn != nil {
-		predicates = append(predicates, user.IDIn(l.UserIDIn...))
+	if p.UserIDIn != nil {
+		predicates = append(predicates, user.IDIn(p.UserIDIn...))
 	}
-	if l.UserIDNotIn != nil {
-		predicates = append(predicates, user.IDNotIn(l.UserIDNotIn...))
+	if p.UserIDNotIn != nil {
+		predicates = append(predicates, user.IDNotIn(p.UserIDNotIn...))
 	}
-	if l.UserCreatedAtGT != nil {
-		predicates = append(predicates, user.CreatedAtGT(*l.UserCreatedAtGT))
+	if p.UserCreatedAtGT != nil {
+		predicates = append(predicates, user.CreatedAtGT(*p.UserCreatedAtGT))
 	}
-	if l.UserCreatedAtLT != nil {
-		predicates = append(predicates, user.CreatedAtLT(*l.UserCreatedAtLT))
+	if p.UserCreatedAtLT != nil {
+		predicates = append(predicates, user.CreatedAtLT(*p.UserCreatedAtLT))
 	}
-	if l.UserUpdatedAtGT != nil {
-		predicates = append(predicates, user.UpdatedAtGT(*l.UserUpdatedAtGT))
+	if p.UserUpdatedAtGT != nil {
+		predicates = append(predicates, user.UpdatedAtGT(*p.UserUpdatedAtGT))
 	}
-	if l.UserUpdatedAtLT != nil {
-		predicates = append(predicates, user.UpdatedAtLT(*l.UserUpdatedAtLT))
+	if p.UserUpdatedAtLT != nil {
+		predicates = append(predicates, user.UpdatedAtLT(*p.UserUpdatedAtLT))
 	}
-	if l.UserNameEQ != nil {
-		predicates = append(predicates, user.NameEQ(*l.UserNameEQ))
+	if p.UserNameEQ != nil {
+		predicates = append(predicates, user.NameEQ(*p.UserNameEQ))
 	}
-	if l.UserNameNEQ != nil {
-		predicates = append(predicates, user.NameNEQ(*l.UserNameNEQ))
+	if p.UserNameNEQ != nil {
+		predicates = append(predicates, user.NameNEQ(*p.UserNameNEQ))
 	}
-	if l.UserNameIn != nil {
-		predicates = append(predicates, user.NameIn(l.UserNameIn...))
+	if p.UserNameIn != nil {
+		predicates = append(predicates, user.NameIn(p.UserNameIn...))
 	}
-	if l.UserNameNotIn != nil {
-		predicates = append(predicates, user.NameNotIn(l.UserNameNotIn...))
+	if p.UserNameNotIn != nil {
+		predicates = append(predicates, user.NameNotIn(p.UserNameNotIn...))
 	}
-	if l.UserNameEqualFold != nil {
-		predicates = append(predicates, user.NameEqualFold(*l.UserNameEqualFold))
+	if p.UserNameEqualFold != nil {
+		predicates = append(predicates, user.NameEqualFold(*p.UserNameEqualFold))
 	}
-	if l.UserNameContains != nil {
-		predicates = append(predicates, user.NameContains(*l.UserNameContains))
+	if p.UserNameContains != nil {
+		predicates = append(predicates, user.NameContains(*p.UserNameContains))
 	}
-	if l.UserNameContainsFold != nil {
-		predicates = append(predicates, user.NameContainsFold(*l.UserNameContainsFold))
+	if p.UserNameContainsFold != nil {
+		predicates = append(predicates, user.NameContainsFold(*p.UserNameContainsFold))
 	}
-	if l.UserNameHasPrefix != nil {
-		predicates = append(predicates, user.NameHasPrefix(*l.UserNameHasPrefix))
+	if p.UserNameHasPrefix != nil {
+		predicates = append(predicates, user.NameHasPrefix(*p.UserNameHasPrefix))
 	}
-	if l.UserNameHasSuffix != nil {
-		predicates = append(predicates, user.NameHasSuffix(*l.UserNameHasSuffix))
+	if p.UserNameHasSuffix != nil {
+		predicates = append(predicates, user.NameHasSuffix(*p.UserNameHasSuffix))
 	}
-	if l.UserTypeEQ != nil {
-		predicates = append(predicates, user.TypeEQ(*l.UserTypeEQ))
+	if p.UserTypeEQ != nil {
+		predicates = append(predicates, user.TypeEQ(*p.UserTypeEQ))
 	}
-	if l.UserTypeNEQ != nil {
-		predicates = append(predicates, user.TypeNEQ(*l.UserTypeNEQ))
+	if p.UserTypeNEQ != nil {
+		predicates = append(predicates, user.TypeNEQ(*p.UserTypeNEQ))
 	}
-	if l.UserTypeIn != nil {
-		predicates = append(predicates, user.TypeIn(l.UserTypeIn...))
+	if p.UserTypeIn != nil {
+		predicates = append(predicates, user.TypeIn(p.UserTypeIn...))
 	}
-	if l.UserTypeNotIn != nil {
-		predicates = append(predicates, user.TypeNotIn(l.UserTypeNotIn...))
+	if p.UserTypeNotIn != nil {
+		predicates = append(predicates, user.TypeNotIn(p.UserTypeNotIn...))
 	}
-	if l.UserDescriptionIsNil != nil {
-		if *l.UserDescriptionIsNil {
+	if p.UserDescriptionIsNil != nil {
+		if *p.UserDescriptionIsNil {
 			predicates = append(predicates, user.DescriptionIsNil())
 		} else {
 			predicates = append(predicates, user.Not(user.DescriptionIsNil()))
 		}
 	}
-	if l.UserDescriptionContains != nil {
-		predicates = append(predicates, user.DescriptionContains(*l.UserDescriptionContains))
+	if p.UserDescriptionContains != nil {
+		predicates = append(predicates, user.DescriptionContains(*p.UserDescriptionContains))
 	}
-	if l.UserDescriptionContainsFold != nil {
-		predicates = append(predicates, user.DescriptionContainsFold(*l.UserDescriptionContainsFold))
+	if p.UserDescriptionContainsFold != nil {
+		predicates = append(predicates, user.DescriptionContainsFold(*p.UserDescriptionContainsFold))
 	}
-	if l.UserEnabledEQ != nil {
-		predicates = append(predicates, user.EnabledEQ(*l.UserEnabledEQ))
+	if p.UserEnabledEQ != nil {
+		predicates = append(predicates, user.EnabledEQ(*p.UserEnabledEQ))
 	}
-	if l.UserEmailEQ != nil {
-		predicates = append(predicates, user.EmailEQ(*l.UserEmailEQ))
+	if p.UserEmailEQ != nil {
+		predicates = append(predicates, user.EmailEQ(*p.UserEmailEQ))
 	}
-	if l.UserEmailNEQ != nil {
-		predicates = append(predicates, user.EmailNEQ(*l.UserEmailNEQ))
+	if p.UserEmailNEQ != nil {
+		predicates = append(predicates, user.EmailNEQ(*p.UserEmailNEQ))
 	}
-	if l.UserEmailIsNil != nil {
-		if *l.UserEmailIsNil {
+	if p.UserEmailIsNil != nil {
+		if *p.UserEmailIsNil {
 			predicates = append(predicates, user.EmailIsNil())
 		} else {
 			predicates = append(predicates, user.Not(user.EmailIsNil()))
 		}
 	}
-	if l.UserEmailIn != nil {
-		predicates = append(predicates, user.EmailIn(l.UserEmailIn...))
+	if p.UserEmailIn != nil {
+		predicates = append(predicates, user.EmailIn(p.UserEmailIn...))
 	}
-	if l.UserEmailNotIn != nil {
-		predicates = append(predicates, user.EmailNotIn(l.UserEmailNotIn...))
+	if p.UserEmailNotIn != nil {
+		predicates = append(predicates, user.EmailNotIn(p.UserEmailNotIn...))
 	}
-	if l.UserEmailEqualFold != nil {
-		predicates = append(predicates, user.EmailEqualFold(*l.UserEmailEqualFold))
+	if p.UserEmailEqualFold != nil {
+		predicates = append(predicates, user.EmailEqualFold(*p.UserEmailEqualFold))
 	}
-	if l.UserEmailContains != nil {
-		predicates = append(predicates, user.EmailContains(*l.UserEmailContains))
+	if p.UserEmailContains != nil {
+		predicates = append(predicates, user.EmailContains(*p.UserEmailContains))
 	}
-	if l.UserEmailContainsFold != nil {
-		predicates = append(predicates, user.EmailContainsFold(*l.UserEmailContainsFold))
+	if p.UserEmailContainsFold != nil {
+		predicates = append(predicates, user.EmailContainsFold(*p.UserEmailContainsFold))
 	}
-	if l.UserEmailHasPrefix != nil {
-		predicates = append(predicates, user.EmailHasPrefix(*l.UserEmailHasPrefix))
+	if p.UserEmailHasPrefix != nil {
+		predicates = append(predicates, user.EmailHasPrefix(*p.UserEmailHasPrefix))
 	}
-	if l.UserEmailHasSuffix != nil {
-		predicates = append(predicates, user.EmailHasSuffix(*l.UserEmailHasSuffix))
+	if p.UserEmailHasSuffix != nil {
+		predicates = append(predicates, user.EmailHasSuffix(*p.UserEmailHasSuffix))
 	}
-	if l.UserLastAuthenticatedAtEQ != nil {
-		predicates = append(predicates, user.LastAuthenticatedAtEQ(*l.UserLastAuthenticatedAtEQ))
+	if p.UserLastAuthenticatedAtEQ != nil {
+		predicates = append(predicates, user.LastAuthenticatedAtEQ(*p.UserLastAuthenticatedAtEQ))
 	}
-	if l.UserLastAuthenticatedAtNEQ != nil {
-		predicates = append(predicates, user.LastAuthenticatedAtNEQ(*l.UserLastAuthenticatedAtNEQ))
+	if p.UserLastAuthenticatedAtNEQ != nil {
+		predicates = append(predicates, user.LastAuthenticatedAtNEQ(*p.UserLastAuthenticatedAtNEQ))
 	}
-	if l.UserLastAuthenticatedAtIsNil != nil {
-		if *l.UserLastAuthenticatedAtIsNil {
+	if p.UserLastAuthenticatedAtIsNil != nil {
+		if *p.UserLastAuthenticatedAtIsNil {
 			predicates = append(predicates, user.LastAuthenticatedAtIsNil())
 		} else {
 			predicates = append(predicates, user.Not(user.LastAuthenticatedAtIsNil()))
 		}
 	}
-	if l.EdgeHasPet != nil {
-		if *l.EdgeHasPet {
+	if p.EdgeHasPet != nil {
+		if *p.EdgeHasPet {
 			predicates = append(predicates, user.HasPets())
 		} else {
 			predicates = append(predicates, user.Not(user.HasPets()))
 		}
 	}
-	if l.EdgePetIDEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.IDEQ(*l.EdgePetIDEQ)))
+	if p.EdgePetIDEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.IDEQ(*p.EdgePetIDEQ)))
 	}
-	if l.EdgePetIDNEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.IDNEQ(*l.EdgePetIDNEQ)))
+	if p.EdgePetIDNEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.IDNEQ(*p.EdgePetIDNEQ)))
 	}
-	if l.EdgePetIDIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.IDIn(l.EdgePetIDIn...)))
+	if p.EdgePetIDIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.IDIn(p.EdgePetIDIn...)))
 	}
-	if l.EdgePetIDNotIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.IDNotIn(l.EdgePetIDNotIn...)))
+	if p.EdgePetIDNotIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.IDNotIn(p.EdgePetIDNotIn...)))
 	}
-	if l.EdgePetNameEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameEQ(*l.EdgePetNameEQ)))
+	if p.EdgePetNameEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameEQ(*p.EdgePetNameEQ)))
 	}
-	if l.EdgePetNameNEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameNEQ(*l.EdgePetNameNEQ)))
+	if p.EdgePetNameNEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameNEQ(*p.EdgePetNameNEQ)))
 	}
-	if l.EdgePetNameIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameIn(l.EdgePetNameIn...)))
+	if p.EdgePetNameIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameIn(p.EdgePetNameIn...)))
 	}
-	if l.EdgePetNameNotIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameNotIn(l.EdgePetNameNotIn...)))
+	if p.EdgePetNameNotIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameNotIn(p.EdgePetNameNotIn...)))
 	}
-	if l.EdgePetNameEqualFold != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameEqualFold(*l.EdgePetNameEqualFold)))
+	if p.EdgePetNameEqualFold != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameEqualFold(*p.EdgePetNameEqualFold)))
 	}
-	if l.EdgePetNameContains != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameContains(*l.EdgePetNameContains)))
+	if p.EdgePetNameContains != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameContains(*p.EdgePetNameContains)))
 	}
-	if l.EdgePetNameContainsFold != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameContainsFold(*l.EdgePetNameContainsFold)))
+	if p.EdgePetNameContainsFold != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameContainsFold(*p.EdgePetNameContainsFold)))
 	}
-	if l.EdgePetNameHasPrefix != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameHasPrefix(*l.EdgePetNameHasPrefix)))
+	if p.EdgePetNameHasPrefix != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameHasPrefix(*p.EdgePetNameHasPrefix)))
 	}
-	if l.EdgePetNameHasSuffix != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.NameHasSuffix(*l.EdgePetNameHasSuffix)))
+	if p.EdgePetNameHasSuffix != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameHasSuffix(*p.EdgePetNameHasSuffix)))
 	}
-	if l.EdgePetNicknamesIsNil != nil {
-		if *l.EdgePetNicknamesIsNil {
+	if p.EdgePetNicknamesIsNil != nil {
+		if *p.EdgePetNicknamesIsNil {
 			predicates = append(predicates, user.HasPetsWith(pet.NicknamesIsNil()))
 		} else {
 			predicates = append(predicates, user.Not(user.HasPetsWith(pet.NicknamesIsNil())))
 		}
 	}
-	if l.EdgePetAgeEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.AgeEQ(*l.EdgePetAgeEQ)))
+	if p.EdgePetAgeEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeEQ(*p.EdgePetAgeEQ)))
 	}
-	if l.EdgePetAgeNEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.AgeNEQ(*l.EdgePetAgeNEQ)))
+	if p.EdgePetAgeNEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeNEQ(*p.EdgePetAgeNEQ)))
 	}
-	if l.EdgePetAgeGT != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.AgeGT(*l.EdgePetAgeGT)))
+	if p.EdgePetAgeGT != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeGT(*p.EdgePetAgeGT)))
 	}
-	if l.EdgePetAgeLT != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.AgeLT(*l.EdgePetAgeLT)))
+	if p.EdgePetAgeLT != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeLT(*p.EdgePetAgeLT)))
 	}
-	if l.EdgePetAgeIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.AgeIn(l.EdgePetAgeIn...)))
+	if p.EdgePetAgeIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeIn(p.EdgePetAgeIn...)))
 	}
-	if l.EdgePetAgeNotIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.AgeNotIn(l.EdgePetAgeNotIn...)))
+	if p.EdgePetAgeNotIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeNotIn(p.EdgePetAgeNotIn...)))
 	}
-	if l.EdgePetTypeEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.TypeEQ(*l.EdgePetTypeEQ)))
+	if p.EdgePetTypeEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.TypeEQ(*p.EdgePetTypeEQ)))
 	}
-	if l.EdgePetTypeNEQ != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.TypeNEQ(*l.EdgePetTypeNEQ)))
+	if p.EdgePetTypeNEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.TypeNEQ(*p.EdgePetTypeNEQ)))
 	}
-	if l.EdgePetTypeIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.TypeIn(l.EdgePetTypeIn...)))
+	if p.EdgePetTypeIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.TypeIn(p.EdgePetTypeIn...)))
 	}
-	if l.EdgePetTypeNotIn != nil {
-		predicates = append(predicates, user.HasPetsWith(pet.TypeNotIn(l.EdgePetTypeNotIn...)))
+	if p.EdgePetTypeNotIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.TypeNotIn(p.EdgePetTypeNotIn...)))
 	}
-	if l.EdgeHasFollowedPet != nil {
-		if *l.EdgeHasFollowedPet {
+	if p.EdgeHasFollowedPet != nil {
+		if *p.EdgeHasFollowedPet {
 			predicates = append(predicates, user.HasFollowedPets())
 		} else {
 			predicates = append(predicates, user.Not(user.HasFollowedPets()))
 		}
 	}
-	if l.EdgeFollowedPetIDEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDEQ(*l.EdgeFollowedPetIDEQ)))
+	if p.EdgeFollowedPetIDEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDEQ(*p.EdgeFollowedPetIDEQ)))
 	}
-	if l.EdgeFollowedPetIDNEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDNEQ(*l.EdgeFollowedPetIDNEQ)))
+	if p.EdgeFollowedPetIDNEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDNEQ(*p.EdgeFollowedPetIDNEQ)))
 	}
-	if l.EdgeFollowedPetIDIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDIn(l.EdgeFollowedPetIDIn...)))
+	if p.EdgeFollowedPetIDIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDIn(p.EdgeFollowedPetIDIn...)))
 	}
-	if l.EdgeFollowedPetIDNotIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDNotIn(l.EdgeFollowedPetIDNotIn...)))
+	if p.EdgeFollowedPetIDNotIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.IDNotIn(p.EdgeFollowedPetIDNotIn...)))
 	}
-	if l.EdgeFollowedPetNameEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameEQ(*l.EdgeFollowedPetNameEQ)))
+	if p.EdgeFollowedPetNameEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameEQ(*p.EdgeFollowedPetNameEQ)))
 	}
-	if l.EdgeFollowedPetNameNEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameNEQ(*l.EdgeFollowedPetNameNEQ)))
+	if p.EdgeFollowedPetNameNEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameNEQ(*p.EdgeFollowedPetNameNEQ)))
 	}
-	if l.EdgeFollowedPetNameIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameIn(l.EdgeFollowedPetNameIn...)))
+	if p.EdgeFollowedPetNameIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameIn(p.EdgeFollowedPetNameIn...)))
 	}
-	if l.EdgeFollowedPetNameNotIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameNotIn(l.EdgeFollowedPetNameNotIn...)))
+	if p.EdgeFollowedPetNameNotIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameNotIn(p.EdgeFollowedPetNameNotIn...)))
 	}
-	if l.EdgeFollowedPetNameEqualFold != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameEqualFold(*l.EdgeFollowedPetNameEqualFold)))
+	if p.EdgeFollowedPetNameEqualFold != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameEqualFold(*p.EdgeFollowedPetNameEqualFold)))
 	}
-	if l.EdgeFollowedPetNameContains != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameContains(*l.EdgeFollowedPetNameContains)))
+	if p.EdgeFollowedPetNameContains != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameContains(*p.EdgeFollowedPetNameContains)))
 	}
-	if l.EdgeFollowedPetNameContainsFold != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameContainsFold(*l.EdgeFollowedPetNameContainsFold)))
+	if p.EdgeFollowedPetNameContainsFold != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameContainsFold(*p.EdgeFollowedPetNameContainsFold)))
 	}
-	if l.EdgeFollowedPetNameHasPrefix != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameHasPrefix(*l.EdgeFollowedPetNameHasPrefix)))
+	if p.EdgeFollowedPetNameHasPrefix != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameHasPrefix(*p.EdgeFollowedPetNameHasPrefix)))
 	}
-	if l.EdgeFollowedPetNameHasSuffix != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameHasSuffix(*l.EdgeFollowedPetNameHasSuffix)))
+	if p.EdgeFollowedPetNameHasSuffix != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.NameHasSuffix(*p.EdgeFollowedPetNameHasSuffix)))
 	}
-	if l.EdgeFollowedPetNicknamesIsNil != nil {
-		if *l.EdgeFollowedPetNicknamesIsNil {
+	if p.EdgeFollowedPetNicknamesIsNil != nil {
+		if *p.EdgeFollowedPetNicknamesIsNil {
 			predicates = append(predicates, user.HasFollowedPetsWith(pet.NicknamesIsNil()))
 		} else {
 			predicates = append(predicates, user.Not(user.HasFollowedPetsWith(pet.NicknamesIsNil())))
 		}
 	}
-	if l.EdgeFollowedPetAgeEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeEQ(*l.EdgeFollowedPetAgeEQ)))
+	if p.EdgeFollowedPetAgeEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeEQ(*p.EdgeFollowedPetAgeEQ)))
 	}
-	if l.EdgeFollowedPetAgeNEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeNEQ(*l.EdgeFollowedPetAgeNEQ)))
+	if p.EdgeFollowedPetAgeNEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeNEQ(*p.EdgeFollowedPetAgeNEQ)))
 	}
-	if l.EdgeFollowedPetAgeGT != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeGT(*l.EdgeFollowedPetAgeGT)))
+	if p.EdgeFollowedPetAgeGT != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeGT(*p.EdgeFollowedPetAgeGT)))
 	}
-	if l.EdgeFollowedPetAgeLT != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeLT(*l.EdgeFollowedPetAgeLT)))
+	if p.EdgeFollowedPetAgeLT != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeLT(*p.EdgeFollowedPetAgeLT)))
 	}
-	if l.EdgeFollowedPetAgeIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeIn(l.EdgeFollowedPetAgeIn...)))
+	if p.EdgeFollowedPetAgeIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeIn(p.EdgeFollowedPetAgeIn...)))
 	}
-	if l.EdgeFollowedPetAgeNotIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeNotIn(l.EdgeFollowedPetAgeNotIn...)))
+	if p.EdgeFollowedPetAgeNotIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeNotIn(p.EdgeFollowedPetAgeNotIn...)))
 	}
-	if l.EdgeFollowedPetTypeEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeEQ(*l.EdgeFollowedPetTypeEQ)))
+	if p.EdgeFollowedPetTypeEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeEQ(*p.EdgeFollowedPetTypeEQ)))
 	}
-	if l.EdgeFollowedPetTypeNEQ != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeNEQ(*l.EdgeFollowedPetTypeNEQ)))
+	if p.EdgeFollowedPetTypeNEQ != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeNEQ(*p.EdgeFollowedPetTypeNEQ)))
 	}
-	if l.EdgeFollowedPetTypeIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeIn(l.EdgeFollowedPetTypeIn...)))
+	if p.EdgeFollowedPetTypeIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeIn(p.EdgeFollowedPetTypeIn...)))
 	}
-	if l.EdgeFollowedPetTypeNotIn != nil {
-		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeNotIn(l.EdgeFollowedPetTypeNotIn...)))
+	if p.EdgeFollowedPetTypeNotIn != nil {
+		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeNotIn(p.EdgeFollowedPetTypeNotIn...)))
 	}
-	if l.EdgeHasFriend != nil {
-		if *l.EdgeHasFriend {
+	if p.EdgeHasFriend != nil {
+		if *p.EdgeHasFriend {
 			predicates = append(predicates, user.HasFriends())
 		} else {
 			predicates = append(predicates, user.Not(user.HasFriends()))
 		}
 	}
-	if l.EdgeFriendIDEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.IDEQ(*l.EdgeFriendIDEQ)))
+	if p.EdgeFriendIDEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.IDEQ(*p.EdgeFriendIDEQ)))
 	}
-	if l.EdgeFriendIDNEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.IDNEQ(*l.EdgeFriendIDNEQ)))
+	if p.EdgeFriendIDNEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.IDNEQ(*p.EdgeFriendIDNEQ)))
 	}
-	if l.EdgeFriendIDIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.IDIn(l.EdgeFriendIDIn...)))
+	if p.EdgeFriendIDIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.IDIn(p.EdgeFriendIDIn...)))
 	}
-	if l.EdgeFriendIDNotIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.IDNotIn(l.EdgeFriendIDNotIn...)))
+	if p.EdgeFriendIDNotIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.IDNotIn(p.EdgeFriendIDNotIn...)))
 	}
-	if l.EdgeFriendCreatedAtGT != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.CreatedAtGT(*l.EdgeFriendCreatedAtGT)))
+	if p.EdgeFriendCreatedAtGT != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.CreatedAtGT(*p.EdgeFriendCreatedAtGT)))
 	}
-	if l.EdgeFriendCreatedAtLT != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.CreatedAtLT(*l.EdgeFriendCreatedAtLT)))
+	if p.EdgeFriendCreatedAtLT != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.CreatedAtLT(*p.EdgeFriendCreatedAtLT)))
 	}
-	if l.EdgeFriendUpdatedAtGT != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.UpdatedAtGT(*l.EdgeFriendUpdatedAtGT)))
+	if p.EdgeFriendUpdatedAtGT != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.UpdatedAtGT(*p.EdgeFriendUpdatedAtGT)))
 	}
-	if l.EdgeFriendUpdatedAtLT != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.UpdatedAtLT(*l.EdgeFriendUpdatedAtLT)))
+	if p.EdgeFriendUpdatedAtLT != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.UpdatedAtLT(*p.EdgeFriendUpdatedAtLT)))
 	}
-	if l.EdgeFriendNameEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameEQ(*l.EdgeFriendNameEQ)))
+	if p.EdgeFriendNameEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameEQ(*p.EdgeFriendNameEQ)))
 	}
-	if l.EdgeFriendNameNEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameNEQ(*l.EdgeFriendNameNEQ)))
+	if p.EdgeFriendNameNEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameNEQ(*p.EdgeFriendNameNEQ)))
 	}
-	if l.EdgeFriendNameIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameIn(l.EdgeFriendNameIn...)))
+	if p.EdgeFriendNameIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameIn(p.EdgeFriendNameIn...)))
 	}
-	if l.EdgeFriendNameNotIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameNotIn(l.EdgeFriendNameNotIn...)))
+	if p.EdgeFriendNameNotIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameNotIn(p.EdgeFriendNameNotIn...)))
 	}
-	if l.EdgeFriendNameEqualFold != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameEqualFold(*l.EdgeFriendNameEqualFold)))
+	if p.EdgeFriendNameEqualFold != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameEqualFold(*p.EdgeFriendNameEqualFold)))
 	}
-	if l.EdgeFriendNameContains != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameContains(*l.EdgeFriendNameContains)))
+	if p.EdgeFriendNameContains != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameContains(*p.EdgeFriendNameContains)))
 	}
-	if l.EdgeFriendNameContainsFold != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameContainsFold(*l.EdgeFriendNameContainsFold)))
+	if p.EdgeFriendNameContainsFold != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameContainsFold(*p.EdgeFriendNameContainsFold)))
 	}
-	if l.EdgeFriendNameHasPrefix != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameHasPrefix(*l.EdgeFriendNameHasPrefix)))
+	if p.EdgeFriendNameHasPrefix != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameHasPrefix(*p.EdgeFriendNameHasPrefix)))
 	}
-	if l.EdgeFriendNameHasSuffix != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.NameHasSuffix(*l.EdgeFriendNameHasSuffix)))
+	if p.EdgeFriendNameHasSuffix != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.NameHasSuffix(*p.EdgeFriendNameHasSuffix)))
 	}
-	if l.EdgeFriendTypeEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.TypeEQ(*l.EdgeFriendTypeEQ)))
+	if p.EdgeFriendTypeEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.TypeEQ(*p.EdgeFriendTypeEQ)))
 	}
-	if l.EdgeFriendTypeNEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.TypeNEQ(*l.EdgeFriendTypeNEQ)))
+	if p.EdgeFriendTypeNEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.TypeNEQ(*p.EdgeFriendTypeNEQ)))
 	}
-	if l.EdgeFriendTypeIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.TypeIn(l.EdgeFriendTypeIn...)))
+	if p.EdgeFriendTypeIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.TypeIn(p.EdgeFriendTypeIn...)))
 	}
-	if l.EdgeFriendTypeNotIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.TypeNotIn(l.EdgeFriendTypeNotIn...)))
+	if p.EdgeFriendTypeNotIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.TypeNotIn(p.EdgeFriendTypeNotIn...)))
 	}
-	if l.EdgeFriendDescriptionIsNil != nil {
-		if *l.EdgeFriendDescriptionIsNil {
+	if p.EdgeFriendDescriptionIsNil != nil {
+		if *p.EdgeFriendDescriptionIsNil {
 			predicates = append(predicates, user.HasFriendsWith(user.DescriptionIsNil()))
 		} else {
 			predicates = append(predicates, user.Not(user.HasFriendsWith(user.DescriptionIsNil())))
 		}
 	}
-	if l.EdgeFriendDescriptionContains != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.DescriptionContains(*l.EdgeFriendDescriptionContains)))
+	if p.EdgeFriendDescriptionContains != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.DescriptionContains(*p.EdgeFriendDescriptionContains)))
 	}
-	if l.EdgeFriendDescriptionContainsFold != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.DescriptionContainsFold(*l.EdgeFriendDescriptionContainsFold)))
+	if p.EdgeFriendDescriptionContainsFold != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.DescriptionContainsFold(*p.EdgeFriendDescriptionContainsFold)))
 	}
-	if l.EdgeFriendEnabledEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EnabledEQ(*l.EdgeFriendEnabledEQ)))
+	if p.EdgeFriendEnabledEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EnabledEQ(*p.EdgeFriendEnabledEQ)))
 	}
-	if l.EdgeFriendEmailEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailEQ(*l.EdgeFriendEmailEQ)))
+	if p.EdgeFriendEmailEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailEQ(*p.EdgeFriendEmailEQ)))
 	}
-	if l.EdgeFriendEmailNEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailNEQ(*l.EdgeFriendEmailNEQ)))
+	if p.EdgeFriendEmailNEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailNEQ(*p.EdgeFriendEmailNEQ)))
 	}
-	if l.EdgeFriendEmailIsNil != nil {
-		if *l.EdgeFriendEmailIsNil {
+	if p.EdgeFriendEmailIsNil != nil {
+		if *p.EdgeFriendEmailIsNil {
 			predicates = append(predicates, user.HasFriendsWith(user.EmailIsNil()))
 		} else {
 			predicates = append(predicates, user.Not(user.HasFriendsWith(user.EmailIsNil())))
 		}
 	}
-	if l.EdgeFriendEmailIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailIn(l.EdgeFriendEmailIn...)))
+	if p.EdgeFriendEmailIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailIn(p.EdgeFriendEmailIn...)))
 	}
-	if l.EdgeFriendEmailNotIn != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailNotIn(l.EdgeFriendEmailNotIn...)))
+	if p.EdgeFriendEmailNotIn != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailNotIn(p.EdgeFriendEmailNotIn...)))
 	}
-	if l.EdgeFriendEmailEqualFold != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailEqualFold(*l.EdgeFriendEmailEqualFold)))
+	if p.EdgeFriendEmailEqualFold != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailEqualFold(*p.EdgeFriendEmailEqualFold)))
 	}
-	if l.EdgeFriendEmailContains != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailContains(*l.EdgeFriendEmailContains)))
+	if p.EdgeFriendEmailContains != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailContains(*p.EdgeFriendEmailContains)))
 	}
-	if l.EdgeFriendEmailContainsFold != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailContainsFold(*l.EdgeFriendEmailContainsFold)))
+	if p.EdgeFriendEmailContainsFold != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailContainsFold(*p.EdgeFriendEmailContainsFold)))
 	}
-	if l.EdgeFriendEmailHasPrefix != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailHasPrefix(*l.EdgeFriendEmailHasPrefix)))
+	if p.EdgeFriendEmailHasPrefix != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailHasPrefix(*p.EdgeFriendEmailHasPrefix)))
 	}
-	if l.EdgeFriendEmailHasSuffix != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.EmailHasSuffix(*l.EdgeFriendEmailHasSuffix)))
+	if p.EdgeFriendEmailHasSuffix != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.EmailHasSuffix(*p.EdgeFriendEmailHasSuffix)))
 	}
-	if l.EdgeFriendLastAuthenticatedAtEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.LastAuthenticatedAtEQ(*l.EdgeFriendLastAuthenticatedAtEQ)))
+	if p.EdgeFriendLastAuthenticatedAtEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.LastAuthenticatedAtEQ(*p.EdgeFriendLastAuthenticatedAtEQ)))
 	}
-	if l.EdgeFriendLastAuthenticatedAtNEQ != nil {
-		predicates = append(predicates, user.HasFriendsWith(user.LastAuthenticatedAtNEQ(*l.EdgeFriendLastAuthenticatedAtNEQ)))
+	if p.EdgeFriendLastAuthenticatedAtNEQ != nil {
+		predicates = append(predicates, user.HasFriendsWith(user.LastAuthenticatedAtNEQ(*p.EdgeFriendLastAuthenticatedAtNEQ)))
 	}
-	if l.EdgeFriendLastAuthenticatedAtIsNil != nil {
-		if *l.EdgeFriendLastAuthenticatedAtIsNil {
+	if p.EdgeFriendLastAuthenticatedAtIsNil != nil {
+		if *p.EdgeFriendLastAuthenticatedAtIsNil {
 			predicates = append(predicates, user.HasFriendsWith(user.LastAuthenticatedAtIsNil()))
 		} else {
 			predicates = append(predicates, user.Not(user.HasFriendsWith(user.LastAuthenticatedAtIsNil())))
 		}
 	}
-	if l.EdgeHasFollowing != nil {
-		if *l.EdgeHasFollowing {
+	if p.EdgeHasFollowing != nil {
+		if *p.EdgeHasFollowing {
 			predicates = append(predicates, user.HasFollowing())
 		} else {
 			predicates = append(predicates, user.Not(user.HasFollowing()))
 		}
 	}
-	if l.EdgeHasFriendship != nil {
-		if *l.EdgeHasFriendship {
+	if p.EdgeHasFriendship != nil {
+		if *p.EdgeHasFriendship {
 			predicates = append(predicates, user.HasFriendships())
 		} else {
 			predicates = append(predicates, user.Not(user.HasFriendships()))
 		}
 	}
-	if l.EdgeFriendshipIDEQ != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDEQ(*l.EdgeFriendshipIDEQ)))
+	if p.EdgeFriendshipIDEQ != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDEQ(*p.EdgeFriendshipIDEQ)))
 	}
-	if l.EdgeFriendshipIDNEQ != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDNEQ(*l.EdgeFriendshipIDNEQ)))
+	if p.EdgeFriendshipIDNEQ != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDNEQ(*p.EdgeFriendshipIDNEQ)))
 	}
-	if l.EdgeFriendshipIDIn != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDIn(l.EdgeFriendshipIDIn...)))
+	if p.EdgeFriendshipIDIn != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDIn(p.EdgeFriendshipIDIn...)))
 	}
-	if l.EdgeFriendshipIDNotIn != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDNotIn(l.EdgeFriendshipIDNotIn...)))
+	if p.EdgeFriendshipIDNotIn != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.IDNotIn(p.EdgeFriendshipIDNotIn...)))
 	}
-	if l.EdgeFriendshipUserIDEQ != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDEQ(*l.EdgeFriendshipUserIDEQ)))
+	if p.EdgeFriendshipUserIDEQ != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDEQ(*p.EdgeFriendshipUserIDEQ)))
 	}
-	if l.EdgeFriendshipUserIDNEQ != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDNEQ(*l.EdgeFriendshipUserIDNEQ)))
+	if p.EdgeFriendshipUserIDNEQ != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDNEQ(*p.EdgeFriendshipUserIDNEQ)))
 	}
-	if l.EdgeFriendshipUserIDIn != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDIn(l.EdgeFriendshipUserIDIn...)))
+	if p.EdgeFriendshipUserIDIn != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDIn(p.EdgeFriendshipUserIDIn...)))
 	}
-	if l.EdgeFriendshipUserIDNotIn != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDNotIn(l.EdgeFriendshipUserIDNotIn...)))
+	if p.EdgeFriendshipUserIDNotIn != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.UserIDNotIn(p.EdgeFriendshipUserIDNotIn...)))
 	}
-	if l.EdgeFriendshipFriendIDEQ != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDEQ(*l.EdgeFriendshipFriendIDEQ)))
+	if p.EdgeFriendshipFriendIDEQ != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDEQ(*p.EdgeFriendshipFriendIDEQ)))
 	}
-	if l.EdgeFriendshipFriendIDNEQ != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDNEQ(*l.EdgeFriendshipFriendIDNEQ)))
+	if p.EdgeFriendshipFriendIDNEQ != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDNEQ(*p.EdgeFriendshipFriendIDNEQ)))
 	}
-	if l.EdgeFriendshipFriendIDIn != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDIn(l.EdgeFriendshipFriendIDIn...)))
+	if p.EdgeFriendshipFriendIDIn != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDIn(p.EdgeFriendshipFriendIDIn...)))
 	}
-	if l.EdgeFriendshipFriendIDNotIn != nil {
-		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDNotIn(l.EdgeFriendshipFriendIDNotIn...)))
+	if p.EdgeFriendshipFriendIDNotIn != nil {
+		predicates = append(predicates, user.HasFriendshipsWith(friendship.FriendIDNotIn(p.EdgeFriendshipFriendIDNotIn...)))
 	}
 
-	if l.UserFilterGroupSearchEQ != nil {
+	if p.UserFilterGroupSearchEQ != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameEQ(*l.UserFilterGroupSearchEQ),
-			user.DescriptionEQ(*l.UserFilterGroupSearchEQ),
-			user.EmailEQ(*l.UserFilterGroupSearchEQ),
+			user.NameEQ(*p.UserFilterGroupSearchEQ),
+			user.DescriptionEQ(*p.UserFilterGroupSearchEQ),
+			user.EmailEQ(*p.UserFilterGroupSearchEQ),
 		))
 	}
-	if l.UserFilterGroupSearchNEQ != nil {
+	if p.UserFilterGroupSearchNEQ != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameNEQ(*l.UserFilterGroupSearchNEQ),
-			user.DescriptionNEQ(*l.UserFilterGroupSearchNEQ),
-			user.EmailNEQ(*l.UserFilterGroupSearchNEQ),
+			user.NameNEQ(*p.UserFilterGroupSearchNEQ),
+			user.DescriptionNEQ(*p.UserFilterGroupSearchNEQ),
+			user.EmailNEQ(*p.UserFilterGroupSearchNEQ),
 		))
 	}
-	if l.UserFilterGroupSearchIn != nil {
+	if p.UserFilterGroupSearchIn != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameIn(l.UserFilterGroupSearchIn...),
-			user.DescriptionIn(l.UserFilterGroupSearchIn...),
-			user.EmailIn(l.UserFilterGroupSearchIn...),
+			user.NameIn(p.UserFilterGroupSearchIn...),
+			user.DescriptionIn(p.UserFilterGroupSearchIn...),
+			user.EmailIn(p.UserFilterGroupSearchIn...),
 		))
 	}
-	if l.UserFilterGroupSearchNotIn != nil {
+	if p.UserFilterGroupSearchNotIn != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameNotIn(l.UserFilterGroupSearchNotIn...),
-			user.DescriptionNotIn(l.UserFilterGroupSearchNotIn...),
-			user.EmailNotIn(l.UserFilterGroupSearchNotIn...),
+			user.NameNotIn(p.UserFilterGroupSearchNotIn...),
+			user.DescriptionNotIn(p.UserFilterGroupSearchNotIn...),
+			user.EmailNotIn(p.UserFilterGroupSearchNotIn...),
 		))
 	}
-	if l.UserFilterGroupSearchEqualFold != nil {
+	if p.UserFilterGroupSearchEqualFold != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameEqualFold(*l.UserFilterGroupSearchEqualFold),
-			user.DescriptionEqualFold(*l.UserFilterGroupSearchEqualFold),
-			user.EmailEqualFold(*l.UserFilterGroupSearchEqualFold),
+			user.NameEqualFold(*p.UserFilterGroupSearchEqualFold),
+			user.DescriptionEqualFold(*p.UserFilterGroupSearchEqualFold),
+			user.EmailEqualFold(*p.UserFilterGroupSearchEqualFold),
 		))
 	}
-	if l.UserFilterGroupSearchContains != nil {
+	if p.UserFilterGroupSearchContains != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameContains(*l.UserFilterGroupSearchContains),
-			user.DescriptionContains(*l.UserFilterGroupSearchContains),
-			user.EmailContains(*l.UserFilterGroupSearchContains),
+			user.NameContains(*p.UserFilterGroupSearchContains),
+			user.DescriptionContains(*p.UserFilterGroupSearchContains),
+			user.EmailContains(*p.UserFilterGroupSearchContains),
 		))
 	}
-	if l.UserFilterGroupSearchContainsFold != nil {
+	if p.UserFilterGroupSearchContainsFold != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameContainsFold(*l.UserFilterGroupSearchContainsFold),
-			user.DescriptionContainsFold(*l.UserFilterGroupSearchContainsFold),
-			user.EmailContainsFold(*l.UserFilterGroupSearchContainsFold),
+			user.NameContainsFold(*p.UserFilterGroupSearchContainsFold),
+			user.DescriptionContainsFold(*p.UserFilterGroupSearchContainsFold),
+			user.EmailContainsFold(*p.UserFilterGroupSearchContainsFold),
 		))
 	}
-	if l.UserFilterGroupSearchHasPrefix != nil {
+	if p.UserFilterGroupSearchHasPrefix != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameHasPrefix(*l.UserFilterGroupSearchHasPrefix),
-			user.DescriptionHasPrefix(*l.UserFilterGroupSearchHasPrefix),
-			user.EmailHasPrefix(*l.UserFilterGroupSearchHasPrefix),
+			user.NameHasPrefix(*p.UserFilterGroupSearchHasPrefix),
+			user.DescriptionHasPrefix(*p.UserFilterGroupSearchHasPrefix),
+			user.EmailHasPrefix(*p.UserFilterGroupSearchHasPrefix),
 		))
 	}
-	if l.UserFilterGroupSearchHasSuffix != nil {
+	if p.UserFilterGroupSearchHasSuffix != nil {
 		predicates = append(predicates, sql.OrPredicates(
-			user.NameHasSuffix(*l.UserFilterGroupSearchHasSuffix),
-			user.DescriptionHasSuffix(*l.UserFilterGroupSearchHasSuffix),
-			user.EmailHasSuffix(*l.UserFilterGroupSearchHasSuffix),
+			user.NameHasSuffix(*p.UserFilterGroupSearchHasSuffix),
+			user.DescriptionHasSuffix(*p.UserFilterGroupSearchHasSuffix),
+			user.EmailHasSuffix(*p.UserFilterGroupSearchHasSuffix),
 		))
 	}
-	return l.ApplyFilterOperation(predicates...)
+	pred, err := p.ApplyFilterOperation(predicates...)
+	if err != nil {
+		return nil, err
+	}
+	return p.ApplyFilterExpression(pred, filterPredicateUser)
+}
+
+// filterPredicateUser returns the predicate for a single filter within a filter
+// expression, where key is the name of the associated filter parameter.
+func filterPredicateUser(key string, value json.RawMessage) (predicate.User, error) {
+	switch key {
+	case "id.eq":
+		var v struct{ UserIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.UserIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.IDEQ(*v.UserIDEQ), nil
+	case "id.neq":
+		var v struct{ UserIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.UserIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.IDNEQ(*v.UserIDNEQ), nil
+	case "id.in":
+		var v struct{ UserIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.UserIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.IDIn(v.UserIDIn...), nil
+	case "id.notIn":
+		var v struct{ UserIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.UserIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.IDNotIn(v.UserIDNotIn...), nil
+	case "createdAt.gt":
+		var v struct{ UserCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.UserCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.CreatedAtGT(*v.UserCreatedAtGT), nil
+	case "createdAt.lt":
+		var v struct{ UserCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.UserCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.CreatedAtLT(*v.UserCreatedAtLT), nil
+	case "updatedAt.gt":
+		var v struct{ UserUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.UserUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.UpdatedAtGT(*v.UserUpdatedAtGT), nil
+	case "updatedAt.lt":
+		var v struct{ UserUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.UserUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.UpdatedAtLT(*v.UserUpdatedAtLT), nil
+	case "name.eq":
+		var v struct{ UserNameEQ *string }
+		if err := json.Unmarshal(value, &v.UserNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameEQ(*v.UserNameEQ), nil
+	case "name.neq":
+		var v struct{ UserNameNEQ *string }
+		if err := json.Unmarshal(value, &v.UserNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameNEQ(*v.UserNameNEQ), nil
+	case "name.in":
+		var v struct{ UserNameIn []string }
+		if err := json.Unmarshal(value, &v.UserNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameIn(v.UserNameIn...), nil
+	case "name.notIn":
+		var v struct{ UserNameNotIn []string }
+		if err := json.Unmarshal(value, &v.UserNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameNotIn(v.UserNameNotIn...), nil
+	case "name.ieq":
+		var v struct{ UserNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.UserNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameEqualFold(*v.UserNameEqualFold), nil
+	case "name.has":
+		var v struct{ UserNameContains *string }
+		if err := json.Unmarshal(value, &v.UserNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameContains(*v.UserNameContains), nil
+	case "name.ihas":
+		var v struct{ UserNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.UserNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameContainsFold(*v.UserNameContainsFold), nil
+	case "name.prefix":
+		var v struct{ UserNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.UserNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameHasPrefix(*v.UserNameHasPrefix), nil
+	case "name.suffix":
+		var v struct{ UserNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.UserNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.NameHasSuffix(*v.UserNameHasSuffix), nil
+	case "type.eq":
+		var v struct{ UserTypeEQ *user.Type }
+		if err := json.Unmarshal(value, &v.UserTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.TypeEQ(*v.UserTypeEQ), nil
+	case "type.neq":
+		var v struct{ UserTypeNEQ *user.Type }
+		if err := json.Unmarshal(value, &v.UserTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.TypeNEQ(*v.UserTypeNEQ), nil
+	case "type.in":
+		var v struct{ UserTypeIn []user.Type }
+		if err := json.Unmarshal(value, &v.UserTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.TypeIn(v.UserTypeIn...), nil
+	case "type.notIn":
+		var v struct{ UserTypeNotIn []user.Type }
+		if err := json.Unmarshal(value, &v.UserTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.TypeNotIn(v.UserTypeNotIn...), nil
+	case "description.null":
+		var v struct{ UserDescriptionIsNil *bool }
+		if err := json.Unmarshal(value, &v.UserDescriptionIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.UserDescriptionIsNil {
+			return user.Not(user.DescriptionIsNil()), nil
+		}
+		return user.DescriptionIsNil(), nil
+	case "description.has":
+		var v struct{ UserDescriptionContains *string }
+		if err := json.Unmarshal(value, &v.UserDescriptionContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.DescriptionContains(*v.UserDescriptionContains), nil
+	case "description.ihas":
+		var v struct{ UserDescriptionContainsFold *string }
+		if err := json.Unmarshal(value, &v.UserDescriptionContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.DescriptionContainsFold(*v.UserDescriptionContainsFold), nil
+	case "enabled.eq":
+		var v struct{ UserEnabledEQ *bool }
+		if err := json.Unmarshal(value, &v.UserEnabledEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EnabledEQ(*v.UserEnabledEQ), nil
+	case "email.eq":
+		var v struct{ UserEmailEQ *string }
+		if err := json.Unmarshal(value, &v.UserEmailEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailEQ(*v.UserEmailEQ), nil
+	case "email.neq":
+		var v struct{ UserEmailNEQ *string }
+		if err := json.Unmarshal(value, &v.UserEmailNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailNEQ(*v.UserEmailNEQ), nil
+	case "email.null":
+		var v struct{ UserEmailIsNil *bool }
+		if err := json.Unmarshal(value, &v.UserEmailIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.UserEmailIsNil {
+			return user.Not(user.EmailIsNil()), nil
+		}
+		return user.EmailIsNil(), nil
+	case "email.in":
+		var v struct{ UserEmailIn []string }
+		if err := json.Unmarshal(value, &v.UserEmailIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailIn(v.UserEmailIn...), nil
+	case "email.notIn":
+		var v struct{ UserEmailNotIn []string }
+		if err := json.Unmarshal(value, &v.UserEmailNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailNotIn(v.UserEmailNotIn...), nil
+	case "email.ieq":
+		var v struct{ UserEmailEqualFold *string }
+		if err := json.Unmarshal(value, &v.UserEmailEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailEqualFold(*v.UserEmailEqualFold), nil
+	case "email.has":
+		var v struct{ UserEmailContains *string }
+		if err := json.Unmarshal(value, &v.UserEmailContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailContains(*v.UserEmailContains), nil
+	case "email.ihas":
+		var v struct{ UserEmailContainsFold *string }
+		if err := json.Unmarshal(value, &v.UserEmailContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailContainsFold(*v.UserEmailContainsFold), nil
+	case "email.prefix":
+		var v struct{ UserEmailHasPrefix *string }
+		if err := json.Unmarshal(value, &v.UserEmailHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailHasPrefix(*v.UserEmailHasPrefix), nil
+	case "email.suffix":
+		var v struct{ UserEmailHasSuffix *string }
+		if err := json.Unmarshal(value, &v.UserEmailHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.EmailHasSuffix(*v.UserEmailHasSuffix), nil
+	case "lastAuthenticatedAt.eq":
+		var v struct{ UserLastAuthenticatedAtEQ *time.Time }
+		if err := json.Unmarshal(value, &v.UserLastAuthenticatedAtEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.LastAuthenticatedAtEQ(*v.UserLastAuthenticatedAtEQ), nil
+	case "lastAuthenticatedAt.neq":
+		var v struct{ UserLastAuthenticatedAtNEQ *time.Time }
+		if err := json.Unmarshal(value, &v.UserLastAuthenticatedAtNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.LastAuthenticatedAtNEQ(*v.UserLastAuthenticatedAtNEQ), nil
+	case "lastAuthenticatedAt.null":
+		var v struct{ UserLastAuthenticatedAtIsNil *bool }
+		if err := json.Unmarshal(value, &v.UserLastAuthenticatedAtIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.UserLastAuthenticatedAtIsNil {
+			return user.Not(user.LastAuthenticatedAtIsNil()), nil
+		}
+		return user.LastAuthenticatedAtIsNil(), nil
+	case "has.pet":
+		var v struct{ EdgeHasPet *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasPet); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasPet {
+			return user.Not(user.HasPets()), nil
+		}
+		return user.HasPets(), nil
+	case "pet.id.eq":
+		var v struct{ EdgePetIDEQ *int }
+		if err := json.Unmarshal(value, &v.EdgePetIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.IDEQ(*v.EdgePetIDEQ)), nil
+	case "pet.id.neq":
+		var v struct{ EdgePetIDNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgePetIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.IDNEQ(*v.EdgePetIDNEQ)), nil
+	case "pet.id.in":
+		var v struct{ EdgePetIDIn []int }
+		if err := json.Unmarshal(value, &v.EdgePetIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.IDIn(v.EdgePetIDIn...)), nil
+	case "pet.id.notIn":
+		var v struct{ EdgePetIDNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgePetIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.IDNotIn(v.EdgePetIDNotIn...)), nil
+	case "pet.name.eq":
+		var v struct{ EdgePetNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgePetNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameEQ(*v.EdgePetNameEQ)), nil
+	case "pet.name.neq":
+		var v struct{ EdgePetNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgePetNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameNEQ(*v.EdgePetNameNEQ)), nil
+	case "pet.name.in":
+		var v struct{ EdgePetNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgePetNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameIn(v.EdgePetNameIn...)), nil
+	case "pet.name.notIn":
+		var v struct{ EdgePetNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgePetNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameNotIn(v.EdgePetNameNotIn...)), nil
+	case "pet.name.ieq":
+		var v struct{ EdgePetNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgePetNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameEqualFold(*v.EdgePetNameEqualFold)), nil
+	case "pet.name.has":
+		var v struct{ EdgePetNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgePetNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameContains(*v.EdgePetNameContains)), nil
+	case "pet.name.ihas":
+		var v struct{ EdgePetNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgePetNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameContainsFold(*v.EdgePetNameContainsFold)), nil
+	case "pet.name.prefix":
+		var v struct{ EdgePetNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgePetNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameHasPrefix(*v.EdgePetNameHasPrefix)), nil
+	case "pet.name.suffix":
+		var v struct{ EdgePetNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgePetNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.NameHasSuffix(*v.EdgePetNameHasSuffix)), nil
+	case "pet.nicknames.null":
+		var v struct{ EdgePetNicknamesIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgePetNicknamesIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgePetNicknamesIsNil {
+			return user.Not(user.HasPetsWith(pet.NicknamesIsNil())), nil
+		}
+		return user.HasPetsWith(pet.NicknamesIsNil()), nil
+	case "pet.age.eq":
+		var v struct{ EdgePetAgeEQ *int }
+		if err := json.Unmarshal(value, &v.EdgePetAgeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.AgeEQ(*v.EdgePetAgeEQ)), nil
+	case "pet.age.neq":
+		var v struct{ EdgePetAgeNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgePetAgeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.AgeNEQ(*v.EdgePetAgeNEQ)), nil
+	case "pet.age.gt":
+		var v struct{ EdgePetAgeGT *int }
+		if err := json.Unmarshal(value, &v.EdgePetAgeGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.AgeGT(*v.EdgePetAgeGT)), nil
+	case "pet.age.lt":
+		var v struct{ EdgePetAgeLT *int }
+		if err := json.Unmarshal(value, &v.EdgePetAgeLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.AgeLT(*v.EdgePetAgeLT)), nil
+	case "pet.age.in":
+		var v struct{ EdgePetAgeIn []int }
+		if err := json.Unmarshal(value, &v.EdgePetAgeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.AgeIn(v.EdgePetAgeIn...)), nil
+	case "pet.age.notIn":
+		var v struct{ EdgePetAgeNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgePetAgeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.AgeNotIn(v.EdgePetAgeNotIn...)), nil
+	case "pet.type.eq":
+		var v struct{ EdgePetTypeEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.EdgePetTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.TypeEQ(*v.EdgePetTypeEQ)), nil
+	case "pet.type.neq":
+		var v struct{ EdgePetTypeNEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.EdgePetTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.TypeNEQ(*v.EdgePetTypeNEQ)), nil
+	case "pet.type.in":
+		var v struct{ EdgePetTypeIn []pet.Type }
+		if err := json.Unmarshal(value, &v.EdgePetTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.TypeIn(v.EdgePetTypeIn...)), nil
+	case "pet.type.notIn":
+		var v struct{ EdgePetTypeNotIn []pet.Type }
+		if err := json.Unmarshal(value, &v.EdgePetTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasPetsWith(pet.TypeNotIn(v.EdgePetTypeNotIn...)), nil
+	case "has.followedPet":
+		var v struct{ EdgeHasFollowedPet *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFollowedPet); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFollowedPet {
+			return user.Not(user.HasFollowedPets()), nil
+		}
+		return user.HasFollowedPets(), nil
+	case "followedPet.id.eq":
+		var v struct{ EdgeFollowedPetIDEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.IDEQ(*v.EdgeFollowedPetIDEQ)), nil
+	case "followedPet.id.neq":
+		var v struct{ EdgeFollowedPetIDNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.IDNEQ(*v.EdgeFollowedPetIDNEQ)), nil
+	case "followedPet.id.in":
+		var v struct{ EdgeFollowedPetIDIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.IDIn(v.EdgeFollowedPetIDIn...)), nil
+	case "followedPet.id.notIn":
+		var v struct{ EdgeFollowedPetIDNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.IDNotIn(v.EdgeFollowedPetIDNotIn...)), nil
+	case "followedPet.name.eq":
+		var v struct{ EdgeFollowedPetNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameEQ(*v.EdgeFollowedPetNameEQ)), nil
+	case "followedPet.name.neq":
+		var v struct{ EdgeFollowedPetNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameNEQ(*v.EdgeFollowedPetNameNEQ)), nil
+	case "followedPet.name.in":
+		var v struct{ EdgeFollowedPetNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameIn(v.EdgeFollowedPetNameIn...)), nil
+	case "followedPet.name.notIn":
+		var v struct{ EdgeFollowedPetNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameNotIn(v.EdgeFollowedPetNameNotIn...)), nil
+	case "followedPet.name.ieq":
+		var v struct{ EdgeFollowedPetNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameEqualFold(*v.EdgeFollowedPetNameEqualFold)), nil
+	case "followedPet.name.has":
+		var v struct{ EdgeFollowedPetNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameContains(*v.EdgeFollowedPetNameContains)), nil
+	case "followedPet.name.ihas":
+		var v struct{ EdgeFollowedPetNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameContainsFold(*v.EdgeFollowedPetNameContainsFold)), nil
+	case "followedPet.name.prefix":
+		var v struct{ EdgeFollowedPetNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameHasPrefix(*v.EdgeFollowedPetNameHasPrefix)), nil
+	case "followedPet.name.suffix":
+		var v struct{ EdgeFollowedPetNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.NameHasSuffix(*v.EdgeFollowedPetNameHasSuffix)), nil
+	case "followedPet.nicknames.null":
+		var v struct{ EdgeFollowedPetNicknamesIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetNicknamesIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFollowedPetNicknamesIsNil {
+			return user.Not(user.HasFollowedPetsWith(pet.NicknamesIsNil())), nil
+		}
+		return user.HasFollowedPetsWith(pet.NicknamesIsNil()), nil
+	case "followedPet.age.eq":
+		var v struct{ EdgeFollowedPetAgeEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetAgeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.AgeEQ(*v.EdgeFollowedPetAgeEQ)), nil
+	case "followedPet.age.neq":
+		var v struct{ EdgeFollowedPetAgeNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetAgeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.AgeNEQ(*v.EdgeFollowedPetAgeNEQ)), nil
+	case "followedPet.age.gt":
+		var v struct{ EdgeFollowedPetAgeGT *int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetAgeGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.AgeGT(*v.EdgeFollowedPetAgeGT)), nil
+	case "followedPet.age.lt":
+		var v struct{ EdgeFollowedPetAgeLT *int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetAgeLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.AgeLT(*v.EdgeFollowedPetAgeLT)), nil
+	case "followedPet.age.in":
+		var v struct{ EdgeFollowedPetAgeIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetAgeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.AgeIn(v.EdgeFollowedPetAgeIn...)), nil
+	case "followedPet.age.notIn":
+		var v struct{ EdgeFollowedPetAgeNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetAgeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.AgeNotIn(v.EdgeFollowedPetAgeNotIn...)), nil
+	case "followedPet.type.eq":
+		var v struct{ EdgeFollowedPetTypeEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.TypeEQ(*v.EdgeFollowedPetTypeEQ)), nil
+	case "followedPet.type.neq":
+		var v struct{ EdgeFollowedPetTypeNEQ *pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.TypeNEQ(*v.EdgeFollowedPetTypeNEQ)), nil
+	case "followedPet.type.in":
+		var v struct{ EdgeFollowedPetTypeIn []pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.TypeIn(v.EdgeFollowedPetTypeIn...)), nil
+	case "followedPet.type.notIn":
+		var v struct{ EdgeFollowedPetTypeNotIn []pet.Type }
+		if err := json.Unmarshal(value, &v.EdgeFollowedPetTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFollowedPetsWith(pet.TypeNotIn(v.EdgeFollowedPetTypeNotIn...)), nil
+	case "has.friend":
+		var v struct{ EdgeHasFriend *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFriend); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFriend {
+			return user.Not(user.HasFriends()), nil
+		}
+		return user.HasFriends(), nil
+	case "friend.id.eq":
+		var v struct{ EdgeFriendIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.IDEQ(*v.EdgeFriendIDEQ)), nil
+	case "friend.id.neq":
+		var v struct{ EdgeFriendIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.IDNEQ(*v.EdgeFriendIDNEQ)), nil
+	case "friend.id.in":
+		var v struct{ EdgeFriendIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.IDIn(v.EdgeFriendIDIn...)), nil
+	case "friend.id.notIn":
+		var v struct{ EdgeFriendIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.IDNotIn(v.EdgeFriendIDNotIn...)), nil
+	case "friend.createdAt.gt":
+		var v struct{ EdgeFriendCreatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendCreatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.CreatedAtGT(*v.EdgeFriendCreatedAtGT)), nil
+	case "friend.createdAt.lt":
+		var v struct{ EdgeFriendCreatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendCreatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.CreatedAtLT(*v.EdgeFriendCreatedAtLT)), nil
+	case "friend.updatedAt.gt":
+		var v struct{ EdgeFriendUpdatedAtGT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendUpdatedAtGT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.UpdatedAtGT(*v.EdgeFriendUpdatedAtGT)), nil
+	case "friend.updatedAt.lt":
+		var v struct{ EdgeFriendUpdatedAtLT *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendUpdatedAtLT); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.UpdatedAtLT(*v.EdgeFriendUpdatedAtLT)), nil
+	case "friend.name.eq":
+		var v struct{ EdgeFriendNameEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameEQ(*v.EdgeFriendNameEQ)), nil
+	case "friend.name.neq":
+		var v struct{ EdgeFriendNameNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameNEQ(*v.EdgeFriendNameNEQ)), nil
+	case "friend.name.in":
+		var v struct{ EdgeFriendNameIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameIn(v.EdgeFriendNameIn...)), nil
+	case "friend.name.notIn":
+		var v struct{ EdgeFriendNameNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameNotIn(v.EdgeFriendNameNotIn...)), nil
+	case "friend.name.ieq":
+		var v struct{ EdgeFriendNameEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameEqualFold(*v.EdgeFriendNameEqualFold)), nil
+	case "friend.name.has":
+		var v struct{ EdgeFriendNameContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameContains(*v.EdgeFriendNameContains)), nil
+	case "friend.name.ihas":
+		var v struct{ EdgeFriendNameContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameContainsFold(*v.EdgeFriendNameContainsFold)), nil
+	case "friend.name.prefix":
+		var v struct{ EdgeFriendNameHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameHasPrefix(*v.EdgeFriendNameHasPrefix)), nil
+	case "friend.name.suffix":
+		var v struct{ EdgeFriendNameHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendNameHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.NameHasSuffix(*v.EdgeFriendNameHasSuffix)), nil
+	case "friend.type.eq":
+		var v struct{ EdgeFriendTypeEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.TypeEQ(*v.EdgeFriendTypeEQ)), nil
+	case "friend.type.neq":
+		var v struct{ EdgeFriendTypeNEQ *user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.TypeNEQ(*v.EdgeFriendTypeNEQ)), nil
+	case "friend.type.in":
+		var v struct{ EdgeFriendTypeIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.TypeIn(v.EdgeFriendTypeIn...)), nil
+	case "friend.type.notIn":
+		var v struct{ EdgeFriendTypeNotIn []user.Type }
+		if err := json.Unmarshal(value, &v.EdgeFriendTypeNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.TypeNotIn(v.EdgeFriendTypeNotIn...)), nil
+	case "friend.description.null":
+		var v struct{ EdgeFriendDescriptionIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendDescriptionIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFriendDescriptionIsNil {
+			return user.Not(user.HasFriendsWith(user.DescriptionIsNil())), nil
+		}
+		return user.HasFriendsWith(user.DescriptionIsNil()), nil
+	case "friend.description.has":
+		var v struct{ EdgeFriendDescriptionContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendDescriptionContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.DescriptionContains(*v.EdgeFriendDescriptionContains)), nil
+	case "friend.description.ihas":
+		var v struct{ EdgeFriendDescriptionContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendDescriptionContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.DescriptionContainsFold(*v.EdgeFriendDescriptionContainsFold)), nil
+	case "friend.enabled.eq":
+		var v struct{ EdgeFriendEnabledEQ *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendEnabledEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EnabledEQ(*v.EdgeFriendEnabledEQ)), nil
+	case "friend.email.eq":
+		var v struct{ EdgeFriendEmailEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailEQ(*v.EdgeFriendEmailEQ)), nil
+	case "friend.email.neq":
+		var v struct{ EdgeFriendEmailNEQ *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailNEQ(*v.EdgeFriendEmailNEQ)), nil
+	case "friend.email.null":
+		var v struct{ EdgeFriendEmailIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFriendEmailIsNil {
+			return user.Not(user.HasFriendsWith(user.EmailIsNil())), nil
+		}
+		return user.HasFriendsWith(user.EmailIsNil()), nil
+	case "friend.email.in":
+		var v struct{ EdgeFriendEmailIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailIn(v.EdgeFriendEmailIn...)), nil
+	case "friend.email.notIn":
+		var v struct{ EdgeFriendEmailNotIn []string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailNotIn(v.EdgeFriendEmailNotIn...)), nil
+	case "friend.email.ieq":
+		var v struct{ EdgeFriendEmailEqualFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailEqualFold(*v.EdgeFriendEmailEqualFold)), nil
+	case "friend.email.has":
+		var v struct{ EdgeFriendEmailContains *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailContains(*v.EdgeFriendEmailContains)), nil
+	case "friend.email.ihas":
+		var v struct{ EdgeFriendEmailContainsFold *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailContainsFold(*v.EdgeFriendEmailContainsFold)), nil
+	case "friend.email.prefix":
+		var v struct{ EdgeFriendEmailHasPrefix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailHasPrefix(*v.EdgeFriendEmailHasPrefix)), nil
+	case "friend.email.suffix":
+		var v struct{ EdgeFriendEmailHasSuffix *string }
+		if err := json.Unmarshal(value, &v.EdgeFriendEmailHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.EmailHasSuffix(*v.EdgeFriendEmailHasSuffix)), nil
+	case "friend.lastAuthenticatedAt.eq":
+		var v struct{ EdgeFriendLastAuthenticatedAtEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendLastAuthenticatedAtEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.LastAuthenticatedAtEQ(*v.EdgeFriendLastAuthenticatedAtEQ)), nil
+	case "friend.lastAuthenticatedAt.neq":
+		var v struct{ EdgeFriendLastAuthenticatedAtNEQ *time.Time }
+		if err := json.Unmarshal(value, &v.EdgeFriendLastAuthenticatedAtNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendsWith(user.LastAuthenticatedAtNEQ(*v.EdgeFriendLastAuthenticatedAtNEQ)), nil
+	case "friend.lastAuthenticatedAt.null":
+		var v struct{ EdgeFriendLastAuthenticatedAtIsNil *bool }
+		if err := json.Unmarshal(value, &v.EdgeFriendLastAuthenticatedAtIsNil); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeFriendLastAuthenticatedAtIsNil {
+			return user.Not(user.HasFriendsWith(user.LastAuthenticatedAtIsNil())), nil
+		}
+		return user.HasFriendsWith(user.LastAuthenticatedAtIsNil()), nil
+	case "has.following":
+		var v struct{ EdgeHasFollowing *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFollowing); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFollowing {
+			return user.Not(user.HasFollowing()), nil
+		}
+		return user.HasFollowing(), nil
+	case "has.friendship":
+		var v struct{ EdgeHasFriendship *bool }
+		if err := json.Unmarshal(value, &v.EdgeHasFriendship); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		if !*v.EdgeHasFriendship {
+			return user.Not(user.HasFriendships()), nil
+		}
+		return user.HasFriendships(), nil
+	case "friendship.id.eq":
+		var v struct{ EdgeFriendshipIDEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.IDEQ(*v.EdgeFriendshipIDEQ)), nil
+	case "friendship.id.neq":
+		var v struct{ EdgeFriendshipIDNEQ *int }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.IDNEQ(*v.EdgeFriendshipIDNEQ)), nil
+	case "friendship.id.in":
+		var v struct{ EdgeFriendshipIDIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.IDIn(v.EdgeFriendshipIDIn...)), nil
+	case "friendship.id.notIn":
+		var v struct{ EdgeFriendshipIDNotIn []int }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.IDNotIn(v.EdgeFriendshipIDNotIn...)), nil
+	case "friendship.userID.eq":
+		var v struct{ EdgeFriendshipUserIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipUserIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.UserIDEQ(*v.EdgeFriendshipUserIDEQ)), nil
+	case "friendship.userID.neq":
+		var v struct{ EdgeFriendshipUserIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipUserIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.UserIDNEQ(*v.EdgeFriendshipUserIDNEQ)), nil
+	case "friendship.userID.in":
+		var v struct{ EdgeFriendshipUserIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipUserIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.UserIDIn(v.EdgeFriendshipUserIDIn...)), nil
+	case "friendship.userID.notIn":
+		var v struct{ EdgeFriendshipUserIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipUserIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.UserIDNotIn(v.EdgeFriendshipUserIDNotIn...)), nil
+	case "friendship.friendID.eq":
+		var v struct{ EdgeFriendshipFriendIDEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipFriendIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.FriendIDEQ(*v.EdgeFriendshipFriendIDEQ)), nil
+	case "friendship.friendID.neq":
+		var v struct{ EdgeFriendshipFriendIDNEQ *uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipFriendIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.FriendIDNEQ(*v.EdgeFriendshipFriendIDNEQ)), nil
+	case "friendship.friendID.in":
+		var v struct{ EdgeFriendshipFriendIDIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipFriendIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.FriendIDIn(v.EdgeFriendshipFriendIDIn...)), nil
+	case "friendship.friendID.notIn":
+		var v struct{ EdgeFriendshipFriendIDNotIn []uuid.UUID }
+		if err := json.Unmarshal(value, &v.EdgeFriendshipFriendIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return user.HasFriendshipsWith(friendship.FriendIDNotIn(v.EdgeFriendshipFriendIDNotIn...)), nil
+	case "search.eq":
+		var v struct{ UserFilterGroupSearchEQ *string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameEQ(*v.UserFilterGroupSearchEQ),
+			user.DescriptionEQ(*v.UserFilterGroupSearchEQ),
+			user.EmailEQ(*v.UserFilterGroupSearchEQ),
+		), nil
+	case "search.neq":
+		var v struct{ UserFilterGroupSearchNEQ *string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameNEQ(*v.UserFilterGroupSearchNEQ),
+			user.DescriptionNEQ(*v.UserFilterGroupSearchNEQ),
+			user.EmailNEQ(*v.UserFilterGroupSearchNEQ),
+		), nil
+	case "search.in":
+		var v struct{ UserFilterGroupSearchIn []string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameIn(v.UserFilterGroupSearchIn...),
+			user.DescriptionIn(v.UserFilterGroupSearchIn...),
+			user.EmailIn(v.UserFilterGroupSearchIn...),
+		), nil
+	case "search.notIn":
+		var v struct{ UserFilterGroupSearchNotIn []string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameNotIn(v.UserFilterGroupSearchNotIn...),
+			user.DescriptionNotIn(v.UserFilterGroupSearchNotIn...),
+			user.EmailNotIn(v.UserFilterGroupSearchNotIn...),
+		), nil
+	case "search.ieq":
+		var v struct{ UserFilterGroupSearchEqualFold *string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchEqualFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameEqualFold(*v.UserFilterGroupSearchEqualFold),
+			user.DescriptionEqualFold(*v.UserFilterGroupSearchEqualFold),
+			user.EmailEqualFold(*v.UserFilterGroupSearchEqualFold),
+		), nil
+	case "search.has":
+		var v struct{ UserFilterGroupSearchContains *string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchContains); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameContains(*v.UserFilterGroupSearchContains),
+			user.DescriptionContains(*v.UserFilterGroupSearchContains),
+			user.EmailContains(*v.UserFilterGroupSearchContains),
+		), nil
+	case "search.ihas":
+		var v struct{ UserFilterGroupSearchContainsFold *string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchContainsFold); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameContainsFold(*v.UserFilterGroupSearchContainsFold),
+			user.DescriptionContainsFold(*v.UserFilterGroupSearchContainsFold),
+			user.EmailContainsFold(*v.UserFilterGroupSearchContainsFold),
+		), nil
+	case "search.prefix":
+		var v struct{ UserFilterGroupSearchHasPrefix *string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchHasPrefix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameHasPrefix(*v.UserFilterGroupSearchHasPrefix),
+			user.DescriptionHasPrefix(*v.UserFilterGroupSearchHasPrefix),
+			user.EmailHasPrefix(*v.UserFilterGroupSearchHasPrefix),
+		), nil
+	case "search.suffix":
+		var v struct{ UserFilterGroupSearchHasSuffix *string }
+		if err := json.Unmarshal(value, &v.UserFilterGroupSearchHasSuffix); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return sql.OrPredicates(
+			user.NameHasSuffix(*v.UserFilterGroupSearchHasSuffix),
+			user.DescriptionHasSuffix(*v.UserFilterGroupSearchHasSuffix),
+			user.EmailHasSuffix(*v.UserFilterGroupSearchHasSuffix),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q", key)
+	}
 }
 
 // ApplySorting applies sorting to the query based on the provided sort and order fields.
@@ -2855,10 +6398,16 @@ func (l *ListUserParams) ApplySorting(query *ent.UserQuery) error {
 	if err := l.Sorted.Validate(UserSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if len(l.terms) == 0 { // No custom sort fields provided and no defaults, so don't do anything.
 		return nil
 	}
-	applySortingUser(query, *l.Field, *l.Order)
+	for _, term := range l.terms {
+		applySortingUser(query, term.field, term.order, term.nulls)
+	}
+	if !l.sortedBy(user.FieldID) && !l.sortedBy("random") {
+		// Use the ID as a tie-breaker, so the order is stable across pages.
+		query.Order(withFieldSelector(user.FieldID, l.terms[len(l.terms)-1].order, ""))
+	}
 	return nil
 }
 
@@ -2870,9 +6419,155 @@ func (l *ListUserParams) Exec(ctx context.Context, query *ent.UserQuery) (result
 		return nil, err
 	}
 	query.Where(predicates)
-	err = l.ApplySorting(EagerLoadUser(query))
+	EagerLoadUser(query)
+	if _, err = ExpandUser(query, l.Expand); err != nil {
+		return nil, err
+	}
+	err = l.ApplySorting(query)
+	if err != nil {
+		return nil, err
+	}
+	l.selector = func(ctx context.Context) (*sql.Selector, error) {
+		return resolveSelector(func(fn func(*sql.Selector)) (int, error) {
+			return query.Clone().Where(fn).Count(ctx)
+		})
+	}
+	// Fields are selected after counting, so the count isn't affected by the selected columns.
+	_, err = l.ApplyPagination(ctx, query, UserPageConfig)
 	if err != nil {
 		return nil, err
 	}
+	if _, err = SelectUser(query, l.Fields, l.Expand); err != nil {
+		return nil, err
+	}
 	return l.ExecutePaginated(ctx, query, UserPageConfig)
 }
+
+// FilterVaccinationParams defines the filter-related parameters for Vaccinations, which
+// are shared by all operations which filter Vaccinations (e.g. list and bulk operations).
+type FilterVaccinationParams struct {
+	Filtered[predicate.Vaccination]
+
+	// Filters field "id" to be equal to the provided value.
+	VaccinationIDEQ *int `form:"id.eq,omitempty" json:"vaccination_ideq,omitempty"`
+	// Filters field "id" to be not equal to the provided value.
+	VaccinationIDNEQ *int `form:"id.neq,omitempty" json:"vaccination_idneq,omitempty"`
+	// Filters field "id" to be within the provided values.
+	VaccinationIDIn []int `form:"id.in,omitempty" json:"vaccination_id_in,omitempty"`
+	// Filters field "id" to be not within the provided values.
+	VaccinationIDNotIn []int `form:"id.notIn,omitempty" json:"vaccination_id_not_in,omitempty"`
+}
+
+// ListVaccinationParams defines parameters for listing Vaccinations via a GET request.
+type ListVaccinationParams struct {
+	Sorted
+	Paginated[*ent.VaccinationQuery, ent.Vaccination]
+	FilterVaccinationParams
+	// Fields contains the fields requested by the client. As "fields" and "fields[<edge>]"
+	// can't be decoded together, this is populated from [ParseFieldSelection] instead.
+	Fields *FieldSelection `json:"-" form:"-"`
+}
+
+// FilterPredicates returns the predicates for filter-related parameters in Vaccination.
+func (p *FilterVaccinationParams) FilterPredicates() (predicate.Vaccination, error) {
+	var predicates []predicate.Vaccination
+
+	if p.VaccinationIDEQ != nil {
+		predicates = append(predicates, vaccination.IDEQ(*p.VaccinationIDEQ))
+	}
+	if p.VaccinationIDNEQ != nil {
+		predicates = append(predicates, vaccination.IDNEQ(*p.VaccinationIDNEQ))
+	}
+	if p.VaccinationIDIn != nil {
+		predicates = append(predicates, vaccination.IDIn(p.VaccinationIDIn...))
+	}
+	if p.VaccinationIDNotIn != nil {
+		predicates = append(predicates, vaccination.IDNotIn(p.VaccinationIDNotIn...))
+	}
+
+	pred, err := p.ApplyFilterOperation(predicates...)
+	if err != nil {
+		return nil, err
+	}
+	return p.ApplyFilterExpression(pred, filterPredicateVaccination)
+}
+
+// filterPredicateVaccination returns the predicate for a single filter within a filter
+// expression, where key is the name of the associated filter parameter.
+func filterPredicateVaccination(key string, value json.RawMessage) (predicate.Vaccination, error) {
+	switch key {
+	case "id.eq":
+		var v struct{ VaccinationIDEQ *int }
+		if err := json.Unmarshal(value, &v.VaccinationIDEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return vaccination.IDEQ(*v.VaccinationIDEQ), nil
+	case "id.neq":
+		var v struct{ VaccinationIDNEQ *int }
+		if err := json.Unmarshal(value, &v.VaccinationIDNEQ); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return vaccination.IDNEQ(*v.VaccinationIDNEQ), nil
+	case "id.in":
+		var v struct{ VaccinationIDIn []int }
+		if err := json.Unmarshal(value, &v.VaccinationIDIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return vaccination.IDIn(v.VaccinationIDIn...), nil
+	case "id.notIn":
+		var v struct{ VaccinationIDNotIn []int }
+		if err := json.Unmarshal(value, &v.VaccinationIDNotIn); err != nil {
+			return nil, fmt.Errorf("invalid value for filter %q: %w", key, err)
+		}
+		return vaccination.IDNotIn(v.VaccinationIDNotIn...), nil
+	default:
+		return nil, fmt.Errorf("unknown filter %q", key)
+	}
+}
+
+// ApplySorting applies sorting to the query based on the provided sort and order fields.
+func (l *ListVaccinationParams) ApplySorting(query *ent.VaccinationQuery) error {
+	if err := l.Sorted.Validate(VaccinationSortConfig); err != nil {
+		return err
+	}
+	if len(l.terms) == 0 { // No custom sort fields provided and no defaults, so don't do anything.
+		return nil
+	}
+	for _, term := range l.terms {
+		applySortingVaccination(query, term.field, term.order, term.nulls)
+	}
+	if !l.sortedBy(vaccination.FieldID) && !l.sortedBy("random") {
+		// Use the ID as a tie-breaker, so the order is stable across pages.
+		query.Order(withFieldSelector(vaccination.FieldID, l.terms[len(l.terms)-1].order, ""))
+	}
+	return nil
+}
+
+// Exec wraps all logic (filtering, sorting, pagination, eager loading) and
+// executes all necessary queries, returning the results.
+func (l *ListVaccinationParams) Exec(ctx context.Context, query *ent.VaccinationQuery) (results *PagedResponse[ent.Vaccination], err error) {
+	predicates, err := l.FilterPredicates()
+	if err != nil {
+		return nil, err
+	}
+	query.Where(predicates)
+	EagerLoadVaccination(query)
+	err = l.ApplySorting(query)
+	if err != nil {
+		return nil, err
+	}
+	l.selector = func(ctx context.Context) (*sql.Selector, error) {
+		return resolveSelector(func(fn func(*sql.Selector)) (int, error) {
+			return query.Clone().Where(fn).Count(ctx)
+		})
+	}
+	// Fields are selected after counting, so the count isn't affected by the selected columns.
+	_, err = l.ApplyPagination(ctx, query, VaccinationPageConfig)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = SelectVaccination(query, l.Fields); err != nil {
+		return nil, err
+	}
+	return l.ExecutePaginated(ctx, query, VaccinationPageConfig)
+}
@@ -28,6 +28,8 @@ type ReplacePetParams struct {
 	Friends []int `json:"friends,omitempty"`
 	// Users that this pet is followed by.
 	FollowedBy []uuid.UUID `json:"followed_by,omitempty"`
+	// Vaccinations the pet has received.
+	Vaccinations []int `json:"vaccinations,omitempty"`
 }
 
 func (r *ReplacePetParams) ApplyInputs(builder *ent.PetCreate) *ent.PetCreate {
@@ -115,6 +117,19 @@ func (r *ReplacePetParams) Exec(ctx context.Context, id int, builder *ent.PetCre
 			return nil, err
 		}
 	}
+	{
+		// Always clear (Replace means full replacement - unprovided fields are cleared)
+		edgeUpdater := updater.ClearVaccinations()
+		if len(r.Vaccinations) > 0 {
+			// Add the new edge IDs if provided
+			edgeUpdater = edgeUpdater.AddVaccinationIDs(r.Vaccinations...)
+		}
+		// If empty array or omitted, just clear (don't add anything)
+		err = edgeUpdater.Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Fetch the entity with eager-loaded edges
 	return EagerLoadPet(query.Where(pet.ID(id))).Only(ctx)
@@ -4,13 +4,16 @@ package rest
 
 import (
 	"bytes"
+	"crypto/sha256"
 	_ "embed"
 	"encoding"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"html/template"
 	"io"
+	"math/big"
 	"net/http"
 	"net/url"
 	"slices"
@@ -18,15 +21,20 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/go-playground/form/v4"
 	uuid "github.com/google/uuid"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/follows"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/friendship"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/post"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/privacy"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 //go:embed openapi.json
@@ -59,19 +67,122 @@ const (
 	OperationDelete Operation = "delete"
 	// OperationList represents the list operation (method: GET).
 	OperationList Operation = "list"
+	// OperationSearch represents the search operation (method: POST), which accepts the
+	// same options as the list operation, in a JSON request body.
+	OperationSearch Operation = "search"
+	// OperationCount represents the count operation (method: GET, and HEAD on the list
+	// endpoint), which returns the number of entities matching the provided filters.
+	OperationCount Operation = "count"
+	// OperationAggregate represents the aggregate operation (method: GET), which returns
+	// aggregated values of the entities matching the provided filters.
+	OperationAggregate Operation = "aggregate"
+	// OperationDistinct represents the distinct operation (method: GET), which returns
+	// the distinct values of a field, for the entities matching the provided filters.
+	OperationDistinct Operation = "distinct"
+	// OperationBulkCreate represents the bulk create operation (method: POST), which
+	// creates multiple entities in a single transaction.
+	OperationBulkCreate Operation = "bulk_create"
+	// OperationBulkDelete represents the bulk delete operation (method: DELETE), which
+	// deletes all entities matching the provided filters.
+	OperationBulkDelete Operation = "bulk_delete"
+	// OperationBulkUpdate represents the bulk update operation (method: PATCH), which
+	// updates all entities matching the provided filters.
+	OperationBulkUpdate Operation = "bulk_update"
 )
 
 // ErrorResponse is the response structure for errors.
 type ErrorResponse struct {
-	Error     string `json:"error"`                // The underlying error, which may be masked when debugging is disabled.
-	Type      string `json:"type"`                 // A summary of the error code based off the HTTP status code or application error code.
-	Code      int    `json:"code"`                 // The HTTP status code or other internal application error code.
-	RequestID string `json:"request_id,omitempty"` // The unique request ID for this error.
-	Timestamp string `json:"timestamp,omitempty"`  // The timestamp of the error, in RFC3339 format.
+	Error     string       `json:"error"`                // The underlying error, which may be masked when debugging is disabled.
+	Type      string       `json:"type"`                 // A summary of the error code based off the HTTP status code or application error code.
+	Code      int          `json:"code"`                 // The HTTP status code or other internal application error code.
+	RequestID string       `json:"request_id,omitempty"` // The unique request ID for this error.
+	Timestamp string       `json:"timestamp,omitempty"`  // The timestamp of the error, in RFC3339 format.
+	Errors    []FieldError `json:"errors,omitempty"`     // The fields which caused the error, if known (400 errors only).
+}
+
+// FieldError describes why a field of the request is invalid, e.g. because it failed
+// validation, or couldn't be decoded.
+type FieldError struct {
+	Field  string `json:"field,omitempty"` // The name (or path) of the field. Empty if the error isn't specific to a field (e.g. malformed JSON).
+	Reason string `json:"reason"`          // Why the field is invalid.
+	Value  any    `json:"value,omitempty"` // The provided value, if known.
+}
+
+// fieldErrors returns the fields which caused the provided error, if known.
+func fieldErrors(err error) []FieldError {
+	var badReq *ErrBadRequest
+	if errors.As(err, &badReq) {
+		return badReq.Fields
+	}
+
+	var verr *ent.ValidationError
+	if errors.As(err, &verr) {
+		reason := verr.Error()
+		if uerr := errors.Unwrap(verr); uerr != nil {
+			reason = uerr.Error()
+		}
+		return []FieldError{{Field: verr.Name, Reason: reason}}
+	}
+	return nil
+}
+
+// maskFieldErrors strips the reason and value of the provided field errors, only
+// retaining which fields were invalid. Errors which aren't specific to a field are
+// removed entirely.
+func maskFieldErrors(errs []FieldError) (masked []FieldError) {
+	for _, e := range errs {
+		if e.Field == "" {
+			continue
+		}
+		masked = append(masked, FieldError{Field: e.Field, Reason: "invalid value"})
+	}
+	return masked
+}
+
+// ProblemDetails is the response structure for errors, as defined by RFC 9457
+// ("application/problem+json").
+type ProblemDetails struct {
+	Type      string       `json:"type"`                 // A URI reference which identifies the problem type.
+	Title     string       `json:"title"`                // A short, human-readable summary of the problem type.
+	Status    int          `json:"status"`               // The HTTP status code.
+	Detail    string       `json:"detail,omitempty"`     // The underlying error, which may be masked when debugging is disabled.
+	Instance  string       `json:"instance,omitempty"`   // The path of the request which caused the problem.
+	RequestID string       `json:"request_id,omitempty"` // The unique request ID for this error.
+	Timestamp string       `json:"timestamp,omitempty"`  // The timestamp of the error, in RFC3339 format.
+	Errors    []FieldError `json:"errors,omitempty"`     // The fields which caused the problem, if known (400 errors only).
+}
+
+// problemType returns the URI which identifies the problem type of the provided
+// HTTP status code.
+func problemType(code int) string {
+	return "https://example.com/problems/" + strings.ReplaceAll(strings.ToLower(strings.ReplaceAll(http.StatusText(code), "'", "")), " ", "-")
+}
+
+// NewProblemDetails converts an ErrorResponse (as built by [Server.DefaultErrorHandler])
+// into Problem Details, for the provided request. This is what the default error handler
+// responds with when Problem Details are enabled through the entrest config.
+func NewProblemDetails(r *http.Request, resp ErrorResponse) *ProblemDetails {
+	p := &ProblemDetails{
+		Type:      problemType(resp.Code),
+		Title:     http.StatusText(resp.Code),
+		Status:    resp.Code,
+		Detail:    resp.Error,
+		Instance:  r.URL.Path,
+		RequestID: resp.RequestID,
+		Timestamp: resp.Timestamp,
+		Errors:    resp.Errors,
+	}
+
+	// The path may have been modified (e.g. when a base path is stripped).
+	if u, uerr := url.ParseRequestURI(r.RequestURI); uerr == nil {
+		p.Instance = u.Path
+	}
+	return p
 }
 
 type ErrBadRequest struct {
-	Err error
+	Err    error
+	Fields []FieldError // The fields which caused the error, if known.
 }
 
 func (e ErrBadRequest) Error() string {
@@ -129,6 +240,11 @@ func IsInvalidID(err error) bool {
 // of "pretty" set to true.
 func JSON(w http.ResponseWriter, r *http.Request, status int, v any) {
 	w.Header().Set("Content-Type", "application/json")
+	writeJSON(w, r, status, v)
+}
+
+// writeJSON is similar to JSON, however it doesn't set the Content-Type header.
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
 	w.WriteHeader(status)
 	enc := json.NewEncoder(w)
 
@@ -156,24 +272,40 @@ var (
 
 // Bind decodes the request body to the given struct. At this time the only supported
 // content-types are application/json, application/x-www-form-urlencoded, as well as
-// GET parameters.
+// GET parameters. PATCH requests which update a single entity also support
+// application/merge-patch+json (RFC 7396) and application/json-patch+json (RFC 6902),
+// which are applied to the current state of the entity when the update is executed.
 func Bind(r *http.Request, v any) error {
 	err := r.ParseForm()
 	if err != nil {
 		return &ErrBadRequest{Err: fmt.Errorf("parsing form parameters: %w", err)}
 	}
 
+	contentType := r.Header.Get("Content-Type")
+
 	switch r.Method {
 	case http.MethodGet, http.MethodHead:
 		err = DefaultDecoder.Decode(v, r.Form)
 	case http.MethodPost, http.MethodPut, http.MethodPatch:
 		switch {
-		case strings.HasPrefix(r.Header.Get("Content-Type"), "application/json"):
-			dec := json.NewDecoder(r.Body)
-			dec.DisallowUnknownFields()
+		// Must be checked before application/json, which is a prefix of application/json-patch+json.
+		case strings.HasPrefix(contentType, "application/merge-patch+json"),
+			strings.HasPrefix(contentType, "application/json-patch+json"):
+			p, ok := v.(patchable)
+			if !ok || r.Method != http.MethodPatch {
+				return &ErrBadRequest{Err: fmt.Errorf("content-type %q is only supported for PATCH requests which update a single entity", contentType)}
+			}
+			defer r.Body.Close()
+
+			var patch *patchRequest
+			patch, err = decodePatch(r.Body, strings.HasPrefix(contentType, "application/merge-patch+json"))
+			if err == nil {
+				p.setPatch(patch)
+			}
+		case strings.HasPrefix(contentType, "application/json"):
 			defer r.Body.Close()
-			err = dec.Decode(v)
-		case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"):
+			err = decodeJSON(r.Body, v)
+		case strings.HasPrefix(contentType, "multipart/form-data"):
 			err = r.ParseMultipartForm(DefaultDecodeMaxMemory)
 			if err == nil {
 				err = DefaultDecoder.Decode(v, r.MultipartForm.Value)
@@ -186,11 +318,554 @@ func Bind(r *http.Request, v any) error {
 	}
 
 	if err != nil {
-		return &ErrBadRequest{Err: fmt.Errorf("error decoding %s request into required format (%T): %w", r.Method, v, err)}
+		return &ErrBadRequest{
+			Err:    fmt.Errorf("error decoding %s request into required format (%T): %w", r.Method, v, err),
+			Fields: bindFieldErrors(err, r.Form),
+		}
+	}
+	return nil
+}
+
+// decodeJSON decodes the JSON document from the provided reader to the given struct.
+func decodeJSON(r io.Reader, v any) error {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// BindQuery decodes the query parameters of the request to the given struct, regardless
+// of the request method. This is useful for requests which also have a body (or which
+// don't support one), where the query parameters are used to select entities.
+func BindQuery(r *http.Request, v any) error {
+	query := r.URL.Query()
+	if err := DefaultDecoder.Decode(v, query); err != nil {
+		return &ErrBadRequest{
+			Err:    fmt.Errorf("error decoding query parameters into required format (%T): %w", v, err),
+			Fields: bindFieldErrors(err, query),
+		}
+	}
+	return nil
+}
+
+// bindFieldErrors returns the fields which caused the provided decoding error, if known.
+// values are the form values which were decoded, which are used to provide the value of
+// each invalid field.
+func bindFieldErrors(err error, values url.Values) (fields []FieldError) {
+	var (
+		typeErr   *json.UnmarshalTypeError
+		syntaxErr *json.SyntaxError
+		formErrs  form.DecodeErrors
+	)
+
+	switch {
+	case errors.As(err, &typeErr):
+		return []FieldError{{
+			Field:  typeErr.Field,
+			Reason: fmt.Sprintf("cannot use JSON %s as %s (at offset %d)", typeErr.Value, typeErr.Type, typeErr.Offset),
+		}}
+	case errors.As(err, &syntaxErr):
+		return []FieldError{{
+			Reason: fmt.Sprintf("invalid JSON (at offset %d): %v", syntaxErr.Offset, syntaxErr),
+		}}
+	case errors.As(err, &formErrs):
+		for name, ferr := range formErrs {
+			field := FieldError{Field: name, Reason: ferr.Error()}
+			if v := values[name]; len(v) == 1 {
+				field.Value = v[0]
+			} else if len(v) > 1 {
+				field.Value = v
+			}
+			fields = append(fields, field)
+		}
+
+		slices.SortFunc(fields, func(a, b FieldError) int {
+			return strings.Compare(a.Field, b.Field)
+		})
+		return fields
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// Returned when unknown fields are disallowed, which isn't a distinct error type.
+		return []FieldError{{
+			Field:  strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`),
+			Reason: "unknown field",
+		}}
+	}
+	return nil
+}
+
+// ErrPatchTestFailed is returned when a "test" operation of a JSON Patch document doesn't
+// match the current state of the entity.
+var ErrPatchTestFailed = errors.New("patch test operation failed")
+
+// IsPatchTestFailed returns true if the unwrapped/underlying error is of type ErrPatchTestFailed.
+func IsPatchTestFailed(err error) bool {
+	return errors.Is(err, ErrPatchTestFailed)
+}
+
+// patchable is implemented by the params of update operations, which accept JSON Merge
+// Patch (RFC 7396) and JSON Patch (RFC 6902) documents. As the result of a patch depends
+// on the current state of the entity, the document is only stored by Bind, and applied
+// when the update is executed.
+type patchable interface {
+	setPatch(patch *patchRequest)
+}
+
+// jsonPatchOperation is a single operation of a JSON Patch document.
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// patchRequest is a JSON Merge Patch or JSON Patch document provided to an update operation.
+type patchRequest struct {
+	merge      any                  // The JSON Merge Patch document, if any.
+	operations []jsonPatchOperation // The JSON Patch operations, if any.
+}
+
+// decodePatch decodes a JSON Merge Patch (if merge is true) or JSON Patch document.
+func decodePatch(r io.Reader, merge bool) (*patchRequest, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	patch := &patchRequest{}
+	if merge {
+		return patch, dec.Decode(&patch.merge)
+	}
+	return patch, dec.Decode(&patch.operations)
+}
+
+// patchTarget is the current state of an entity in the format of the request body of its
+// update operation, which JSON Merge Patch and JSON Patch documents are applied to (see
+// the patchDocument method of the params).
+type patchTarget struct {
+	doc   map[string]any    // The current values of the fields and edges.
+	edges []string          // Keys of non-unique edges, which are arrays of the IDs of the linked entities.
+	clear map[string]func() // Keys of optional fields and edges, and the functions which clear them.
+}
+
+// apply applies the patch to the current state of the entity, and decodes the resulting
+// changes into v. Optional fields and edges which were removed (or set to null) are
+// cleared, and removing any other key is rejected. Added and removed IDs of non-unique
+// edges are converted into the "add_<edge>" and "remove_<edge>" fields.
+func (p *patchRequest) apply(target *patchTarget, v any) error {
+	normalized, err := normalizeJSON(target.doc)
+	if err != nil {
+		return err
+	}
+	current, _ := normalized.(map[string]any)
+
+	var patched any
+	if p.operations != nil {
+		patched, err = applyJSONPatch(jsonClone(current), p.operations)
+		if err != nil {
+			if IsPatchTestFailed(err) {
+				return err
+			}
+			return &ErrBadRequest{Err: err}
+		}
+	} else {
+		patched = applyMergePatch(jsonClone(current), p.merge)
+	}
+
+	result, ok := patched.(map[string]any)
+	if !ok {
+		return &ErrBadRequest{Err: errors.New("patched document must be an object")}
+	}
+
+	update := map[string]any{}
+	var cleared []string
+	for key, value := range result {
+		if slices.Contains(target.edges, key) {
+			continue
+		}
+		if old, ok := current[key]; ok && jsonEqual(old, value) {
+			continue
+		}
+		if value == nil {
+			cleared = append(cleared, key)
+			continue
+		}
+		update[key] = value
+	}
+	for key := range current {
+		if _, ok := result[key]; !ok && !slices.Contains(target.edges, key) {
+			cleared = append(cleared, key)
+		}
+	}
+	slices.Sort(cleared)
+
+	// Option values which are null are decoded as the zero value, so fields and edges
+	// are cleared directly, and only if they're optional.
+	var invalid []FieldError
+	for _, key := range cleared {
+		if target.clear[key] == nil {
+			invalid = append(invalid, FieldError{Field: key, Reason: "isn't optional, so can't be removed or null"})
+		}
+	}
+	if len(invalid) > 0 {
+		return &ErrBadRequest{
+			Err:    errors.New("patched document removes (or sets to null) fields which aren't optional"),
+			Fields: invalid,
+		}
+	}
+
+	for _, key := range target.edges {
+		oldIDs, _ := current[key].([]any)
+
+		// Removing the edge unlinks all entities.
+		var newIDs []any
+		if value, ok := result[key]; ok {
+			if newIDs, ok = value.([]any); !ok {
+				return &ErrBadRequest{
+					Err:    fmt.Errorf("edge %q must be an array of IDs", key),
+					Fields: []FieldError{{Field: key, Reason: "must be an array of IDs", Value: value}},
+				}
+			}
+		}
+
+		contains := func(ids []any, id any) bool {
+			return slices.ContainsFunc(ids, func(v any) bool { return jsonEqual(v, id) })
+		}
+
+		var added, removed []any
+		for _, id := range newIDs {
+			if !contains(oldIDs, id) && !contains(added, id) {
+				added = append(added, id)
+			}
+		}
+		for _, id := range oldIDs {
+			if !contains(newIDs, id) {
+				removed = append(removed, id)
+			}
+		}
+
+		if len(added) > 0 {
+			update["add_"+key] = added
+		}
+		if len(removed) > 0 {
+			update["remove_"+key] = removed
+		}
+	}
+
+	b, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	if err = decodeJSON(bytes.NewReader(b), v); err != nil {
+		return &ErrBadRequest{
+			Err:    fmt.Errorf("error decoding patched document into required format (%T): %w", v, err),
+			Fields: bindFieldErrors(err, nil),
+		}
+	}
+
+	for _, key := range cleared {
+		target.clear[key]()
 	}
 	return nil
 }
 
+// normalizeJSON converts v into its generic JSON representation (maps, slices, strings,
+// json.Number, booleans and nil), so it can be patched and compared.
+func normalizeJSON(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var out any
+	return out, dec.Decode(&out)
+}
+
+// jsonClone returns a deep copy of the generic JSON value.
+func jsonClone(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, value := range v {
+			out[key] = jsonClone(value)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, value := range v {
+			out[i] = jsonClone(value)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// jsonEqual returns true if the generic JSON values are equal, where numbers are compared
+// by their value, and objects regardless of the order of their members.
+func jsonEqual(a, b any) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, xok := new(big.Float).SetString(a.String())
+		y, yok := new(big.Float).SetString(b.String())
+		return xok && yok && x.Cmp(y) == 0
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !jsonEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		return ok && slices.EqualFunc(a, b, jsonEqual)
+	default:
+		return a == b
+	}
+}
+
+// applyMergePatch applies the JSON Merge Patch (RFC 7396) document to target.
+func applyMergePatch(target, patch any) any {
+	members, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	out, ok := target.(map[string]any)
+	if !ok {
+		out = map[string]any{}
+	}
+
+	for key, value := range members {
+		if value == nil {
+			delete(out, key)
+			continue
+		}
+		out[key] = applyMergePatch(out[key], value)
+	}
+	return out
+}
+
+// applyJSONPatch applies the JSON Patch (RFC 6902) operations to doc. If any operation
+// fails, an error is returned, and none of the operations should be applied.
+func applyJSONPatch(doc any, operations []jsonPatchOperation) (any, error) {
+	for i, op := range operations {
+		path, err := parseJSONPointer(op.Path)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+
+		var (
+			value any
+			from  []string
+		)
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return nil, fmt.Errorf("operation %d: %q requires a value", i, op.Op)
+			}
+			dec := json.NewDecoder(bytes.NewReader(op.Value))
+			dec.UseNumber()
+			if err = dec.Decode(&value); err != nil {
+				return nil, fmt.Errorf("operation %d: invalid value: %w", i, err)
+			}
+		case "move", "copy":
+			from, err = parseJSONPointer(op.From)
+			if err != nil {
+				return nil, fmt.Errorf("operation %d: %w", i, err)
+			}
+		}
+
+		switch op.Op {
+		case "add":
+			doc, err = jsonAdd(doc, path, value)
+		case "remove":
+			doc, err = jsonRemove(doc, path)
+		case "replace":
+			if len(path) == 0 {
+				doc = value
+				break
+			}
+			doc, err = jsonRemove(doc, path)
+			if err == nil {
+				doc, err = jsonAdd(doc, path, value)
+			}
+		case "move":
+			if len(path) > len(from) && slices.Equal(path[:len(from)], from) {
+				return nil, fmt.Errorf("operation %d: cannot move %q into one of its children", i, op.From)
+			}
+			value, err = jsonGet(doc, from)
+			if err == nil {
+				doc, err = jsonRemove(doc, from)
+			}
+			if err == nil {
+				doc, err = jsonAdd(doc, path, value)
+			}
+		case "copy":
+			value, err = jsonGet(doc, from)
+			if err == nil {
+				doc, err = jsonAdd(doc, path, jsonClone(value))
+			}
+		case "test":
+			var current any
+			current, err = jsonGet(doc, path)
+			if err == nil && !jsonEqual(current, value) {
+				err = ErrPatchTestFailed
+			}
+		default:
+			return nil, fmt.Errorf("operation %d: unsupported operation %q", i, op.Op)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %q): %w", i, op.Op, op.Path, err)
+		}
+	}
+	return doc, nil
+}
+
+var jsonPointerUnescape = strings.NewReplacer("~1", "/", "~0", "~")
+
+// parseJSONPointer returns the reference tokens of the JSON Pointer (RFC 6901).
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = jsonPointerUnescape.Replace(tokens[i])
+	}
+	return tokens, nil
+}
+
+// jsonArrayIndex returns the index referenced by the token in an array of the provided
+// length. If end is true, the index after the last element ("-", or the length) is allowed.
+func jsonArrayIndex(token string, length int, end bool) (int, error) {
+	if end && token == "-" {
+		return length, nil
+	}
+
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') || token[0] == '+' {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if i > length || (i == length && !end) {
+		return 0, fmt.Errorf("array index %d out of bounds", i)
+	}
+	return i, nil
+}
+
+// jsonGet returns the value referenced by the path.
+func jsonGet(doc any, path []string) (any, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			doc = value
+		case []any:
+			i, err := jsonArrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[i]
+		default:
+			return nil, fmt.Errorf("cannot reference %q in a value which isn't an object or array", token)
+		}
+	}
+	return doc, nil
+}
+
+// jsonUpdate replaces the parent of the value referenced by the path (which must not be
+// empty) with the result of fn, which is provided with the parent and the last token.
+func jsonUpdate(doc any, path []string, fn func(parent any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+
+	child, err := jsonGet(doc, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	child, err = jsonUpdate(child, path[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node := doc.(type) {
+	case map[string]any:
+		node[path[0]] = child
+	case []any:
+		i, _ := jsonArrayIndex(path[0], len(node), false)
+		node[i] = child
+	}
+	return doc, nil
+}
+
+// jsonAdd adds the value at the path, replacing existing object members, and inserting
+// into arrays.
+func jsonAdd(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return jsonUpdate(doc, path, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			i, err := jsonArrayIndex(token, len(node), true)
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(node, i, value), nil
+		default:
+			return nil, fmt.Errorf("cannot add %q to a value which isn't an object or array", token)
+		}
+	})
+}
+
+// jsonRemove removes the value at the path, which must exist.
+func jsonRemove(doc any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, errors.New("cannot remove the whole document")
+	}
+
+	return jsonUpdate(doc, path, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			delete(node, token)
+			return node, nil
+		case []any:
+			i, err := jsonArrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			return slices.Delete(node, i, i+1), nil
+		default:
+			return nil, fmt.Errorf("cannot remove %q from a value which isn't an object or array", token)
+		}
+	})
+}
+
 // Req simplifies making an HTTP handler that returns a single result, and an error.
 // The result, if not nil, must be JSON-marshalable. If result is nil, [http.StatusNoContent]
 // will be returned.
@@ -205,7 +880,13 @@ func Req[Resp any](s *Server, op Operation, fn func(*http.Request) (*Resp, error
 // Only supports string, int, and types that support UnmarshalText, UnmarshalJSON, or UnmarshalBinary
 // (in that order).
 func resolveID[T any](r *http.Request) (id T, err error) {
-	value := r.PathValue("id")
+	return resolvePathID[T](r, "id")
+}
+
+// resolvePathID is similar to resolveID, but resolves the ID from the provided path
+// parameter (e.g. "friendID" for "/pets/{id}/friends/{friendID}").
+func resolvePathID[T any](r *http.Request, name string) (id T, err error) {
+	value := r.PathValue(name)
 
 	switch any(id).(type) {
 	case string:
@@ -289,61 +970,321 @@ func ReqIDParam[Params, Resp, I any](s *Server, op Operation, fn func(*http.Requ
 	}
 }
 
-// Links represents a set of linkable-relationsips that can be represented through
-// the "Link" header. Note that all urls must be url-encoded already.
-type Links map[string]string
-
-func (l Links) String() string {
-	var links []string
-	var keys []string
-	for k := range l {
-		keys = append(keys, k)
-	}
-	slices.Sort(keys)
-	for _, k := range keys {
-		links = append(links, fmt.Sprintf(`<%s>; rel=%q`, l[k], k))
+// newETag returns a strong ETag, derived from a hash of the provided value.
+func newETag(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
 	}
-	return strings.Join(links, ", ")
+	sum := sha256.Sum256(b)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
 }
 
-type linkablePagedResource interface {
-	GetPage() int
-	GetIsLastPage() bool
+// ErrPreconditionFailed is returned when the If-Match header of a request doesn't match the
+// current ETag of the entity.
+var ErrPreconditionFailed = errors.New("precondition failed: entity has been modified since it was retrieved")
+
+// IsPreconditionFailed returns true if the unwrapped/underlying error is of type ErrPreconditionFailed.
+func IsPreconditionFailed(err error) bool {
+	return errors.Is(err, ErrPreconditionFailed)
 }
 
-// Spec returns the OpenAPI spec for the server implementation.
-func (s *Server) Spec(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	if !s.config.DisableSpecInjectServer && s.config.BaseURL != "" {
-		spec := map[string]any{}
-		err := json.Unmarshal(OpenAPI, &spec)
-		if err != nil {
-			panic(fmt.Sprintf("failed to unmarshal spec: %v", err))
-		}
+// CategoryETag returns the ETag of the provided Category, derived from the "updated_at" field.
+func CategoryETag(e *ent.Category) string {
+	return newETag(e.UpdatedAt)
+}
 
-		type Server struct {
-			URL string `json:"url"`
-		}
+// FollowETag returns the ETag of the provided Follow, derived from all of its
+// fields. Edges aren't included, as they can change depending on what is eager-loaded.
+func FollowETag(e *ent.Follows) string {
+	c := *e
+	c.Edges = ent.FollowsEdges{}
+	return newETag(c)
+}
 
-		if _, ok := spec["servers"]; !ok {
-			spec["servers"] = []Server{{URL: s.config.BaseURL}}
-			JSON(w, r, http.StatusOK, spec)
-			return
-		}
-	}
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(OpenAPI)
+// FriendshipETag returns the ETag of the provided Friendship, derived from all of its
+// fields. Edges aren't included, as they can change depending on what is eager-loaded.
+func FriendshipETag(e *ent.Friendship) string {
+	c := *e
+	c.Edges = ent.FriendshipEdges{}
+	return newETag(c)
 }
 
-var scalarTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
-<html>
-  <head>
-    <title>API Reference</title>
-    <meta charset="utf-8" />
-    <meta name="viewport" content="width=device-width, initial-scale=1" />
-    <meta name="darkreader-lock">
-    <link rel="icon" type="image/svg+xml"
-      href="data:image/svg+xml,%3Csvg xmlns='http://www.w3.org/2000/svg' width='32' height='32' viewBox='0 0 1024 1024'%3E%3Cpath fill='currentColor' d='m917.7 148.8l-42.4-42.4c-1.6-1.6-3.6-2.3-5.7-2.3s-4.1.8-5.7 2.3l-76.1 76.1a199.27 199.27 0 0 0-112.1-34.3c-51.2 0-102.4 19.5-141.5 58.6L432.3 308.7a8.03 8.03 0 0 0 0 11.3L704 591.7c1.6 1.6 3.6 2.3 5.7 2.3c2 0 4.1-.8 5.7-2.3l101.9-101.9c68.9-69 77-175.7 24.3-253.5l76.1-76.1c3.1-3.2 3.1-8.3 0-11.4M769.1 441.7l-59.4 59.4l-186.8-186.8l59.4-59.4c24.9-24.9 58.1-38.7 93.4-38.7s68.4 13.7 93.4 38.7c24.9 24.9 38.7 58.1 38.7 93.4s-13.8 68.4-38.7 93.4m-190.2 105a8.03 8.03 0 0 0-11.3 0L501 613.3L410.7 523l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3L441 408.6a8.03 8.03 0 0 0-11.3 0L363 475.3l-43-43a7.85 7.85 0 0 0-5.7-2.3c-2 0-4.1.8-5.7 2.3L206.8 534.2c-68.9 69-77 175.7-24.3 253.5l-76.1 76.1a8.03 8.03 0 0 0 0 11.3l42.4 42.4c1.6 1.6 3.6 2.3 5.7 2.3s4.1-.8 5.7-2.3l76.1-76.1c33.7 22.9 72.9 34.3 112.1 34.3c51.2 0 102.4-19.5 141.5-58.6l101.9-101.9c3.1-3.1 3.1-8.2 0-11.3l-43-43l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3zM441.7 769.1a131.32 131.32 0 0 1-93.4 38.7c-35.3 0-68.4-13.7-93.4-38.7a131.32 131.32 0 0 1-38.7-93.4c0-35.3 13.7-68.4 38.7-93.4l59.4-59.4l186.8 186.8z'/%3E%3C/svg%3E" />
+// PetETag returns the ETag of the provided Pet, derived from all of its
+// fields. Edges aren't included, as they can change depending on what is eager-loaded.
+func PetETag(e *ent.Pet) string {
+	c := *e
+	c.Edges = ent.PetEdges{}
+	return newETag(c)
+}
+
+// PostETag returns the ETag of the provided Post, derived from the "updated_at" field.
+func PostETag(e *ent.Post) string {
+	return newETag(e.UpdatedAt)
+}
+
+// SettingETag returns the ETag of the provided Setting, derived from the "updated_at" field.
+func SettingETag(e *ent.Settings) string {
+	return newETag(e.UpdatedAt)
+}
+
+// UserETag returns the ETag of the provided User, derived from the "updated_at" field.
+func UserETag(e *ent.User) string {
+	return newETag(e.UpdatedAt)
+}
+
+// VaccinationETag returns the ETag of the provided Vaccination, derived from all of its
+// fields. Edges aren't included, as they can change depending on what is eager-loaded.
+func VaccinationETag(e *ent.Vaccination) string {
+	c := *e
+	c.Edges = ent.VaccinationEdges{}
+	return newETag(c)
+}
+
+// entityETag returns the ETag of the provided entity, or an empty string if it isn't
+// a single entity.
+func entityETag(v any) string {
+	switch e := v.(type) {
+	case *ent.Category:
+		return CategoryETag(e)
+	case *ent.Follows:
+		return FollowETag(e)
+	case *ent.Friendship:
+		return FriendshipETag(e)
+	case *ent.Pet:
+		return PetETag(e)
+	case *ent.Post:
+		return PostETag(e)
+	case *ent.Settings:
+		return SettingETag(e)
+	case *ent.User:
+		return UserETag(e)
+	case *ent.Vaccination:
+		return VaccinationETag(e)
+	default:
+		return ""
+	}
+}
+
+// checkIfMatch validates the provided If-Match header (if any) against the current ETag
+// of the entity, which is fetched using current. orCreate should be true if the operation
+// creates the entity when it doesn't exist, in which case no precondition is required.
+// ETags of GET responses, which are prefixed with the ETag of the entity (see
+// isNotModified), are also accepted.
+func checkIfMatch[T any](header string, orCreate bool, current func() (*T, error)) error {
+	entity, err := current()
+	switch {
+	case ent.IsNotFound(err) && header == "":
+		if orCreate {
+			return nil
+		}
+		return err
+	case ent.IsNotFound(err):
+		// There is no current ETag to match.
+		return ErrPreconditionFailed
+	case err != nil:
+		return err
+	}
+
+	etag := entityETag(entity)
+	for _, v := range strings.Split(header, ",") {
+		if v = strings.TrimSpace(v); v == "*" || v == etag {
+			return nil
+		}
+		if strings.HasPrefix(v, strings.TrimSuffix(etag, `"`)+"-") {
+			return nil
+		}
+	}
+	return ErrPreconditionFailed
+}
+
+// forUpdate is a predicate which locks the selected rows until the end of the transaction
+// (SELECT ... FOR UPDATE), so they can't be modified concurrently. SQLite doesn't support
+// row locks, however it only allows a single writer, so the write of any concurrent
+// transaction fails instead.
+func forUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
+
+// withIfMatch invokes exec, after validating the If-Match header of the request (if any)
+// against the current ETag of the entity, which is fetched using current. Both run in
+// the same transaction, and current should lock the entity (see forUpdate), so it can't
+// be modified between the check and exec. See checkIfMatch for orCreate.
+func withIfMatch[T, R any](r *http.Request, db *ent.Client, orCreate bool, current func(*ent.Client) (*T, error), exec func(*ent.Client) (*R, error)) (*R, error) {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return exec(db)
+	}
+
+	tx, err := db.Tx(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkIfMatch(header, orCreate, func() (*T, error) {
+		return current(tx.Client())
+	})
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	result, err := exec(tx.Client())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CategoryLastModified returns when the provided Category was last modified,
+// derived from the "updated_at" field.
+func CategoryLastModified(e *ent.Category) time.Time {
+	return e.UpdatedAt
+}
+
+// PostLastModified returns when the provided Post was last modified,
+// derived from the "updated_at" field.
+func PostLastModified(e *ent.Post) time.Time {
+	return e.UpdatedAt
+}
+
+// SettingLastModified returns when the provided Setting was last modified,
+// derived from the "updated_at" field.
+func SettingLastModified(e *ent.Settings) time.Time {
+	return e.UpdatedAt
+}
+
+// UserLastModified returns when the provided User was last modified,
+// derived from the "updated_at" field.
+func UserLastModified(e *ent.User) time.Time {
+	return e.UpdatedAt
+}
+
+// entityLastModified returns when the provided entity was last modified, or the zero
+// time if it isn't a single entity, or the schema has no Last-Modified field.
+func entityLastModified(v any) time.Time {
+	switch e := v.(type) {
+	case *ent.Category:
+		return CategoryLastModified(e)
+	case *ent.Post:
+		return PostLastModified(e)
+	case *ent.Settings:
+		return SettingLastModified(e)
+	case *ent.User:
+		return UserLastModified(e)
+	default:
+		return time.Time{}
+	}
+}
+
+// isNotModified sets the ETag and Last-Modified headers of the response, and returns
+// true if the copy of the response the client already has is still current, based on
+// the If-None-Match and If-Modified-Since headers. body is the value which will be
+// returned to the client, and resp the value before any fields were trimmed.
+//
+// The ETag is derived from a hash of body, so it changes whenever the response does
+// (e.g. when eager-loaded edges change, or different edges are expanded).
+// If the response already has the ETag of the entity, it's used as a prefix, so the
+// ETag can still be provided in the If-Match header of subsequent requests.
+func isNotModified(w http.ResponseWriter, r *http.Request, resp, body any) bool {
+	etag := newETag(body)
+	if etag == "" {
+		return false
+	}
+	if entity := w.Header().Get("ETag"); entity != "" {
+		etag = strings.TrimSuffix(entity, `"`) + "-" + strings.TrimPrefix(etag, `"`)
+	}
+	w.Header().Set("ETag", etag)
+
+	modified := entityLastModified(resp)
+	if !modified.IsZero() {
+		w.Header().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+
+	// If-Modified-Since is ignored when If-None-Match is provided, as ETags are
+	// more precise.
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		for _, v := range strings.Split(header, ",") {
+			// GET requests use the weak comparison function.
+			if v = strings.TrimPrefix(strings.TrimSpace(v), "W/"); v == "*" || v == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if header := r.Header.Get("If-Modified-Since"); header != "" && !modified.IsZero() {
+		since, err := http.ParseTime(header)
+		return err == nil && !modified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// Links represents a set of linkable-relationsips that can be represented through
+// the "Link" header. Note that all urls must be url-encoded already.
+type Links map[string]string
+
+func (l Links) String() string {
+	var links []string
+	var keys []string
+	for k := range l {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		links = append(links, fmt.Sprintf(`<%s>; rel=%q`, l[k], k))
+	}
+	return strings.Join(links, ", ")
+}
+
+type linkablePagedResource interface {
+	GetPage() int
+	GetIsLastPage() bool
+}
+
+// linkableCursorResource is implemented by cursor-paginated responses, which
+// can only link to the next page.
+type linkableCursorResource interface {
+	GetNextCursor() string
+}
+
+// Spec returns the OpenAPI spec for the server implementation.
+func (s *Server) Spec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if !s.config.DisableSpecInjectServer && s.config.BaseURL != "" {
+		spec := map[string]any{}
+		err := json.Unmarshal(OpenAPI, &spec)
+		if err != nil {
+			panic(fmt.Sprintf("failed to unmarshal spec: %v", err))
+		}
+
+		type Server struct {
+			URL string `json:"url"`
+		}
+
+		if _, ok := spec["servers"]; !ok {
+			spec["servers"] = []Server{{URL: s.config.BaseURL}}
+			JSON(w, r, http.StatusOK, spec)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(OpenAPI)
+}
+
+var scalarTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>API Reference</title>
+    <meta charset="utf-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1" />
+    <meta name="darkreader-lock">
+    <link rel="icon" type="image/svg+xml"
+      href="data:image/svg+xml,%3Csvg xmlns='http://www.w3.org/2000/svg' width='32' height='32' viewBox='0 0 1024 1024'%3E%3Cpath fill='currentColor' d='m917.7 148.8l-42.4-42.4c-1.6-1.6-3.6-2.3-5.7-2.3s-4.1.8-5.7 2.3l-76.1 76.1a199.27 199.27 0 0 0-112.1-34.3c-51.2 0-102.4 19.5-141.5 58.6L432.3 308.7a8.03 8.03 0 0 0 0 11.3L704 591.7c1.6 1.6 3.6 2.3 5.7 2.3c2 0 4.1-.8 5.7-2.3l101.9-101.9c68.9-69 77-175.7 24.3-253.5l76.1-76.1c3.1-3.2 3.1-8.3 0-11.4M769.1 441.7l-59.4 59.4l-186.8-186.8l59.4-59.4c24.9-24.9 58.1-38.7 93.4-38.7s68.4 13.7 93.4 38.7c24.9 24.9 38.7 58.1 38.7 93.4s-13.8 68.4-38.7 93.4m-190.2 105a8.03 8.03 0 0 0-11.3 0L501 613.3L410.7 523l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3L441 408.6a8.03 8.03 0 0 0-11.3 0L363 475.3l-43-43a7.85 7.85 0 0 0-5.7-2.3c-2 0-4.1.8-5.7 2.3L206.8 534.2c-68.9 69-77 175.7-24.3 253.5l-76.1 76.1a8.03 8.03 0 0 0 0 11.3l42.4 42.4c1.6 1.6 3.6 2.3 5.7 2.3s4.1-.8 5.7-2.3l76.1-76.1c33.7 22.9 72.9 34.3 112.1 34.3c51.2 0 102.4-19.5 141.5-58.6l101.9-101.9c3.1-3.1 3.1-8.2 0-11.3l-43-43l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3zM441.7 769.1a131.32 131.32 0 0 1-93.4 38.7c-35.3 0-68.4-13.7-93.4-38.7a131.32 131.32 0 0 1-38.7-93.4c0-35.3 13.7-68.4 38.7-93.4l59.4-59.4l186.8 186.8z'/%3E%3C/svg%3E" />
   </head>
   <body>
     <script id="api-reference"></script>
@@ -420,7 +1361,9 @@ type ServerConfig struct {
 	EnableLinks bool
 
 	// MaskErrors if set to true, will mask the error message returned to the client,
-	// returning a generic error message based on the HTTP status code.
+	// returning a generic error message based on the HTTP status code. Field errors of
+	// 400 responses only include the names of the invalid fields, with a generic reason,
+	// and without the provided value.
 	MaskErrors bool
 
 	// ErrorHandler is invoked when an error occurs. If not provided, the default
@@ -490,6 +1433,10 @@ func (s *Server) DefaultErrorHandler(w http.ResponseWriter, r *http.Request, op
 		resp.Code = http.StatusBadRequest
 	case IsInvalidID(err):
 		resp.Code = http.StatusBadRequest
+	case IsPatchTestFailed(err):
+		resp.Code = http.StatusConflict
+	case IsPreconditionFailed(err):
+		resp.Code = http.StatusPreconditionFailed
 	case errors.Is(err, privacy.Deny):
 		resp.Code = http.StatusForbidden
 	case ent.IsNotFound(err):
@@ -508,8 +1455,12 @@ func (s *Server) DefaultErrorHandler(w http.ResponseWriter, r *http.Request, op
 	if resp.Type == "" {
 		resp.Type = http.StatusText(resp.Code)
 	}
+	if resp.Code == http.StatusBadRequest {
+		resp.Errors = fieldErrors(err)
+	}
 	if s.config.MaskErrors {
 		resp.Error = http.StatusText(resp.Code)
+		resp.Errors = maskFieldErrors(resp.Errors)
 	}
 	if s.config.GetReqID != nil {
 		resp.RequestID = s.config.GetReqID(r)
@@ -546,6 +1497,16 @@ func handleResponse[Resp any](s *Server, w http.ResponseWriter, r *http.Request,
 						links["next"] = s.config.BasePath + links["next"]
 					}
 				}
+			} else if lr, ok := any(resp).(linkableCursorResource); ok {
+				if cursor := lr.GetNextCursor(); cursor != "" {
+					query := r.URL.Query()
+					query.Set("cursor", cursor)
+					r.URL.RawQuery = query.Encode()
+					links["next"] = r.URL.String()
+					if !strings.HasPrefix(links["next"], s.config.BasePath) {
+						links["next"] = s.config.BasePath + links["next"]
+					}
+				}
 			}
 		}
 
@@ -553,6 +1514,22 @@ func handleResponse[Resp any](s *Server, w http.ResponseWriter, r *http.Request,
 			w.Header().Set("Link", v)
 		}
 	}
+
+	// Entity ETags (which If-Match is compared against) are only provided when the
+	// response contains the full entity.
+	if err == nil && resp != nil && ParseFieldSelection(r.URL.Query()) == nil {
+		if etag := entityETag(resp); etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+	}
+
+	// Trim any fields which weren't requested by the client, when using the "fields"
+	// query parameter.
+	var body any = resp
+	if fs := ParseFieldSelection(r.URL.Query()); fs != nil && err == nil && resp != nil && (op == OperationRead || op == OperationList || op == OperationSearch) {
+		body, err = fs.trim(resp, op != OperationRead)
+	}
+
 	if err != nil {
 		if s.config.ErrorHandler != nil {
 			s.config.ErrorHandler(w, r, op, err)
@@ -563,17 +1540,28 @@ func handleResponse[Resp any](s *Server, w http.ResponseWriter, r *http.Request,
 	}
 	if resp != nil {
 		type pagedResp interface {
-			GetTotalCount() int
+			isEmpty() bool
 		}
-		if v, ok := any(resp).(pagedResp); ok && v.GetTotalCount() == 0 && r.Method == http.MethodGet {
-			JSON(w, r, http.StatusNotFound, resp)
+		if v, ok := any(resp).(pagedResp); ok && v.isEmpty() && (r.Method == http.MethodGet || op == OperationSearch) {
+			JSON(w, r, http.StatusNotFound, body)
 			return
 		}
-		if r.Method == http.MethodPost {
+		if r.Method == http.MethodHead {
+			if v, ok := any(resp).(*CountResult); ok {
+				w.Header().Set("X-Total-Count", strconv.Itoa(v.Count))
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.Method == http.MethodGet && (op == OperationRead || op == OperationList) && isNotModified(w, r, resp, body) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if r.Method == http.MethodPost && op != OperationSearch {
 			JSON(w, r, http.StatusCreated, resp)
 			return
 		}
-		JSON(w, r, http.StatusOK, resp)
+		JSON(w, r, http.StatusOK, body)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -596,7 +1584,10 @@ func (s *Server) Handler() http.Handler {
 	mux := http.NewServeMux()
 	mux.HandleFunc("PUT /categories/{id}", ReqIDParam(s, OperationUpsert, s.UpsertCategory))
 	mux.HandleFunc("GET /follows", ReqParam(s, OperationList, s.ListFollows))
+	mux.HandleFunc("GET /follows/{userID}/{petID}", Req(s, OperationRead, s.GetFollow))
 	mux.HandleFunc("POST /follows", ReqParam(s, OperationCreate, s.CreateFollow))
+	mux.HandleFunc("PATCH /follows/{userID}/{petID}", ReqParam(s, OperationUpdate, s.UpdateFollow))
+	mux.HandleFunc("DELETE /follows/{userID}/{petID}", Req(s, OperationDelete, s.DeleteFollow))
 	mux.HandleFunc("GET /friendships", ReqParam(s, OperationList, s.ListFriendships))
 	mux.HandleFunc("GET /friendships/{id}", ReqID(s, OperationRead, s.GetFriendship))
 	mux.HandleFunc("GET /friendships/{id}/user", ReqID(s, OperationRead, s.GetFriendshipUser))
@@ -605,14 +1596,33 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("PATCH /friendships/{id}", ReqIDParam(s, OperationUpdate, s.UpdateFriendship))
 	mux.HandleFunc("DELETE /friendships/{id}", ReqID(s, OperationDelete, s.DeleteFriendship))
 	mux.HandleFunc("GET /pets", ReqParam(s, OperationList, s.ListPets))
+	mux.HandleFunc("POST /pets/search", ReqParam(s, OperationSearch, s.SearchPets))
+	mux.HandleFunc("GET /pets/count", ReqParam(s, OperationCount, s.CountPets))
+	mux.HandleFunc("HEAD /pets", ReqParam(s, OperationCount, s.CountPets))
+	mux.HandleFunc("GET /pets/aggregate", ReqParam(s, OperationAggregate, s.AggregatePets))
+	mux.HandleFunc("GET /pets/distinct/type", ReqParam(s, OperationDistinct, s.DistinctPetsType))
 	mux.HandleFunc("GET /pets/{id}", ReqID(s, OperationRead, s.GetPet))
 	mux.HandleFunc("GET /pets/{id}/categories", ReqIDParam(s, OperationList, s.ListPetCategories))
+	mux.HandleFunc("PUT /pets/{id}/categories/{categoryID}", ReqID(s, OperationUpdate, s.LinkPetCategory))
+	mux.HandleFunc("DELETE /pets/{id}/categories/{categoryID}", ReqID(s, OperationUpdate, s.UnlinkPetCategory))
 	mux.HandleFunc("GET /pets/{id}/owner", ReqID(s, OperationRead, s.GetPetOwner))
 	mux.HandleFunc("GET /pets/{id}/friends", ReqIDParam(s, OperationList, s.ListPetFriends))
+	mux.HandleFunc("PUT /pets/{id}/friends/{friendID}", ReqID(s, OperationUpdate, s.LinkPetFriend))
+	mux.HandleFunc("DELETE /pets/{id}/friends/{friendID}", ReqID(s, OperationUpdate, s.UnlinkPetFriend))
 	mux.HandleFunc("GET /pets/{id}/followed-by", ReqIDParam(s, OperationList, s.ListPetFollowedBys))
+	mux.HandleFunc("PUT /pets/{id}/followed-by/{followedByID}", ReqID(s, OperationUpdate, s.LinkPetFollowedBy))
+	mux.HandleFunc("DELETE /pets/{id}/followed-by/{followedByID}", ReqID(s, OperationUpdate, s.UnlinkPetFollowedBy))
+	mux.HandleFunc("GET /pets/{id}/vaccinations", ReqIDParam(s, OperationList, s.ListPetVaccinations))
+	mux.HandleFunc("POST /pets/{id}/vaccinations", ReqIDParam(s, OperationCreate, s.CreatePetVaccination))
+	mux.HandleFunc("GET /pets/{id}/vaccinations/{vaccinationID}", ReqID(s, OperationRead, s.GetPetVaccination))
+	mux.HandleFunc("PATCH /pets/{id}/vaccinations/{vaccinationID}", ReqIDParam(s, OperationUpdate, s.UpdatePetVaccination))
+	mux.HandleFunc("DELETE /pets/{id}/vaccinations/{vaccinationID}", ReqID(s, OperationDelete, s.DeletePetVaccination))
 	mux.HandleFunc("POST /pets", ReqParam(s, OperationCreate, s.CreatePet))
+	mux.HandleFunc("POST /pets/bulk", ReqParam(s, OperationBulkCreate, s.CreateBulkPets))
 	mux.HandleFunc("PATCH /pets/{id}", ReqIDParam(s, OperationUpdate, s.UpdatePet))
 	mux.HandleFunc("PUT /pets/{id}", ReqIDParam(s, OperationCreateOrReplace, s.ReplacePet))
+	mux.HandleFunc("PATCH /pets", ReqParam(s, OperationBulkUpdate, s.UpdateBulkPets))
+	mux.HandleFunc("DELETE /pets", Req(s, OperationBulkDelete, s.DeleteBulkPets))
 	mux.HandleFunc("DELETE /pets/{id}", ReqID(s, OperationDelete, s.DeletePet))
 	mux.HandleFunc("GET /posts", ReqParam(s, OperationList, s.ListPosts))
 	mux.HandleFunc("GET /posts/{id}", ReqID(s, OperationRead, s.GetPost))
@@ -620,17 +1630,31 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("POST /posts", ReqParam(s, OperationCreate, s.CreatePost))
 	mux.HandleFunc("PATCH /posts/{id}", ReqIDParam(s, OperationUpdate, s.UpdatePost))
 	mux.HandleFunc("DELETE /posts/{id}", ReqID(s, OperationDelete, s.DeletePost))
+	mux.HandleFunc("GET /posts/by/slug/{slug}", Req(s, OperationRead, s.GetPostBySlug))
+	mux.HandleFunc("PATCH /posts/by/slug/{slug}", ReqParam(s, OperationUpdate, s.UpdatePostBySlug))
+	mux.HandleFunc("DELETE /posts/by/slug/{slug}", Req(s, OperationDelete, s.DeletePostBySlug))
 	mux.HandleFunc("GET /settings", ReqParam(s, OperationList, s.ListSettings))
 	mux.HandleFunc("GET /settings/{id}", ReqID(s, OperationRead, s.GetSetting))
 	mux.HandleFunc("GET /settings/{id}/admins", ReqIDParam(s, OperationList, s.ListSettingAdmins))
+	mux.HandleFunc("PUT /settings/{id}/admins/{adminID}", ReqID(s, OperationUpdate, s.LinkSettingAdmin))
+	mux.HandleFunc("DELETE /settings/{id}/admins/{adminID}", ReqID(s, OperationUpdate, s.UnlinkSettingAdmin))
 	mux.HandleFunc("PATCH /settings/{id}", ReqIDParam(s, OperationUpdate, s.UpdateSetting))
 	mux.HandleFunc("GET /users", ReqParam(s, OperationList, s.ListUsers))
 	mux.HandleFunc("GET /users/{id}", ReqID(s, OperationRead, s.GetUser))
 	mux.HandleFunc("GET /users/{id}/pets", ReqIDParam(s, OperationList, s.ListUserPets))
+	mux.HandleFunc("POST /users/{id}/pets", ReqIDParam(s, OperationCreate, s.CreateUserPet))
+	mux.HandleFunc("PUT /users/{id}/pets/{petID}", ReqID(s, OperationUpdate, s.LinkUserPet))
+	mux.HandleFunc("DELETE /users/{id}/pets/{petID}", ReqID(s, OperationUpdate, s.UnlinkUserPet))
 	mux.HandleFunc("GET /users/{id}/followed-pets", ReqIDParam(s, OperationList, s.ListUserFollowedPets))
+	mux.HandleFunc("PUT /users/{id}/followed-pets/{followedPetID}", ReqID(s, OperationUpdate, s.LinkUserFollowedPet))
+	mux.HandleFunc("DELETE /users/{id}/followed-pets/{followedPetID}", ReqID(s, OperationUpdate, s.UnlinkUserFollowedPet))
 	mux.HandleFunc("GET /users/{id}/friends", ReqIDParam(s, OperationList, s.ListUserFriends))
+	mux.HandleFunc("PUT /users/{id}/friends/{friendID}", ReqID(s, OperationUpdate, s.LinkUserFriend))
+	mux.HandleFunc("DELETE /users/{id}/friends/{friendID}", ReqID(s, OperationUpdate, s.UnlinkUserFriend))
 	mux.HandleFunc("GET /users/{id}/posts", ReqIDParam(s, OperationList, s.ListUserPosts))
+	mux.HandleFunc("POST /users/{id}/posts", ReqIDParam(s, OperationCreate, s.CreateUserPost))
 	mux.HandleFunc("GET /users/{id}/friendships", ReqIDParam(s, OperationList, s.ListUserFriendships))
+	mux.HandleFunc("POST /users/{id}/friendships", ReqIDParam(s, OperationCreate, s.CreateUserFriendship))
 	mux.HandleFunc("POST /users", ReqParam(s, OperationCreate, s.CreateUser))
 	mux.HandleFunc("PATCH /users/{id}", ReqIDParam(s, OperationUpdate, s.UpdateUser))
 	mux.HandleFunc("PUT /users/{id}", ReqIDParam(s, OperationUpsert, s.UpsertUser))
@@ -662,7 +1686,11 @@ func (s *Server) Handler() http.Handler {
 
 // UpsertCategory maps to "PUT /categories/{id}".
 func (s *Server) UpsertCategory(r *http.Request, categoryID int, p *UpsertCategoryParams) (*ent.Category, error) {
-	return p.Exec(r.Context(), categoryID, s.db.Category.Create(), s.db.Category.Query(), s.db.Category.UpdateOneID(categoryID))
+	return withIfMatch(r, s.db, true, func(db *ent.Client) (*ent.Category, error) {
+		return db.Category.Query().Where(category.ID(categoryID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Category, error) {
+		return p.Exec(r.Context(), categoryID, db.Category.Create(), db.Category.Query(), db.Category.UpdateOneID(categoryID))
+	})
 }
 
 // ListFollows maps to "GET /follows".
@@ -670,29 +1698,112 @@ func (s *Server) ListFollows(r *http.Request, p *ListFollowParams) (*PagedRespon
 	return p.Exec(r.Context(), s.db.Follows.Query())
 }
 
+// queryFollowByID returns a query for the Follow identified by the composite ID
+// path parameters of the request (e.g. "/follows/{userID}/{petID}").
+func (s *Server) queryFollowByID(r *http.Request) (*ent.FollowsQuery, error) {
+	userID, err := resolvePathID[uuid.UUID](r, "userID")
+	if err != nil {
+		return nil, err
+	}
+	petID, err := resolvePathID[int](r, "petID")
+	if err != nil {
+		return nil, err
+	}
+	return s.db.Follows.Query().Where(
+		follows.UserID(userID),
+		follows.PetID(petID),
+	), nil
+}
+
+// GetFollow maps to "GET /follows/{userID}/{petID}".
+func (s *Server) GetFollow(r *http.Request) (*ent.Follows, error) {
+	query, err := s.queryFollowByID(r)
+	if err != nil {
+		return nil, err
+	}
+	query = EagerLoadFollow(query)
+	return query.Only(r.Context())
+}
+
 // CreateFollow maps to "POST /follows".
 func (s *Server) CreateFollow(r *http.Request, p *CreateFollowParams) (*ent.Follows, error) {
 	return p.Exec(r.Context(), s.db.Follows.Create(), s.db.Follows.Query())
 }
 
+// UpdateFollow maps to "PATCH /follows/{userID}/{petID}".
+func (s *Server) UpdateFollow(r *http.Request, p *UpdateFollowParams) (*ent.Follows, error) {
+	query, err := s.queryFollowByID(r)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := query.Only(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Follows, error) {
+		return db.Follows.Query().Where(follows.UserID(entity.UserID), follows.PetID(entity.PetID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Follows, error) {
+		return p.Exec(r.Context(), db.Follows.UpdateOne(entity), db.Follows.Query())
+	})
+}
+
+// DeleteFollow maps to "DELETE /follows/{userID}/{petID}".
+func (s *Server) DeleteFollow(r *http.Request) (*struct{}, error) {
+	query, err := s.queryFollowByID(r)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := query.Only(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Follows, error) {
+		return db.Follows.Query().Where(follows.UserID(entity.UserID), follows.PetID(entity.PetID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		if _, err := db.Follows.Delete().Where(follows.UserID(entity.UserID), follows.PetID(entity.PetID)).Exec(r.Context()); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	})
+}
+
 // ListFriendships maps to "GET /friendships".
 func (s *Server) ListFriendships(r *http.Request, p *ListFriendshipParams) (*PagedResponse[ent.Friendship], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.Friendship.Query())
 }
 
 // GetFriendship maps to "GET /friendships/{id}".
 func (s *Server) GetFriendship(r *http.Request, friendshipID int) (*ent.Friendship, error) {
-	return EagerLoadFriendship(s.db.Friendship.Query().Where(friendship.ID(friendshipID))).Only(r.Context())
+	query := EagerLoadFriendship(s.db.Friendship.Query().Where(friendship.ID(friendshipID)))
+	if _, err := SelectFriendship(query, ParseFieldSelection(r.URL.Query())); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // GetFriendshipUser maps to "GET /friendships/{id}/user".
 func (s *Server) GetFriendshipUser(r *http.Request, friendshipID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.Friendship.Query().Where(friendship.ID(friendshipID)).QueryUser()).Only(r.Context())
+	query := EagerLoadUser(s.db.Friendship.Query().Where(friendship.ID(friendshipID)).QueryUser())
+	if _, err := ExpandUser(query, r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	if _, err := SelectUser(query, ParseFieldSelection(r.URL.Query()), r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // GetFriendshipFriend maps to "GET /friendships/{id}/friend".
 func (s *Server) GetFriendshipFriend(r *http.Request, friendshipID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.Friendship.Query().Where(friendship.ID(friendshipID)).QueryFriend()).Only(r.Context())
+	query := EagerLoadUser(s.db.Friendship.Query().Where(friendship.ID(friendshipID)).QueryFriend())
+	if _, err := ExpandUser(query, r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	if _, err := SelectUser(query, ParseFieldSelection(r.URL.Query()), r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // CreateFriendship maps to "POST /friendships".
@@ -702,77 +1813,366 @@ func (s *Server) CreateFriendship(r *http.Request, p *CreateFriendshipParams) (*
 
 // UpdateFriendship maps to "PATCH /friendships/{id}".
 func (s *Server) UpdateFriendship(r *http.Request, friendshipID int, p *UpdateFriendshipParams) (*ent.Friendship, error) {
-	return p.Exec(r.Context(), s.db.Friendship.UpdateOneID(friendshipID), s.db.Friendship.Query())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Friendship, error) {
+		return db.Friendship.Query().Where(friendship.ID(friendshipID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Friendship, error) {
+		return p.Exec(r.Context(), db.Friendship.UpdateOneID(friendshipID), db.Friendship.Query())
+	})
 }
 
 // DeleteFriendship maps to "DELETE /friendships/{id}".
 func (s *Server) DeleteFriendship(r *http.Request, friendshipID int) (*struct{}, error) {
-	return nil, s.db.Friendship.DeleteOneID(friendshipID).Exec(r.Context())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Friendship, error) {
+		return db.Friendship.Query().Where(friendship.ID(friendshipID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Friendship.DeleteOneID(friendshipID).Exec(r.Context())
+	})
 }
 
 // ListPets maps to "GET /pets".
 func (s *Server) ListPets(r *http.Request, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
+	return p.Exec(r.Context(), s.db.Pet.Query())
+}
+
+// SearchPets maps to "POST /pets/search".
+func (s *Server) SearchPets(r *http.Request, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
+	return p.Exec(r.Context(), s.db.Pet.Query())
+}
+
+// CountPets maps to "GET /pets/count", and "HEAD /pets".
+func (s *Server) CountPets(r *http.Request, p *ListPetParams) (*CountResult, error) {
+	return p.ExecCount(r.Context(), s.db.Pet.Query())
+}
+
+// AggregatePets maps to "GET /pets/aggregate".
+func (s *Server) AggregatePets(r *http.Request, p *AggregatePetParams) (*[]PetAggregateRow, error) {
 	return p.Exec(r.Context(), s.db.Pet.Query())
 }
 
+// DistinctPetsType maps to "GET /pets/distinct/type".
+func (s *Server) DistinctPetsType(r *http.Request, p *DistinctPetParams) (*DistinctResponse[pet.Type], error) {
+	return p.ExecType(r.Context(), s.db.Pet.Query())
+}
+
 // GetPet maps to "GET /pets/{id}".
 func (s *Server) GetPet(r *http.Request, petID int) (*ent.Pet, error) {
-	return EagerLoadPet(s.db.Pet.Query().Where(pet.ID(petID))).Only(r.Context())
+	query := EagerLoadPet(s.db.Pet.Query().Where(pet.ID(petID)))
+	if _, err := ExpandPet(query, r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	if _, err := SelectPet(query, ParseFieldSelection(r.URL.Query()), r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // ListPetCategories maps to "GET /pets/{id}/categories".
 func (s *Server) ListPetCategories(r *http.Request, petID int, p *ListCategoryParams) (*PagedResponse[ent.Category], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.Pet.Query().Where(pet.ID(petID)).QueryCategories())
 }
 
+// LinkPetCategory maps to "PUT /pets/{id}/categories/{categoryID}". Linking
+// an already linked Category has no effect.
+func (s *Server) LinkPetCategory(r *http.Request, petID int) (*struct{}, error) {
+	categoryID, err := resolvePathID[int](r, "categoryID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(petID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Category.Query().Where(category.ID(categoryID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Pet.UpdateOneID(petID).AddCategoryIDs(categoryID).Exec(r.Context())
+	})
+}
+
+// UnlinkPetCategory maps to "DELETE /pets/{id}/categories/{categoryID}". The
+// Category itself isn't deleted, and unlinking one which isn't linked has no effect.
+func (s *Server) UnlinkPetCategory(r *http.Request, petID int) (*struct{}, error) {
+	categoryID, err := resolvePathID[int](r, "categoryID")
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Pet.UpdateOneID(petID).RemoveCategoryIDs(categoryID).Exec(r.Context())
+	})
+}
+
 // GetPetOwner maps to "GET /pets/{id}/owner".
 func (s *Server) GetPetOwner(r *http.Request, petID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.Pet.Query().Where(pet.ID(petID)).QueryOwner()).Only(r.Context())
+	query := EagerLoadUser(s.db.Pet.Query().Where(pet.ID(petID)).QueryOwner())
+	if _, err := ExpandUser(query, r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	if _, err := SelectUser(query, ParseFieldSelection(r.URL.Query()), r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // ListPetFriends maps to "GET /pets/{id}/friends".
 func (s *Server) ListPetFriends(r *http.Request, petID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.Pet.Query().Where(pet.ID(petID)).QueryFriends())
 }
 
+// LinkPetFriend maps to "PUT /pets/{id}/friends/{friendID}". Linking
+// an already linked Pet has no effect.
+func (s *Server) LinkPetFriend(r *http.Request, petID int) (*struct{}, error) {
+	friendID, err := resolvePathID[int](r, "friendID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(petID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(friendID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Pet.UpdateOneID(petID).AddFriendIDs(friendID).Exec(r.Context())
+	})
+}
+
+// UnlinkPetFriend maps to "DELETE /pets/{id}/friends/{friendID}". The
+// Pet itself isn't deleted, and unlinking one which isn't linked has no effect.
+func (s *Server) UnlinkPetFriend(r *http.Request, petID int) (*struct{}, error) {
+	friendID, err := resolvePathID[int](r, "friendID")
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Pet.UpdateOneID(petID).RemoveFriendIDs(friendID).Exec(r.Context())
+	})
+}
+
 // ListPetFollowedBys maps to "GET /pets/{id}/followed-by".
 func (s *Server) ListPetFollowedBys(r *http.Request, petID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.Pet.Query().Where(pet.ID(petID)).QueryFollowedBy())
 }
 
+// LinkPetFollowedBy maps to "PUT /pets/{id}/followed-by/{followedByID}". Linking
+// an already linked User has no effect.
+func (s *Server) LinkPetFollowedBy(r *http.Request, petID int) (*struct{}, error) {
+	followedByID, err := resolvePathID[uuid.UUID](r, "followedByID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(petID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.User.Query().Where(user.ID(followedByID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Pet.UpdateOneID(petID).AddFollowedByIDs(followedByID).Exec(r.Context())
+	})
+}
+
+// UnlinkPetFollowedBy maps to "DELETE /pets/{id}/followed-by/{followedByID}". The
+// User itself isn't deleted, and unlinking one which isn't linked has no effect.
+func (s *Server) UnlinkPetFollowedBy(r *http.Request, petID int) (*struct{}, error) {
+	followedByID, err := resolvePathID[uuid.UUID](r, "followedByID")
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Pet.UpdateOneID(petID).RemoveFollowedByIDs(followedByID).Exec(r.Context())
+	})
+}
+
+// ListPetVaccinations maps to "GET /pets/{id}/vaccinations".
+func (s *Server) ListPetVaccinations(r *http.Request, petID int, p *ListVaccinationParams) (*PagedResponse[ent.Vaccination], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
+	return p.Exec(r.Context(), s.db.Pet.Query().Where(pet.ID(petID)).QueryVaccinations())
+}
+
+// CreatePetVaccination maps to "POST /pets/{id}/vaccinations". The created
+// Vaccination is attached to the Pet provided in the path.
+func (s *Server) CreatePetVaccination(r *http.Request, petID int, p *CreatePetVaccinationParams) (*ent.Vaccination, error) {
+	// The Pet is checked and the Vaccination created in the same
+	// transaction, so a Pet deleted in between fails the create as a whole.
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Pet.Query().Where(pet.ID(petID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	builder := p.ApplyInputs(tx.Vaccination.Create())
+	builder.SetPetID(petID)
+
+	result, err := builder.Save(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return EagerLoadVaccination(s.db.Vaccination.Query().Where(vaccination.ID(result.ID))).Only(r.Context())
+}
+
+// GetPetVaccination maps to "GET /pets/{id}/vaccinations/{vaccinationID}".
+func (s *Server) GetPetVaccination(r *http.Request, petID int) (*ent.Vaccination, error) {
+	vaccinationID, err := resolvePathID[int](r, "vaccinationID")
+	if err != nil {
+		return nil, err
+	}
+	query := EagerLoadVaccination(s.db.Pet.Query().Where(pet.ID(petID)).QueryVaccinations().Where(vaccination.ID(vaccinationID)))
+	if _, err := SelectVaccination(query, ParseFieldSelection(r.URL.Query())); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
+}
+
+// UpdatePetVaccination maps to "PATCH /pets/{id}/vaccinations/{vaccinationID}". The Vaccination
+// must belong to the Pet provided in the path.
+func (s *Server) UpdatePetVaccination(r *http.Request, petID int, p *UpdateVaccinationParams) (*ent.Vaccination, error) {
+	vaccinationID, err := resolvePathID[int](r, "vaccinationID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(petID)).QueryVaccinations().Where(vaccination.ID(vaccinationID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Vaccination, error) {
+		return db.Vaccination.Query().Where(vaccination.ID(vaccinationID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Vaccination, error) {
+		return p.Exec(r.Context(), db.Vaccination.UpdateOneID(vaccinationID), db.Vaccination.Query())
+	})
+}
+
+// DeletePetVaccination maps to "DELETE /pets/{id}/vaccinations/{vaccinationID}". The Vaccination
+// must belong to the Pet provided in the path.
+func (s *Server) DeletePetVaccination(r *http.Request, petID int) (*struct{}, error) {
+	vaccinationID, err := resolvePathID[int](r, "vaccinationID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(petID)).QueryVaccinations().Where(vaccination.ID(vaccinationID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Vaccination, error) {
+		return db.Vaccination.Query().Where(vaccination.ID(vaccinationID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Vaccination.DeleteOneID(vaccinationID).Exec(r.Context())
+	})
+}
+
 // CreatePet maps to "POST /pets".
 func (s *Server) CreatePet(r *http.Request, p *CreatePetParams) (*ent.Pet, error) {
 	return p.Exec(r.Context(), s.db.Pet.Create(), s.db.Pet.Query())
 }
 
+// CreateBulkPets maps to "POST /pets/bulk".
+func (s *Server) CreateBulkPets(r *http.Request, p *BulkCreatePetParams) (*[]*ent.Pet, error) {
+	results, err := p.Exec(r.Context(), s.db)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
 // UpdatePet maps to "PATCH /pets/{id}".
 func (s *Server) UpdatePet(r *http.Request, petID int, p *UpdatePetParams) (*ent.Pet, error) {
-	return p.Exec(r.Context(), s.db.Pet.UpdateOneID(petID), s.db.Pet.Query())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Pet, error) {
+		return p.Exec(r.Context(), db.Pet.UpdateOneID(petID), db.Pet.Query())
+	})
 }
 
 // ReplacePet maps to "PUT /pets/{id}".
 func (s *Server) ReplacePet(r *http.Request, petID int, p *ReplacePetParams) (*ent.Pet, error) {
-	return p.Exec(r.Context(), petID, s.db.Pet.Create(), s.db.Pet.Query(), s.db.Pet.UpdateOneID(petID))
+	return withIfMatch(r, s.db, true, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Pet, error) {
+		return p.Exec(r.Context(), petID, db.Pet.Create(), db.Pet.Query(), db.Pet.UpdateOneID(petID))
+	})
+}
+
+// UpdateBulkPets maps to "PATCH /pets".
+func (s *Server) UpdateBulkPets(r *http.Request, u *UpdatePetParams) (*BulkResult, error) {
+	p := &BulkUpdatePetParams{Update: u}
+	if err := BindQuery(r, p); err != nil {
+		return nil, err
+	}
+	return p.Exec(r.Context(), s.db)
+}
+
+// DeleteBulkPets maps to "DELETE /pets".
+func (s *Server) DeleteBulkPets(r *http.Request) (*BulkResult, error) {
+	p := &BulkDeletePetParams{}
+	if err := BindQuery(r, p); err != nil {
+		return nil, err
+	}
+	return p.Exec(r.Context(), s.db)
 }
 
 // DeletePet maps to "DELETE /pets/{id}".
 func (s *Server) DeletePet(r *http.Request, petID int) (*struct{}, error) {
-	return nil, s.db.Pet.DeleteOneID(petID).Exec(r.Context())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Pet, error) {
+		return db.Pet.Query().Where(pet.ID(petID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Pet.DeleteOneID(petID).Exec(r.Context())
+	})
 }
 
 // ListPosts maps to "GET /posts".
-func (s *Server) ListPosts(r *http.Request, p *ListPostParams) (*PagedResponse[ent.Post], error) {
+func (s *Server) ListPosts(r *http.Request, p *ListPostParams) (*CursorPagedResponse[ent.Post], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.Post.Query())
 }
 
 // GetPost maps to "GET /posts/{id}".
 func (s *Server) GetPost(r *http.Request, postID int) (*ent.Post, error) {
-	return EagerLoadPost(s.db.Post.Query().Where(post.ID(postID))).Only(r.Context())
+	query := EagerLoadPost(s.db.Post.Query().Where(post.ID(postID)))
+	if _, err := SelectPost(query, ParseFieldSelection(r.URL.Query())); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // GetPostAuthor maps to "GET /posts/{id}/author".
 func (s *Server) GetPostAuthor(r *http.Request, postID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.Post.Query().Where(post.ID(postID)).QueryAuthor()).Only(r.Context())
+	query := EagerLoadUser(s.db.Post.Query().Where(post.ID(postID)).QueryAuthor())
+	if _, err := ExpandUser(query, r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	if _, err := SelectUser(query, ParseFieldSelection(r.URL.Query()), r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // CreatePost maps to "POST /posts".
@@ -782,69 +2182,375 @@ func (s *Server) CreatePost(r *http.Request, p *CreatePostParams) (*ent.Post, er
 
 // UpdatePost maps to "PATCH /posts/{id}".
 func (s *Server) UpdatePost(r *http.Request, postID int, p *UpdatePostParams) (*ent.Post, error) {
-	return p.Exec(r.Context(), s.db.Post.UpdateOneID(postID), s.db.Post.Query())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Post, error) {
+		return db.Post.Query().Where(post.ID(postID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Post, error) {
+		return p.Exec(r.Context(), db.Post.UpdateOneID(postID), db.Post.Query())
+	})
 }
 
 // DeletePost maps to "DELETE /posts/{id}".
 func (s *Server) DeletePost(r *http.Request, postID int) (*struct{}, error) {
-	return nil, s.db.Post.DeleteOneID(postID).Exec(r.Context())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Post, error) {
+		return db.Post.Query().Where(post.ID(postID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Post.DeleteOneID(postID).Exec(r.Context())
+	})
+}
+
+// GetPostBySlug maps to "GET /posts/by/slug/{slug}".
+func (s *Server) GetPostBySlug(r *http.Request) (*ent.Post, error) {
+	slug, err := resolvePathID[string](r, "slug")
+	if err != nil {
+		return nil, err
+	}
+	query := EagerLoadPost(s.db.Post.Query().Where(post.Slug(slug)))
+	if _, err := SelectPost(query, ParseFieldSelection(r.URL.Query())); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
+}
+
+// UpdatePostBySlug maps to "PATCH /posts/by/slug/{slug}".
+func (s *Server) UpdatePostBySlug(r *http.Request, p *UpdatePostParams) (*ent.Post, error) {
+	slug, err := resolvePathID[string](r, "slug")
+	if err != nil {
+		return nil, err
+	}
+	postID, err := s.db.Post.Query().Where(post.Slug(slug)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Post, error) {
+		return db.Post.Query().Where(post.ID(postID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Post, error) {
+		return p.Exec(r.Context(), db.Post.UpdateOneID(postID), db.Post.Query())
+	})
+}
+
+// DeletePostBySlug maps to "DELETE /posts/by/slug/{slug}".
+func (s *Server) DeletePostBySlug(r *http.Request) (*struct{}, error) {
+	slug, err := resolvePathID[string](r, "slug")
+	if err != nil {
+		return nil, err
+	}
+	postID, err := s.db.Post.Query().Where(post.Slug(slug)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Post, error) {
+		return db.Post.Query().Where(post.ID(postID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Post.DeleteOneID(postID).Exec(r.Context())
+	})
 }
 
 // ListSettings maps to "GET /settings".
 func (s *Server) ListSettings(r *http.Request, p *ListSettingParams) (*PagedResponse[ent.Settings], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.Settings.Query())
 }
 
 // GetSetting maps to "GET /settings/{id}".
 func (s *Server) GetSetting(r *http.Request, settingID int) (*ent.Settings, error) {
-	return EagerLoadSetting(s.db.Settings.Query().Where(settings.ID(settingID))).Only(r.Context())
+	query := EagerLoadSetting(s.db.Settings.Query().Where(settings.ID(settingID)))
+	if _, err := SelectSetting(query, ParseFieldSelection(r.URL.Query())); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // ListSettingAdmins maps to "GET /settings/{id}/admins".
 func (s *Server) ListSettingAdmins(r *http.Request, settingID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.Settings.Query().Where(settings.ID(settingID)).QueryAdmins())
 }
 
+// LinkSettingAdmin maps to "PUT /settings/{id}/admins/{adminID}". Linking
+// an already linked User has no effect.
+func (s *Server) LinkSettingAdmin(r *http.Request, settingID int) (*struct{}, error) {
+	adminID, err := resolvePathID[uuid.UUID](r, "adminID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Settings.Query().Where(settings.ID(settingID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.User.Query().Where(user.ID(adminID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Settings, error) {
+		return db.Settings.Query().Where(settings.ID(settingID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Settings.UpdateOneID(settingID).AddAdminIDs(adminID).Exec(r.Context())
+	})
+}
+
+// UnlinkSettingAdmin maps to "DELETE /settings/{id}/admins/{adminID}". The
+// User itself isn't deleted, and unlinking one which isn't linked has no effect.
+func (s *Server) UnlinkSettingAdmin(r *http.Request, settingID int) (*struct{}, error) {
+	adminID, err := resolvePathID[uuid.UUID](r, "adminID")
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Settings, error) {
+		return db.Settings.Query().Where(settings.ID(settingID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Settings.UpdateOneID(settingID).RemoveAdminIDs(adminID).Exec(r.Context())
+	})
+}
+
 // UpdateSetting maps to "PATCH /settings/{id}".
 func (s *Server) UpdateSetting(r *http.Request, settingID int, p *UpdateSettingParams) (*ent.Settings, error) {
-	return p.Exec(r.Context(), s.db.Settings.UpdateOneID(settingID), s.db.Settings.Query())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Settings, error) {
+		return db.Settings.Query().Where(settings.ID(settingID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Settings, error) {
+		return p.Exec(r.Context(), db.Settings.UpdateOneID(settingID), db.Settings.Query())
+	})
 }
 
 // ListUsers maps to "GET /users".
 func (s *Server) ListUsers(r *http.Request, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.User.Query())
 }
 
 // GetUser maps to "GET /users/{id}".
 func (s *Server) GetUser(r *http.Request, userID uuid.UUID) (*ent.User, error) {
-	return EagerLoadUser(s.db.User.Query().Where(user.ID(userID))).Only(r.Context())
+	query := EagerLoadUser(s.db.User.Query().Where(user.ID(userID)))
+	if _, err := ExpandUser(query, r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	if _, err := SelectUser(query, ParseFieldSelection(r.URL.Query()), r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
 }
 
 // ListUserPets maps to "GET /users/{id}/pets".
 func (s *Server) ListUserPets(r *http.Request, userID uuid.UUID, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryPets())
 }
 
+// CreateUserPet maps to "POST /users/{id}/pets". The created
+// Pet is attached to the User provided in the path.
+func (s *Server) CreateUserPet(r *http.Request, userID uuid.UUID, p *CreateUserPetParams) (*ent.Pet, error) {
+	// The User is checked and the Pet created in the same
+	// transaction, so a User deleted in between fails the create as a whole.
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.User.Query().Where(user.ID(userID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	builder := p.ApplyInputs(tx.Pet.Create())
+	builder.SetOwnerID(userID)
+
+	result, err := builder.Save(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return EagerLoadPet(s.db.Pet.Query().Where(pet.ID(result.ID))).Only(r.Context())
+}
+
+// LinkUserPet maps to "PUT /users/{id}/pets/{petID}". Linking
+// an already linked Pet has no effect.
+func (s *Server) LinkUserPet(r *http.Request, userID uuid.UUID) (*struct{}, error) {
+	petID, err := resolvePathID[int](r, "petID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.User.Query().Where(user.ID(userID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(petID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.User.UpdateOneID(userID).AddPetIDs(petID).Exec(r.Context())
+	})
+}
+
+// UnlinkUserPet maps to "DELETE /users/{id}/pets/{petID}". The
+// Pet itself isn't deleted, and unlinking one which isn't linked has no effect.
+func (s *Server) UnlinkUserPet(r *http.Request, userID uuid.UUID) (*struct{}, error) {
+	petID, err := resolvePathID[int](r, "petID")
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.User.UpdateOneID(userID).RemovePetIDs(petID).Exec(r.Context())
+	})
+}
+
 // ListUserFollowedPets maps to "GET /users/{id}/followed-pets".
 func (s *Server) ListUserFollowedPets(r *http.Request, userID uuid.UUID, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryFollowedPets())
 }
 
+// LinkUserFollowedPet maps to "PUT /users/{id}/followed-pets/{followedPetID}". Linking
+// an already linked Pet has no effect.
+func (s *Server) LinkUserFollowedPet(r *http.Request, userID uuid.UUID) (*struct{}, error) {
+	followedPetID, err := resolvePathID[int](r, "followedPetID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.User.Query().Where(user.ID(userID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.Pet.Query().Where(pet.ID(followedPetID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.User.UpdateOneID(userID).AddFollowedPetIDs(followedPetID).Exec(r.Context())
+	})
+}
+
+// UnlinkUserFollowedPet maps to "DELETE /users/{id}/followed-pets/{followedPetID}". The
+// Pet itself isn't deleted, and unlinking one which isn't linked has no effect.
+func (s *Server) UnlinkUserFollowedPet(r *http.Request, userID uuid.UUID) (*struct{}, error) {
+	followedPetID, err := resolvePathID[int](r, "followedPetID")
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.User.UpdateOneID(userID).RemoveFollowedPetIDs(followedPetID).Exec(r.Context())
+	})
+}
+
 // ListUserFriends maps to "GET /users/{id}/friends".
 func (s *Server) ListUserFriends(r *http.Request, userID uuid.UUID, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryFriends())
 }
 
+// LinkUserFriend maps to "PUT /users/{id}/friends/{friendID}". Linking
+// an already linked User has no effect.
+func (s *Server) LinkUserFriend(r *http.Request, userID uuid.UUID) (*struct{}, error) {
+	friendID, err := resolvePathID[uuid.UUID](r, "friendID")
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.User.Query().Where(user.ID(userID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.User.Query().Where(user.ID(friendID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.User.UpdateOneID(userID).AddFriendIDs(friendID).Exec(r.Context())
+	})
+}
+
+// UnlinkUserFriend maps to "DELETE /users/{id}/friends/{friendID}". The
+// User itself isn't deleted, and unlinking one which isn't linked has no effect.
+func (s *Server) UnlinkUserFriend(r *http.Request, userID uuid.UUID) (*struct{}, error) {
+	friendID, err := resolvePathID[uuid.UUID](r, "friendID")
+	if err != nil {
+		return nil, err
+	}
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.User.UpdateOneID(userID).RemoveFriendIDs(friendID).Exec(r.Context())
+	})
+}
+
 // ListUserPosts maps to "GET /users/{id}/posts".
-func (s *Server) ListUserPosts(r *http.Request, userID uuid.UUID, p *ListPostParams) (*PagedResponse[ent.Post], error) {
+func (s *Server) ListUserPosts(r *http.Request, userID uuid.UUID, p *ListPostParams) (*CursorPagedResponse[ent.Post], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryPosts())
 }
 
+// CreateUserPost maps to "POST /users/{id}/posts". The created
+// Post is attached to the User provided in the path.
+func (s *Server) CreateUserPost(r *http.Request, userID uuid.UUID, p *CreateUserPostParams) (*ent.Post, error) {
+	// The User is checked and the Post created in the same
+	// transaction, so a User deleted in between fails the create as a whole.
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.User.Query().Where(user.ID(userID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	builder := p.ApplyInputs(tx.Post.Create())
+	builder.SetAuthorID(userID)
+
+	result, err := builder.Save(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return EagerLoadPost(s.db.Post.Query().Where(post.ID(result.ID))).Only(r.Context())
+}
+
 // ListUserFriendships maps to "GET /users/{id}/friendships".
 func (s *Server) ListUserFriendships(r *http.Request, userID uuid.UUID, p *ListFriendshipParams) (*PagedResponse[ent.Friendship], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
 	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryFriendships())
 }
 
+// CreateUserFriendship maps to "POST /users/{id}/friendships". The created
+// Friendship is attached to the User provided in the path.
+func (s *Server) CreateUserFriendship(r *http.Request, userID uuid.UUID, p *CreateUserFriendshipParams) (*ent.Friendship, error) {
+	// The User is checked and the Friendship created in the same
+	// transaction, so a User deleted in between fails the create as a whole.
+	tx, err := s.db.Tx(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.User.Query().Where(user.ID(userID)).OnlyID(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	builder := p.ApplyInputs(tx.Friendship.Create())
+	builder.SetUserID(userID)
+
+	result, err := builder.Save(r.Context())
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return EagerLoadFriendship(s.db.Friendship.Query().Where(friendship.ID(result.ID))).Only(r.Context())
+}
+
 // CreateUser maps to "POST /users".
 func (s *Server) CreateUser(r *http.Request, p *CreateUserParams) (*ent.User, error) {
 	return p.Exec(r.Context(), s.db.User.Create(), s.db.User.Query())
@@ -852,15 +2558,77 @@ func (s *Server) CreateUser(r *http.Request, p *CreateUserParams) (*ent.User, er
 
 // UpdateUser maps to "PATCH /users/{id}".
 func (s *Server) UpdateUser(r *http.Request, userID uuid.UUID, p *UpdateUserParams) (*ent.User, error) {
-	return p.Exec(r.Context(), s.db.User.UpdateOneID(userID), s.db.User.Query())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.User, error) {
+		return p.Exec(r.Context(), db.User.UpdateOneID(userID), db.User.Query())
+	})
 }
 
 // UpsertUser maps to "PUT /users/{id}".
 func (s *Server) UpsertUser(r *http.Request, userID uuid.UUID, p *UpsertUserParams) (*ent.User, error) {
-	return p.Exec(r.Context(), userID, s.db.User.Create(), s.db.User.Query(), s.db.User.UpdateOneID(userID))
+	return withIfMatch(r, s.db, true, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.User, error) {
+		return p.Exec(r.Context(), userID, db.User.Create(), db.User.Query(), db.User.UpdateOneID(userID))
+	})
 }
 
 // DeleteUser maps to "DELETE /users/{id}".
 func (s *Server) DeleteUser(r *http.Request, userID uuid.UUID) (*struct{}, error) {
-	return nil, s.db.User.DeleteOneID(userID).Exec(r.Context())
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.User, error) {
+		return db.User.Query().Where(user.ID(userID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.User.DeleteOneID(userID).Exec(r.Context())
+	})
+}
+
+// ListVaccinations maps to "GET /vaccinations".
+func (s *Server) ListVaccinations(r *http.Request, p *ListVaccinationParams) (*PagedResponse[ent.Vaccination], error) {
+	p.Fields = ParseFieldSelection(r.URL.Query())
+	return p.Exec(r.Context(), s.db.Vaccination.Query())
+}
+
+// GetVaccination maps to "GET /vaccinations/{id}".
+func (s *Server) GetVaccination(r *http.Request, vaccinationID int) (*ent.Vaccination, error) {
+	query := EagerLoadVaccination(s.db.Vaccination.Query().Where(vaccination.ID(vaccinationID)))
+	if _, err := SelectVaccination(query, ParseFieldSelection(r.URL.Query())); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
+}
+
+// GetVaccinationPet maps to "GET /vaccinations/{id}/pet".
+func (s *Server) GetVaccinationPet(r *http.Request, vaccinationID int) (*ent.Pet, error) {
+	query := EagerLoadPet(s.db.Vaccination.Query().Where(vaccination.ID(vaccinationID)).QueryPet())
+	if _, err := ExpandPet(query, r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	if _, err := SelectPet(query, ParseFieldSelection(r.URL.Query()), r.URL.Query()["expand"]); err != nil {
+		return nil, err
+	}
+	return query.Only(r.Context())
+}
+
+// CreateVaccination maps to "POST /vaccinations".
+func (s *Server) CreateVaccination(r *http.Request, p *CreateVaccinationParams) (*ent.Vaccination, error) {
+	return p.Exec(r.Context(), s.db.Vaccination.Create(), s.db.Vaccination.Query())
+}
+
+// UpdateVaccination maps to "PATCH /vaccinations/{id}".
+func (s *Server) UpdateVaccination(r *http.Request, vaccinationID int, p *UpdateVaccinationParams) (*ent.Vaccination, error) {
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Vaccination, error) {
+		return db.Vaccination.Query().Where(vaccination.ID(vaccinationID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*ent.Vaccination, error) {
+		return p.Exec(r.Context(), db.Vaccination.UpdateOneID(vaccinationID), db.Vaccination.Query())
+	})
+}
+
+// DeleteVaccination maps to "DELETE /vaccinations/{id}".
+func (s *Server) DeleteVaccination(r *http.Request, vaccinationID int) (*struct{}, error) {
+	return withIfMatch(r, s.db, false, func(db *ent.Client) (*ent.Vaccination, error) {
+		return db.Vaccination.Query().Where(vaccination.ID(vaccinationID), forUpdate).Only(r.Context())
+	}, func(db *ent.Client) (*struct{}, error) {
+		return nil, db.Vaccination.DeleteOneID(vaccinationID).Exec(r.Context())
+	})
 }
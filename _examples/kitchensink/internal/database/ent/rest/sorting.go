@@ -3,10 +3,13 @@
 package rest
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/category"
@@ -16,41 +19,117 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/post"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 )
 
 type Sorted struct {
-	// Field is the field to sort by. Can be a standard field name (e.g. "name"), or a custom field
-	// name (e.g. "pets.age.sum"). If no field is provided, the default field will be used.
-	Field *string `json:"sort" form:"sort,omitempty"`
-
-	// Order is the order to sort by. Can be either "asc" or "desc". If no order is provided, the
-	// default order will be used.
+	// Sort are the fields to sort by, in order of priority. Can be a standard field name (e.g.
+	// "name"), or a custom field name (e.g. "pets.age.sum"), and can be prefixed with "-" to sort
+	// in descending order (e.g. "-created_at"). Can be provided as comma-separated values, multiple
+	// times, or both. When provided in a JSON body, can be either a string or an array of strings.
+	// If no fields are provided, the default field will be used.
+	Sort sortFields `json:"sort" form:"sort,omitempty"`
+
+	// Order is the order to sort by, for fields which aren't prefixed with "-". Can be either "asc"
+	// or "desc". If no order is provided, the default order will be used.
 	Order *orderDirection `json:"order" form:"order,omitempty"`
+
+	// Nulls is where NULL values are placed, for fields which can be NULL. Can be either "first"
+	// or "last". If not provided, the default of the field will be used (if any), otherwise the
+	// database default.
+	Nulls *nullsOrder `json:"nulls" form:"nulls,omitempty"`
+
+	terms []sortTerm // Validated sort fields and their order.
+}
+
+// sortFields are the (unvalidated) fields to sort by. In addition to an array of strings,
+// a single string (e.g. "-age,name") is accepted when decoding from JSON.
+type sortFields []string
+
+// UnmarshalJSON implements [json.Unmarshaler], accepting either a string or an array of
+// strings.
+func (s *sortFields) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = nil
+		return nil
+	}
+
+	var field string
+	if err := json.Unmarshal(data, &field); err == nil {
+		*s = sortFields{field}
+		return nil
+	}
+
+	var fields []string
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return errors.New("sort must be a string or an array of strings")
+	}
+	*s = fields
+	return nil
+}
+
+// sortTerm is a single (validated) field to sort by, and its order.
+type sortTerm struct {
+	field string
+	order orderDirection
+	nulls nullsOrder
 }
 
 // Validate validates the sorting fields and applies any necessary defaults.
 func (s *Sorted) Validate(cfg *SortConfig) error {
-	if s.Field == nil {
+	s.terms = nil
+
+	fields := splitQueryValues(s.Sort)
+	if len(fields) == 0 {
 		if cfg.DefaultField == "" {
 			return nil
 		}
-		s.Field = &cfg.DefaultField
+		fields = []string{cfg.DefaultField}
 	}
 	if s.Order == nil {
 		s.Order = &cfg.DefaultOrder
 	}
 
-	if !slices.Contains(cfg.Fields, *s.Field) {
-		return &ErrBadRequest{Err: fmt.Errorf("invalid sort field: %s", *s.Field)}
-	}
-
 	if !slices.Contains(OrderDirections, *s.Order) {
 		return &ErrBadRequest{Err: fmt.Errorf("invalid order: %s", *s.Order)}
 	}
 
+	if s.Nulls != nil && !slices.Contains(NullsOrders, *s.Nulls) {
+		return &ErrBadRequest{Err: fmt.Errorf("invalid nulls order: %s", *s.Nulls)}
+	}
+
+	for _, field := range fields {
+		term := sortTerm{field: field, order: *s.Order}
+		if v, ok := strings.CutPrefix(field, "-"); ok {
+			term = sortTerm{field: v, order: orderDesc}
+		}
+
+		if !slices.Contains(cfg.Fields, term.field) {
+			return &ErrBadRequest{Err: fmt.Errorf("invalid sort field: %s", field)}
+		}
+		if s.sortedBy(term.field) {
+			return &ErrBadRequest{Err: fmt.Errorf("duplicate sort field: %s", term.field)}
+		}
+		if nulls, ok := cfg.NullableFields[term.field]; ok {
+			term.nulls = nulls
+			if s.Nulls != nil {
+				term.nulls = *s.Nulls
+			}
+		}
+		s.terms = append(s.terms, term)
+	}
+
+	if len(s.terms) > 1 && s.sortedBy("random") {
+		return &ErrBadRequest{Err: errors.New("random sorting cannot be combined with other sort fields")}
+	}
 	return nil
 }
 
+// sortedBy returns true if the provided field is one of the (validated) sort fields.
+func (s *Sorted) sortedBy(field string) bool {
+	return slices.ContainsFunc(s.terms, func(t sortTerm) bool { return t.field == field })
+}
+
 // withOrderTerm returns the OrderTermOption (asc/desc) based on the provided order string.
 func withOrderTerm(order orderDirection) sql.OrderTermOption {
 	if order == orderAsc {
@@ -59,27 +138,58 @@ func withOrderTerm(order orderDirection) sql.OrderTermOption {
 	return sql.OrderDesc()
 }
 
-func withFieldSelector(field string, order orderDirection) func(*sql.Selector) {
-	if order == orderAsc {
-		return ent.Asc(field)
+// withFieldSelector returns a selector which orders by the provided field. If nulls is
+// provided, NULL values are placed first or last. This is emulated on MySQL, which doesn't
+// support "NULLS FIRST" and "NULLS LAST".
+func withFieldSelector(field string, order orderDirection, nulls nullsOrder) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		opts := []sql.OrderTermOption{withOrderTerm(order)}
+
+		switch {
+		case nulls != "" && s.Dialect() == dialect.MySQL:
+			// "<field> IS NULL" is 1 for NULL values, so sorting on it descending places them first.
+			s.OrderExprFunc(func(b *sql.Builder) {
+				b.WriteString(s.C(field)).WriteString(" IS NULL")
+				if nulls == nullsFirst {
+					b.WriteString(" DESC")
+				}
+			})
+		case nulls == nullsFirst:
+			opts = append(opts, sql.OrderNullsFirst())
+		case nulls == nullsLast:
+			opts = append(opts, sql.OrderNullsLast())
+		}
+
+		sql.OrderByField(field, opts...).ToFunc()(s)
 	}
-	return ent.Desc(field)
 }
 
 type SortConfig struct {
 	Fields       []string
 	DefaultField string
 	DefaultOrder orderDirection
+
+	// NullableFields are the sortable fields which can contain NULL values, mapped to where
+	// NULL values are placed by default (empty if the database default is used).
+	NullableFields map[string]nullsOrder
 }
 
 type orderDirection string
 
+type nullsOrder string
+
 var (
 	orderAsc  orderDirection = "asc"
 	orderDesc orderDirection = "desc"
 
 	// OrderDirections are the allowed order directions that can be provided.
 	OrderDirections = []orderDirection{orderAsc, orderDesc}
+
+	nullsFirst nullsOrder = "first"
+	nullsLast  nullsOrder = "last"
+
+	// NullsOrders are the allowed placements of NULL values that can be provided.
+	NullsOrders = []nullsOrder{nullsFirst, nullsLast}
 	// CategorySortConfig defines the default sort configuration for Category.
 	CategorySortConfig = &SortConfig{
 		Fields: []string{
@@ -143,6 +253,7 @@ var (
 			"owner.name",
 			"owner.updated_at",
 			"random",
+			"vaccinations.count",
 		},
 		DefaultField: "name",
 		DefaultOrder: "asc",
@@ -150,14 +261,8 @@ var (
 	// PostSortConfig defines the default sort configuration for Post.
 	PostSortConfig = &SortConfig{
 		Fields: []string{
-			"author.created_at",
-			"author.email",
-			"author.id",
-			"author.name",
-			"author.updated_at",
 			"created_at",
 			"id",
-			"random",
 			"updated_at",
 		},
 		DefaultField: "id",
@@ -195,6 +300,21 @@ var (
 		},
 		DefaultField: "name",
 		DefaultOrder: "asc",
+		NullableFields: map[string]nullsOrder{
+			"email": "last",
+		},
+	}
+	// VaccinationSortConfig defines the default sort configuration for Vaccination.
+	VaccinationSortConfig = &SortConfig{
+		Fields: []string{
+			"id",
+			"pet.age",
+			"pet.id",
+			"pet.name",
+			"random",
+		},
+		DefaultField: "id",
+		DefaultOrder: "asc",
 	}
 )
 
@@ -219,9 +339,9 @@ func isSpecializedSort(parts []string) (isCount, isSum bool) {
 	return isCount, isSum
 }
 
-// applySortingCategory applies sorting to the query based on the provided sort and
-// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
-func applySortingCategory(query *ent.CategoryQuery, field string, order orderDirection) *ent.CategoryQuery {
+// applySortingCategory applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingCategory(query *ent.CategoryQuery, field string, order orderDirection, nulls nullsOrder) *ent.CategoryQuery {
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -242,12 +362,12 @@ func applySortingCategory(query *ent.CategoryQuery, field string, order orderDir
 	if field == "random" {
 		return query.Order(sql.OrderByRand())
 	}
-	return query.Order(withFieldSelector(field, order))
+	return query.Order(withFieldSelector(field, order, nulls))
 }
 
-// applySortingFollow applies sorting to the query based on the provided sort and
-// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
-func applySortingFollow(query *ent.FollowsQuery, field string, order orderDirection) *ent.FollowsQuery {
+// applySortingFollow applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingFollow(query *ent.FollowsQuery, field string, order orderDirection, nulls nullsOrder) *ent.FollowsQuery {
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -261,12 +381,12 @@ func applySortingFollow(query *ent.FollowsQuery, field string, order orderDirect
 	if field == "random" {
 		return query.Order(sql.OrderByRand())
 	}
-	return query.Order(withFieldSelector(field, order))
+	return query.Order(withFieldSelector(field, order, nulls))
 }
 
-// applySortingFriendship applies sorting to the query based on the provided sort and
-// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
-func applySortingFriendship(query *ent.FriendshipQuery, field string, order orderDirection) *ent.FriendshipQuery {
+// applySortingFriendship applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingFriendship(query *ent.FriendshipQuery, field string, order orderDirection, nulls nullsOrder) *ent.FriendshipQuery {
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -280,12 +400,12 @@ func applySortingFriendship(query *ent.FriendshipQuery, field string, order orde
 	if field == "random" {
 		return query.Order(sql.OrderByRand())
 	}
-	return query.Order(withFieldSelector(field, order))
+	return query.Order(withFieldSelector(field, order, nulls))
 }
 
-// applySortingPet applies sorting to the query based on the provided sort and
-// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
-func applySortingPet(query *ent.PetQuery, field string, order orderDirection) *ent.PetQuery {
+// applySortingPet applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingPet(query *ent.PetQuery, field string, order orderDirection, nulls nullsOrder) *ent.PetQuery {
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -321,6 +441,15 @@ func applySortingPet(query *ent.PetQuery, field string, order orderDirection) *e
 			default:
 				return query.Order(pet.ByFollowedBy(sql.OrderByField(parts[1], dir)))
 			}
+		case pet.EdgeVaccinations:
+			switch {
+			case isCount:
+				return query.Order(pet.ByVaccinationsCount(dir))
+			case isSum:
+				return query.Order(pet.ByVaccinations(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(pet.ByVaccinations(sql.OrderByField(parts[1], dir)))
+			}
 		case pet.EdgeFollowing:
 			switch {
 			case isCount:
@@ -335,12 +464,12 @@ func applySortingPet(query *ent.PetQuery, field string, order orderDirection) *e
 	if field == "random" {
 		return query.Order(sql.OrderByRand())
 	}
-	return query.Order(withFieldSelector(field, order))
+	return query.Order(withFieldSelector(field, order, nulls))
 }
 
-// applySortingPost applies sorting to the query based on the provided sort and
-// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
-func applySortingPost(query *ent.PostQuery, field string, order orderDirection) *ent.PostQuery {
+// applySortingPost applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingPost(query *ent.PostQuery, field string, order orderDirection, nulls nullsOrder) *ent.PostQuery {
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -352,12 +481,12 @@ func applySortingPost(query *ent.PostQuery, field string, order orderDirection)
 	if field == "random" {
 		return query.Order(sql.OrderByRand())
 	}
-	return query.Order(withFieldSelector(field, order))
+	return query.Order(withFieldSelector(field, order, nulls))
 }
 
-// applySortingSetting applies sorting to the query based on the provided sort and
-// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
-func applySortingSetting(query *ent.SettingsQuery, field string, order orderDirection) *ent.SettingsQuery {
+// applySortingSetting applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingSetting(query *ent.SettingsQuery, field string, order orderDirection, nulls nullsOrder) *ent.SettingsQuery {
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -378,12 +507,12 @@ func applySortingSetting(query *ent.SettingsQuery, field string, order orderDire
 	if field == "random" {
 		return query.Order(sql.OrderByRand())
 	}
-	return query.Order(withFieldSelector(field, order))
+	return query.Order(withFieldSelector(field, order, nulls))
 }
 
-// applySortingUser applies sorting to the query based on the provided sort and
-// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
-func applySortingUser(query *ent.UserQuery, field string, order orderDirection) *ent.UserQuery {
+// applySortingUser applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingUser(query *ent.UserQuery, field string, order orderDirection, nulls nullsOrder) *ent.UserQuery {
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -449,5 +578,22 @@ func applySortingUser(query *ent.UserQuery, field string, order orderDirection)
 	if field == "random" {
 		return query.Order(sql.OrderByRand())
 	}
-	return query.Order(withFieldSelector(field, order))
+	return query.Order(withFieldSelector(field, order, nulls))
+}
+
+// applySortingVaccination applies sorting to the query based on the provided sort, order
+// and nulls fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingVaccination(query *ent.VaccinationQuery, field string, order orderDirection, nulls nullsOrder) *ent.VaccinationQuery {
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		switch parts[0] {
+		case vaccination.EdgePet:
+			return query.Order(vaccination.ByPetField(parts[1], dir))
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order, nulls))
 }
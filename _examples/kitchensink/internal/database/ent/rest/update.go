@@ -10,11 +10,13 @@ import (
 	uuid "github.com/google/uuid"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/follows"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/friendship"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/post"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 	schema "github.com/lrstanley/entrest/_examples/kitchensink/internal/database/schema"
 )
 
@@ -24,6 +26,26 @@ type UpdateCategoryParams struct {
 	Nillable Option[*string]  `json:"nillable"`
 	Strings  Option[[]string] `json:"strings,omitempty"`
 	Ints     Option[[]int]    `json:"ints,omitempty"`
+
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdateCategoryParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided Category in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdateCategoryParams) patchDocument(ctx context.Context, e *ent.Category, builder *ent.CategoryUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	target.doc["name"] = e.Name
+	target.doc["nillable"] = e.Nillable
+	target.doc["strings"] = e.Strings
+	target.clear["strings"] = func() { builder.ClearStrings() }
+	target.doc["ints"] = e.Ints
+	target.clear["ints"] = func() { builder.ClearInts() }
+	return target, nil
 }
 
 func (u *UpdateCategoryParams) ApplyInputs(builder *ent.CategoryUpdateOne) *ent.CategoryUpdateOne {
@@ -47,8 +69,26 @@ func (u *UpdateCategoryParams) ApplyInputs(builder *ent.CategoryUpdateOne) *ent.
 
 // Exec wraps all logic (mapping all provided values to the build), updates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
-// loaded edges.
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
 func (c *UpdateCategoryParams) Exec(ctx context.Context, builder *ent.CategoryUpdateOne, query *ent.CategoryQuery) (*ent.Category, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		id, _ := builder.Mutation().ID()
+		current, err := query.Clone().Where(category.ID(id)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.ApplyInputs(builder).Save(ctx)
 	if err != nil {
 		return nil, err
@@ -56,11 +96,85 @@ func (c *UpdateCategoryParams) Exec(ctx context.Context, builder *ent.CategoryUp
 	return EagerLoadCategory(query.Where(category.ID(result.ID))).Only(ctx)
 }
 
+// UpdateFollowParams defines parameters for updating a Follow via a PATCH request.
+type UpdateFollowParams struct {
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdateFollowParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided Follow in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdateFollowParams) patchDocument(ctx context.Context, e *ent.Follows, builder *ent.FollowsUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	return target, nil
+}
+
+func (u *UpdateFollowParams) ApplyInputs(builder *ent.FollowsUpdateOne) *ent.FollowsUpdateOne {
+	return builder
+}
+
+// Exec wraps all logic (mapping all provided values to the build), updates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
+func (c *UpdateFollowParams) Exec(ctx context.Context, builder *ent.FollowsUpdateOne, query *ent.FollowsQuery) (*ent.Follows, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		userID, _ := builder.Mutation().UserID()
+		petID, _ := builder.Mutation().PetID()
+		current, err := query.Clone().Where(
+			follows.UserID(userID),
+			follows.PetID(petID),
+		).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadFollow(query.Where(
+		follows.UserID(result.UserID),
+		follows.PetID(result.PetID),
+	)).Only(ctx)
+}
+
 // UpdateFriendshipParams defines parameters for updating a Friendship via a PATCH request.
 type UpdateFriendshipParams struct {
 	CreatedAt Option[time.Time] `json:"created_at"`
 	UserID    Option[uuid.UUID] `json:"user_id"`
 	FriendID  Option[uuid.UUID] `json:"friend_id"`
+
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdateFriendshipParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided Friendship in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdateFriendshipParams) patchDocument(ctx context.Context, e *ent.Friendship, builder *ent.FriendshipUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	target.doc["created_at"] = e.CreatedAt
+	target.doc["user_id"] = e.UserID
+	target.doc["friend_id"] = e.FriendID
+	return target, nil
 }
 
 func (u *UpdateFriendshipParams) ApplyInputs(builder *ent.FriendshipUpdateOne) *ent.FriendshipUpdateOne {
@@ -79,8 +193,26 @@ func (u *UpdateFriendshipParams) ApplyInputs(builder *ent.FriendshipUpdateOne) *
 
 // Exec wraps all logic (mapping all provided values to the build), updates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
-// loaded edges.
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
 func (c *UpdateFriendshipParams) Exec(ctx context.Context, builder *ent.FriendshipUpdateOne, query *ent.FriendshipQuery) (*ent.Friendship, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		id, _ := builder.Mutation().ID()
+		current, err := query.Clone().Where(friendship.ID(id)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.ApplyInputs(builder).Save(ctx)
 	if err != nil {
 		return nil, err
@@ -112,6 +244,77 @@ type UpdatePetParams struct {
 	AddFollowedBy Option[[]uuid.UUID] `json:"add_followed_by,omitempty"`
 	// Users that this pet is followed by.
 	RemoveFollowedBy Option[[]uuid.UUID] `json:"remove_followed_by,omitempty"`
+	// Vaccinations the pet has received.
+	AddVaccinations Option[[]int] `json:"add_vaccinations,omitempty"`
+	// Vaccinations the pet has received.
+	RemoveVaccinations Option[[]int] `json:"remove_vaccinations,omitempty"`
+
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdatePetParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided Pet in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdatePetParams) patchDocument(ctx context.Context, e *ent.Pet, builder *ent.PetUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	target.doc["name"] = e.Name
+	target.doc["nicknames"] = e.Nicknames
+	target.clear["nicknames"] = func() { builder.ClearNicknames() }
+	target.doc["description"] = e.Description
+	target.clear["description"] = func() { builder.ClearDescription() }
+	target.doc["age"] = e.Age
+	target.doc["type"] = e.Type
+	categoriesIDs, err := e.QueryCategories().Order(ent.Asc(category.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if categoriesIDs == nil {
+		categoriesIDs = []int{}
+	}
+	target.doc["categories"] = categoriesIDs
+	target.edges = append(target.edges, "categories")
+	ownerID, err := e.QueryOwner().OnlyID(ctx)
+	switch {
+	case err == nil:
+		target.doc["owner"] = ownerID
+	case ent.IsNotFound(err):
+		target.doc["owner"] = nil
+	default:
+		return nil, err
+	}
+	target.clear["owner"] = func() { builder.ClearOwner() }
+	friendsIDs, err := e.QueryFriends().Order(ent.Asc(pet.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if friendsIDs == nil {
+		friendsIDs = []int{}
+	}
+	target.doc["friends"] = friendsIDs
+	target.edges = append(target.edges, "friends")
+	followedByIDs, err := e.QueryFollowedBy().Order(ent.Asc(user.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if followedByIDs == nil {
+		followedByIDs = []uuid.UUID{}
+	}
+	target.doc["followed_by"] = followedByIDs
+	target.edges = append(target.edges, "followed_by")
+	vaccinationsIDs, err := e.QueryVaccinations().Order(ent.Asc(vaccination.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if vaccinationsIDs == nil {
+		vaccinationsIDs = []int{}
+	}
+	target.doc["vaccinations"] = vaccinationsIDs
+	target.edges = append(target.edges, "vaccinations")
+	return target, nil
 }
 
 func (u *UpdatePetParams) ApplyInputs(builder *ent.PetUpdateOne) *ent.PetUpdateOne {
@@ -167,13 +370,101 @@ func (u *UpdatePetParams) ApplyInputs(builder *ent.PetUpdateOne) *ent.PetUpdateO
 	if v, ok := u.RemoveFollowedBy.Get(); ok && v != nil {
 		builder.RemoveFollowedByIDs(v...)
 	}
+	if v, ok := u.AddVaccinations.Get(); ok && v != nil {
+		builder.AddVaccinationIDs(v...)
+	}
+	if v, ok := u.RemoveVaccinations.Get(); ok && v != nil {
+		builder.RemoveVaccinationIDs(v...)
+	}
+	return builder
+}
+
+// ApplyBulkInputs is similar to ApplyInputs, but maps all provided values to a builder
+// which updates multiple entities.
+func (u *UpdatePetParams) ApplyBulkInputs(builder *ent.PetUpdate) *ent.PetUpdate {
+	if v, ok := u.Name.Get(); ok {
+		builder.SetName(v)
+	}
+	if v, ok := u.Nicknames.Get(); ok {
+		builder.SetNicknames(v)
+	}
+	if v, ok := u.Description.Get(); ok {
+		if v != nil {
+			builder.SetDescription(*v)
+		} else {
+			builder.ClearDescription()
+		}
+	}
+	if v, ok := u.Age.Get(); ok {
+		builder.SetAge(v)
+	}
+	if v, ok := u.Type.Get(); ok {
+		builder.SetType(v)
+	}
+
+	if v, ok := u.AddCategories.Get(); ok && v != nil {
+		builder.AddCategoryIDs(v...)
+	}
+	if v, ok := u.RemoveCategories.Get(); ok && v != nil {
+		builder.RemoveCategoryIDs(v...)
+	}
+	// If add_<edge> or remove_<edge> is provided, don't clear or use this field.
+	if v, ok := u.Categories.Get(); ok && !u.AddCategories.Present() && !u.RemoveCategories.Present() {
+		builder.ClearCategories()
+		if v != nil {
+			builder.AddCategoryIDs(v...)
+		}
+	}
+	if v, ok := u.Owner.Get(); ok {
+		if v != nil {
+			builder.SetOwnerID(*v)
+		} else {
+			builder.ClearOwner()
+		}
+	}
+	if v, ok := u.AddFriends.Get(); ok && v != nil {
+		builder.AddFriendIDs(v...)
+	}
+	if v, ok := u.RemoveFriends.Get(); ok && v != nil {
+		builder.RemoveFriendIDs(v...)
+	}
+	if v, ok := u.AddFollowedBy.Get(); ok && v != nil {
+		builder.AddFollowedByIDs(v...)
+	}
+	if v, ok := u.RemoveFollowedBy.Get(); ok && v != nil {
+		builder.RemoveFollowedByIDs(v...)
+	}
+	if v, ok := u.AddVaccinations.Get(); ok && v != nil {
+		builder.AddVaccinationIDs(v...)
+	}
+	if v, ok := u.RemoveVaccinations.Get(); ok && v != nil {
+		builder.RemoveVaccinationIDs(v...)
+	}
 	return builder
 }
 
 // Exec wraps all logic (mapping all provided values to the build), updates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
-// loaded edges.
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
 func (c *UpdatePetParams) Exec(ctx context.Context, builder *ent.PetUpdateOne, query *ent.PetQuery) (*ent.Pet, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		id, _ := builder.Mutation().ID()
+		current, err := query.Clone().Where(pet.ID(id)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.ApplyInputs(builder).Save(ctx)
 	if err != nil {
 		return nil, err
@@ -186,6 +477,23 @@ type UpdatePostParams struct {
 	Title Option[string] `json:"title"`
 	Slug  Option[string] `json:"slug"`
 	Body  Option[string] `json:"body"`
+
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdatePostParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided Post in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdatePostParams) patchDocument(ctx context.Context, e *ent.Post, builder *ent.PostUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	target.doc["title"] = e.Title
+	target.doc["slug"] = e.Slug
+	target.doc["body"] = e.Body
+	return target, nil
 }
 
 func (u *UpdatePostParams) ApplyInputs(builder *ent.PostUpdateOne) *ent.PostUpdateOne {
@@ -204,8 +512,26 @@ func (u *UpdatePostParams) ApplyInputs(builder *ent.PostUpdateOne) *ent.PostUpda
 
 // Exec wraps all logic (mapping all provided values to the build), updates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
-// loaded edges.
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
 func (c *UpdatePostParams) Exec(ctx context.Context, builder *ent.PostUpdateOne, query *ent.PostQuery) (*ent.Post, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		id, _ := builder.Mutation().ID()
+		current, err := query.Clone().Where(post.ID(id)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.ApplyInputs(builder).Save(ctx)
 	if err != nil {
 		return nil, err
@@ -221,6 +547,31 @@ type UpdateSettingParams struct {
 	AddAdmins Option[[]uuid.UUID] `json:"add_admins,omitempty"`
 	// Administrators for the platform.
 	RemoveAdmins Option[[]uuid.UUID] `json:"remove_admins,omitempty"`
+
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdateSettingParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided Setting in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdateSettingParams) patchDocument(ctx context.Context, e *ent.Settings, builder *ent.SettingsUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	target.doc["global_banner"] = e.GlobalBanner
+	target.clear["global_banner"] = func() { builder.ClearGlobalBanner() }
+	adminsIDs, err := e.QueryAdmins().Order(ent.Asc(user.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if adminsIDs == nil {
+		adminsIDs = []uuid.UUID{}
+	}
+	target.doc["admins"] = adminsIDs
+	target.edges = append(target.edges, "admins")
+	return target, nil
 }
 
 func (u *UpdateSettingParams) ApplyInputs(builder *ent.SettingsUpdateOne) *ent.SettingsUpdateOne {
@@ -243,8 +594,26 @@ func (u *UpdateSettingParams) ApplyInputs(builder *ent.SettingsUpdateOne) *ent.S
 
 // Exec wraps all logic (mapping all provided values to the build), updates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
-// loaded edges.
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
 func (c *UpdateSettingParams) Exec(ctx context.Context, builder *ent.SettingsUpdateOne, query *ent.SettingsQuery) (*ent.Settings, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		id, _ := builder.Mutation().ID()
+		current, err := query.Clone().Where(settings.ID(id)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.ApplyInputs(builder).Save(ctx)
 	if err != nil {
 		return nil, err
@@ -290,6 +659,82 @@ type UpdateUserParams struct {
 	RemovePosts       Option[[]int]       `json:"remove_posts,omitempty"`
 	AddFriendships    Option[[]int]       `json:"add_friendships,omitempty"`
 	RemoveFriendships Option[[]int]       `json:"remove_friendships,omitempty"`
+
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdateUserParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided User in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdateUserParams) patchDocument(ctx context.Context, e *ent.User, builder *ent.UserUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	target.doc["name"] = e.Name
+	target.doc["type"] = e.Type
+	target.doc["description"] = e.Description
+	target.clear["description"] = func() { builder.ClearDescription() }
+	target.doc["enabled"] = e.Enabled
+	target.doc["email"] = e.Email
+	target.clear["email"] = func() { builder.ClearEmail() }
+	target.doc["avatar"] = e.Avatar
+	target.clear["avatar"] = func() { builder.ClearAvatar() }
+	target.doc["github_data"] = e.GithubData
+	target.clear["github_data"] = func() { builder.ClearGithubData() }
+	target.doc["any_data"] = e.AnyData
+	target.clear["any_data"] = func() { builder.ClearAnyData() }
+	target.doc["profile_url"] = e.ProfileURL
+	target.clear["profile_url"] = func() { builder.ClearProfileURL() }
+	target.doc["last_authenticated_at"] = e.LastAuthenticatedAt
+	target.clear["last_authenticated_at"] = func() { builder.ClearLastAuthenticatedAt() }
+	petsIDs, err := e.QueryPets().Order(ent.Asc(pet.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if petsIDs == nil {
+		petsIDs = []int{}
+	}
+	target.doc["pets"] = petsIDs
+	target.edges = append(target.edges, "pets")
+	followedPetsIDs, err := e.QueryFollowedPets().Order(ent.Asc(pet.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if followedPetsIDs == nil {
+		followedPetsIDs = []int{}
+	}
+	target.doc["followed_pets"] = followedPetsIDs
+	target.edges = append(target.edges, "followed_pets")
+	friendsIDs, err := e.QueryFriends().Order(ent.Asc(user.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if friendsIDs == nil {
+		friendsIDs = []uuid.UUID{}
+	}
+	target.doc["friends"] = friendsIDs
+	target.edges = append(target.edges, "friends")
+	postsIDs, err := e.QueryPosts().Order(ent.Asc(post.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if postsIDs == nil {
+		postsIDs = []int{}
+	}
+	target.doc["posts"] = postsIDs
+	target.edges = append(target.edges, "posts")
+	friendshipsIDs, err := e.QueryFriendships().Order(ent.Asc(friendship.FieldID)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if friendshipsIDs == nil {
+		friendshipsIDs = []int{}
+	}
+	target.doc["friendships"] = friendshipsIDs
+	target.edges = append(target.edges, "friendships")
+	return target, nil
 }
 
 func (u *UpdateUserParams) ApplyInputs(builder *ent.UserUpdateOne) *ent.UserUpdateOne {
@@ -378,11 +823,107 @@ func (u *UpdateUserParams) ApplyInputs(builder *ent.UserUpdateOne) *ent.UserUpda
 
 // Exec wraps all logic (mapping all provided values to the build), updates the entity,
 // and does another query (using provided query as base) to get the entity, with all eager
-// loaded edges.
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
 func (c *UpdateUserParams) Exec(ctx context.Context, builder *ent.UserUpdateOne, query *ent.UserQuery) (*ent.User, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		id, _ := builder.Mutation().ID()
+		current, err := query.Clone().Where(user.ID(id)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.ApplyInputs(builder).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return EagerLoadUser(query.Where(user.ID(result.ID))).Only(ctx)
 }
+
+// UpdateVaccinationParams defines parameters for updating a Vaccination via a PATCH request.
+type UpdateVaccinationParams struct {
+	// Name of the vaccine.
+	Name Option[string] `json:"name"`
+	// When the vaccine was administered.
+	AdministeredAt Option[time.Time] `json:"administered_at"`
+	// The pet that was vaccinated.
+	Pet Option[int] `json:"pet"`
+
+	patch *patchRequest // JSON Merge Patch or JSON Patch document, applied by Exec.
+}
+
+func (u *UpdateVaccinationParams) setPatch(patch *patchRequest) {
+	u.patch = patch
+}
+
+// patchDocument returns the current state of the provided Vaccination in the format of
+// the request body, which JSON Merge Patch and JSON Patch documents are applied to.
+// Sensitive fields aren't included. Optional fields and edges are cleared using builder.
+func (u *UpdateVaccinationParams) patchDocument(ctx context.Context, e *ent.Vaccination, builder *ent.VaccinationUpdateOne) (*patchTarget, error) {
+	target := &patchTarget{doc: map[string]any{}, clear: map[string]func(){}}
+	target.doc["name"] = e.Name
+	target.doc["administered_at"] = e.AdministeredAt
+	petID, err := e.QueryPet().OnlyID(ctx)
+	switch {
+	case err == nil:
+		target.doc["pet"] = petID
+	case ent.IsNotFound(err):
+		target.doc["pet"] = nil
+	default:
+		return nil, err
+	}
+	return target, nil
+}
+
+func (u *UpdateVaccinationParams) ApplyInputs(builder *ent.VaccinationUpdateOne) *ent.VaccinationUpdateOne {
+	if v, ok := u.Name.Get(); ok {
+		builder.SetName(v)
+	}
+	if v, ok := u.AdministeredAt.Get(); ok {
+		builder.SetAdministeredAt(v)
+	}
+
+	if v, ok := u.Pet.Get(); ok {
+		builder.SetPetID(v)
+	}
+	return builder
+}
+
+// Exec wraps all logic (mapping all provided values to the build), updates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges. If a JSON Merge Patch or JSON Patch document was provided (see Bind), it's
+// first applied to the current state of the entity.
+func (c *UpdateVaccinationParams) Exec(ctx context.Context, builder *ent.VaccinationUpdateOne, query *ent.VaccinationQuery) (*ent.Vaccination, error) {
+	if patch := c.patch; patch != nil {
+		c.patch = nil
+		id, _ := builder.Mutation().ID()
+		current, err := query.Clone().Where(vaccination.ID(id)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := c.patchDocument(ctx, current, builder)
+		if err != nil {
+			return nil, err
+		}
+		if err = patch.apply(target, c); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadVaccination(query.Where(vaccination.ID(result.ID))).Only(ctx)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/post"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/schema"
 )
 
@@ -230,4 +231,14 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	vaccinationFields := schema.Vaccination{}.Fields()
+	_ = vaccinationFields
+	// vaccinationDescName is the schema descriptor for name field.
+	vaccinationDescName := vaccinationFields[0].Descriptor()
+	// vaccination.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	vaccination.NameValidator = vaccinationDescName.Validators[0].(func(string) error)
+	// vaccinationDescAdministeredAt is the schema descriptor for administered_at field.
+	vaccinationDescAdministeredAt := vaccinationFields[1].Descriptor()
+	// vaccination.DefaultAdministeredAt holds the default value on creation for the administered_at field.
+	vaccination.DefaultAdministeredAt = vaccinationDescAdministeredAt.Default.(func() time.Time)
 }
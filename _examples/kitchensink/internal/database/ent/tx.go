@@ -28,6 +28,8 @@ type Tx struct {
 	Skipped *SkippedClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Vaccination is the client for interacting with the Vaccination builders.
+	Vaccination *VaccinationClient
 
 	// lazily loaded.
 	client     *Client
@@ -167,6 +169,7 @@ func (tx *Tx) init() {
 	tx.Settings = NewSettingsClient(tx.config)
 	tx.Skipped = NewSkippedClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Vaccination = NewVaccinationClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
+)
+
+// Vaccination is the model entity for the Vaccination schema.
+type Vaccination struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name of the vaccine.
+	Name string `json:"name"`
+	// When the vaccine was administered.
+	AdministeredAt time.Time `json:"administered_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VaccinationQuery when eager-loading is set.
+	Edges            VaccinationEdges `json:"edges"`
+	pet_vaccinations *int
+	selectValues     sql.SelectValues
+}
+
+// VaccinationEdges holds the relations/edges for other nodes in the graph.
+type VaccinationEdges struct {
+	// The pet that was vaccinated.
+	Pet *Pet `json:"pet,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PetOrErr returns the Pet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VaccinationEdges) PetOrErr() (*Pet, error) {
+	if e.Pet != nil {
+		return e.Pet, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: pet.Label}
+	}
+	return nil, &NotLoadedError{edge: "pet"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Vaccination) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vaccination.FieldID:
+			values[i] = new(sql.NullInt64)
+		case vaccination.FieldName:
+			values[i] = new(sql.NullString)
+		case vaccination.FieldAdministeredAt:
+			values[i] = new(sql.NullTime)
+		case vaccination.ForeignKeys[0]: // pet_vaccinations
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Vaccination fields.
+func (_m *Vaccination) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vaccination.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case vaccination.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case vaccination.FieldAdministeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field administered_at", values[i])
+			} else if value.Valid {
+				_m.AdministeredAt = value.Time
+			}
+		case vaccination.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field pet_vaccinations", value)
+			} else if value.Valid {
+				_m.pet_vaccinations = new(int)
+				*_m.pet_vaccinations = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Vaccination.
+// This includes values selected through modifiers, order, etc.
+func (_m *Vaccination) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPet queries the "pet" edge of the Vaccination entity.
+func (_m *Vaccination) QueryPet() *PetQuery {
+	return NewVaccinationClient(_m.config).QueryPet(_m)
+}
+
+// Update returns a builder for updating this Vaccination.
+// Note that you need to call Vaccination.Unwrap() before calling this method if this Vaccination
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Vaccination) Update() *VaccinationUpdateOne {
+	return NewVaccinationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Vaccination entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Vaccination) Unwrap() *Vaccination {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Vaccination is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Vaccination) String() string {
+	var builder strings.Builder
+	builder.WriteString("Vaccination(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("administered_at=")
+	builder.WriteString(_m.AdministeredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Vaccinations is a parsable slice of Vaccination.
+type Vaccinations []*Vaccination
@@ -0,0 +1,99 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vaccination
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the vaccination type in the database.
+	Label = "vaccination"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAdministeredAt holds the string denoting the administered_at field in the database.
+	FieldAdministeredAt = "administered_at"
+	// EdgePet holds the string denoting the pet edge name in mutations.
+	EdgePet = "pet"
+	// Table holds the table name of the vaccination in the database.
+	Table = "vaccinations"
+	// PetTable is the table that holds the pet relation/edge.
+	PetTable = "vaccinations"
+	// PetInverseTable is the table name for the Pet entity.
+	// It exists in this package in order to avoid circular dependency with the "pet" package.
+	PetInverseTable = "pets"
+	// PetColumn is the table column denoting the pet relation/edge.
+	PetColumn = "pet_vaccinations"
+)
+
+// Columns holds all SQL columns for vaccination fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldAdministeredAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "vaccinations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"pet_vaccinations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultAdministeredAt holds the default value on creation for the "administered_at" field.
+	DefaultAdministeredAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Vaccination queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAdministeredAt orders the results by the administered_at field.
+func ByAdministeredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdministeredAt, opts...).ToFunc()
+}
+
+// ByPetField orders the results by pet field.
+func ByPetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPetStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PetTable, PetColumn),
+	)
+}
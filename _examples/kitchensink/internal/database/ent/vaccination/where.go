@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vaccination
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldEQ(FieldName, v))
+}
+
+// AdministeredAt applies equality check predicate on the "administered_at" field. It's identical to AdministeredAtEQ.
+func AdministeredAt(v time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldEQ(FieldAdministeredAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldContainsFold(FieldName, v))
+}
+
+// AdministeredAtEQ applies the EQ predicate on the "administered_at" field.
+func AdministeredAtEQ(v time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldEQ(FieldAdministeredAt, v))
+}
+
+// AdministeredAtNEQ applies the NEQ predicate on the "administered_at" field.
+func AdministeredAtNEQ(v time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldNEQ(FieldAdministeredAt, v))
+}
+
+// AdministeredAtIn applies the In predicate on the "administered_at" field.
+func AdministeredAtIn(vs ...time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldIn(FieldAdministeredAt, vs...))
+}
+
+// AdministeredAtNotIn applies the NotIn predicate on the "administered_at" field.
+func AdministeredAtNotIn(vs ...time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldNotIn(FieldAdministeredAt, vs...))
+}
+
+// AdministeredAtGT applies the GT predicate on the "administered_at" field.
+func AdministeredAtGT(v time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldGT(FieldAdministeredAt, v))
+}
+
+// AdministeredAtGTE applies the GTE predicate on the "administered_at" field.
+func AdministeredAtGTE(v time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldGTE(FieldAdministeredAt, v))
+}
+
+// AdministeredAtLT applies the LT predicate on the "administered_at" field.
+func AdministeredAtLT(v time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldLT(FieldAdministeredAt, v))
+}
+
+// AdministeredAtLTE applies the LTE predicate on the "administered_at" field.
+func AdministeredAtLTE(v time.Time) predicate.Vaccination {
+	return predicate.Vaccination(sql.FieldLTE(FieldAdministeredAt, v))
+}
+
+// HasPet applies the HasEdge predicate on the "pet" edge.
+func HasPet() predicate.Vaccination {
+	return predicate.Vaccination(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PetTable, PetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPetWith applies the HasEdge predicate on the "pet" edge with a given conditions (other predicates).
+func HasPetWith(preds ...predicate.Pet) predicate.Vaccination {
+	return predicate.Vaccination(func(s *sql.Selector) {
+		step := newPetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Vaccination) predicate.Vaccination {
+	return predicate.Vaccination(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Vaccination) predicate.Vaccination {
+	return predicate.Vaccination(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Vaccination) predicate.Vaccination {
+	return predicate.Vaccination(sql.NotPredicates(p))
+}
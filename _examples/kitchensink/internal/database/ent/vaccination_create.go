@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
+)
+
+// VaccinationCreate is the builder for creating a Vaccination entity.
+type VaccinationCreate struct {
+	config
+	mutation *VaccinationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *VaccinationCreate) SetName(v string) *VaccinationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAdministeredAt sets the "administered_at" field.
+func (_c *VaccinationCreate) SetAdministeredAt(v time.Time) *VaccinationCreate {
+	_c.mutation.SetAdministeredAt(v)
+	return _c
+}
+
+// SetNillableAdministeredAt sets the "administered_at" field if the given value is not nil.
+func (_c *VaccinationCreate) SetNillableAdministeredAt(v *time.Time) *VaccinationCreate {
+	if v != nil {
+		_c.SetAdministeredAt(*v)
+	}
+	return _c
+}
+
+// SetPetID sets the "pet" edge to the Pet entity by ID.
+func (_c *VaccinationCreate) SetPetID(id int) *VaccinationCreate {
+	_c.mutation.SetPetID(id)
+	return _c
+}
+
+// SetPet sets the "pet" edge to the Pet entity.
+func (_c *VaccinationCreate) SetPet(v *Pet) *VaccinationCreate {
+	return _c.SetPetID(v.ID)
+}
+
+// Mutation returns the VaccinationMutation object of the builder.
+func (_c *VaccinationCreate) Mutation() *VaccinationMutation {
+	return _c.mutation
+}
+
+// Save creates the Vaccination in the database.
+func (_c *VaccinationCreate) Save(ctx context.Context) (*Vaccination, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VaccinationCreate) SaveX(ctx context.Context) *Vaccination {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VaccinationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VaccinationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VaccinationCreate) defaults() {
+	if _, ok := _c.mutation.AdministeredAt(); !ok {
+		v := vaccination.DefaultAdministeredAt()
+		_c.mutation.SetAdministeredAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VaccinationCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Vaccination.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := vaccination.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Vaccination.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AdministeredAt(); !ok {
+		return &ValidationError{Name: "administered_at", err: errors.New(`ent: missing required field "Vaccination.administered_at"`)}
+	}
+	if len(_c.mutation.PetIDs()) == 0 {
+		return &ValidationError{Name: "pet", err: errors.New(`ent: missing required edge "Vaccination.pet"`)}
+	}
+	return nil
+}
+
+func (_c *VaccinationCreate) sqlSave(ctx context.Context) (*Vaccination, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VaccinationCreate) createSpec() (*Vaccination, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Vaccination{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vaccination.Table, sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(vaccination.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.AdministeredAt(); ok {
+		_spec.SetField(vaccination.FieldAdministeredAt, field.TypeTime, value)
+		_node.AdministeredAt = value
+	}
+	if nodes := _c.mutation.PetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vaccination.PetTable,
+			Columns: []string{vaccination.PetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pet_vaccinations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Vaccination.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VaccinationUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VaccinationCreate) OnConflict(opts ...sql.ConflictOption) *VaccinationUpsertOne {
+	_c.conflict = opts
+	return &VaccinationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Vaccination.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VaccinationCreate) OnConflictColumns(columns ...string) *VaccinationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VaccinationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// VaccinationUpsertOne is the builder for "upsert"-ing
+	//  one Vaccination node.
+	VaccinationUpsertOne struct {
+		create *VaccinationCreate
+	}
+
+	// VaccinationUpsert is the "OnConflict" setter.
+	VaccinationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *VaccinationUpsert) SetName(v string) *VaccinationUpsert {
+	u.Set(vaccination.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VaccinationUpsert) UpdateName() *VaccinationUpsert {
+	u.SetExcluded(vaccination.FieldName)
+	return u
+}
+
+// SetAdministeredAt sets the "administered_at" field.
+func (u *VaccinationUpsert) SetAdministeredAt(v time.Time) *VaccinationUpsert {
+	u.Set(vaccination.FieldAdministeredAt, v)
+	return u
+}
+
+// UpdateAdministeredAt sets the "administered_at" field to the value that was provided on create.
+func (u *VaccinationUpsert) UpdateAdministeredAt() *VaccinationUpsert {
+	u.SetExcluded(vaccination.FieldAdministeredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Vaccination.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VaccinationUpsertOne) UpdateNewValues() *VaccinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Vaccination.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VaccinationUpsertOne) Ignore() *VaccinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VaccinationUpsertOne) DoNothing() *VaccinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VaccinationCreate.OnConflict
+// documentation for more info.
+func (u *VaccinationUpsertOne) Update(set func(*VaccinationUpsert)) *VaccinationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VaccinationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *VaccinationUpsertOne) SetName(v string) *VaccinationUpsertOne {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VaccinationUpsertOne) UpdateName() *VaccinationUpsertOne {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAdministeredAt sets the "administered_at" field.
+func (u *VaccinationUpsertOne) SetAdministeredAt(v time.Time) *VaccinationUpsertOne {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.SetAdministeredAt(v)
+	})
+}
+
+// UpdateAdministeredAt sets the "administered_at" field to the value that was provided on create.
+func (u *VaccinationUpsertOne) UpdateAdministeredAt() *VaccinationUpsertOne {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.UpdateAdministeredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VaccinationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VaccinationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VaccinationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VaccinationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VaccinationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VaccinationCreateBulk is the builder for creating many Vaccination entities in bulk.
+type VaccinationCreateBulk struct {
+	config
+	err      error
+	builders []*VaccinationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Vaccination entities in the database.
+func (_c *VaccinationCreateBulk) Save(ctx context.Context) ([]*Vaccination, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Vaccination, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VaccinationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VaccinationCreateBulk) SaveX(ctx context.Context) []*Vaccination {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VaccinationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VaccinationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Vaccination.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VaccinationUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VaccinationCreateBulk) OnConflict(opts ...sql.ConflictOption) *VaccinationUpsertBulk {
+	_c.conflict = opts
+	return &VaccinationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Vaccination.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VaccinationCreateBulk) OnConflictColumns(columns ...string) *VaccinationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VaccinationUpsertBulk{
+		create: _c,
+	}
+}
+
+// VaccinationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Vaccination nodes.
+type VaccinationUpsertBulk struct {
+	create *VaccinationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Vaccination.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VaccinationUpsertBulk) UpdateNewValues() *VaccinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Vaccination.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VaccinationUpsertBulk) Ignore() *VaccinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VaccinationUpsertBulk) DoNothing() *VaccinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VaccinationCreateBulk.OnConflict
+// documentation for more info.
+func (u *VaccinationUpsertBulk) Update(set func(*VaccinationUpsert)) *VaccinationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VaccinationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *VaccinationUpsertBulk) SetName(v string) *VaccinationUpsertBulk {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VaccinationUpsertBulk) UpdateName() *VaccinationUpsertBulk {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAdministeredAt sets the "administered_at" field.
+func (u *VaccinationUpsertBulk) SetAdministeredAt(v time.Time) *VaccinationUpsertBulk {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.SetAdministeredAt(v)
+	})
+}
+
+// UpdateAdministeredAt sets the "administered_at" field to the value that was provided on create.
+func (u *VaccinationUpsertBulk) UpdateAdministeredAt() *VaccinationUpsertBulk {
+	return u.Update(func(s *VaccinationUpsert) {
+		s.UpdateAdministeredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VaccinationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VaccinationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VaccinationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VaccinationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
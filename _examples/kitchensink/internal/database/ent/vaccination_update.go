@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/predicate"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
+)
+
+// VaccinationUpdate is the builder for updating Vaccination entities.
+type VaccinationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VaccinationMutation
+}
+
+// Where appends a list predicates to the VaccinationUpdate builder.
+func (_u *VaccinationUpdate) Where(ps ...predicate.Vaccination) *VaccinationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *VaccinationUpdate) SetName(v string) *VaccinationUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VaccinationUpdate) SetNillableName(v *string) *VaccinationUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAdministeredAt sets the "administered_at" field.
+func (_u *VaccinationUpdate) SetAdministeredAt(v time.Time) *VaccinationUpdate {
+	_u.mutation.SetAdministeredAt(v)
+	return _u
+}
+
+// SetNillableAdministeredAt sets the "administered_at" field if the given value is not nil.
+func (_u *VaccinationUpdate) SetNillableAdministeredAt(v *time.Time) *VaccinationUpdate {
+	if v != nil {
+		_u.SetAdministeredAt(*v)
+	}
+	return _u
+}
+
+// SetPetID sets the "pet" edge to the Pet entity by ID.
+func (_u *VaccinationUpdate) SetPetID(id int) *VaccinationUpdate {
+	_u.mutation.SetPetID(id)
+	return _u
+}
+
+// SetPet sets the "pet" edge to the Pet entity.
+func (_u *VaccinationUpdate) SetPet(v *Pet) *VaccinationUpdate {
+	return _u.SetPetID(v.ID)
+}
+
+// Mutation returns the VaccinationMutation object of the builder.
+func (_u *VaccinationUpdate) Mutation() *VaccinationMutation {
+	return _u.mutation
+}
+
+// ClearPet clears the "pet" edge to the Pet entity.
+func (_u *VaccinationUpdate) ClearPet() *VaccinationUpdate {
+	_u.mutation.ClearPet()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VaccinationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VaccinationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VaccinationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VaccinationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VaccinationUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := vaccination.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Vaccination.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PetCleared() && len(_u.mutation.PetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Vaccination.pet"`)
+	}
+	return nil
+}
+
+func (_u *VaccinationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vaccination.Table, vaccination.Columns, sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(vaccination.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdministeredAt(); ok {
+		_spec.SetField(vaccination.FieldAdministeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.PetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vaccination.PetTable,
+			Columns: []string{vaccination.PetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vaccination.PetTable,
+			Columns: []string{vaccination.PetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vaccination.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VaccinationUpdateOne is the builder for updating a single Vaccination entity.
+type VaccinationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VaccinationMutation
+}
+
+// SetName sets the "name" field.
+func (_u *VaccinationUpdateOne) SetName(v string) *VaccinationUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VaccinationUpdateOne) SetNillableName(v *string) *VaccinationUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAdministeredAt sets the "administered_at" field.
+func (_u *VaccinationUpdateOne) SetAdministeredAt(v time.Time) *VaccinationUpdateOne {
+	_u.mutation.SetAdministeredAt(v)
+	return _u
+}
+
+// SetNillableAdministeredAt sets the "administered_at" field if the given value is not nil.
+func (_u *VaccinationUpdateOne) SetNillableAdministeredAt(v *time.Time) *VaccinationUpdateOne {
+	if v != nil {
+		_u.SetAdministeredAt(*v)
+	}
+	return _u
+}
+
+// SetPetID sets the "pet" edge to the Pet entity by ID.
+func (_u *VaccinationUpdateOne) SetPetID(id int) *VaccinationUpdateOne {
+	_u.mutation.SetPetID(id)
+	return _u
+}
+
+// SetPet sets the "pet" edge to the Pet entity.
+func (_u *VaccinationUpdateOne) SetPet(v *Pet) *VaccinationUpdateOne {
+	return _u.SetPetID(v.ID)
+}
+
+// Mutation returns the VaccinationMutation object of the builder.
+func (_u *VaccinationUpdateOne) Mutation() *VaccinationMutation {
+	return _u.mutation
+}
+
+// ClearPet clears the "pet" edge to the Pet entity.
+func (_u *VaccinationUpdateOne) ClearPet() *VaccinationUpdateOne {
+	_u.mutation.ClearPet()
+	return _u
+}
+
+// Where appends a list predicates to the VaccinationUpdate builder.
+func (_u *VaccinationUpdateOne) Where(ps ...predicate.Vaccination) *VaccinationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VaccinationUpdateOne) Select(field string, fields ...string) *VaccinationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Vaccination entity.
+func (_u *VaccinationUpdateOne) Save(ctx context.Context) (*Vaccination, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VaccinationUpdateOne) SaveX(ctx context.Context) *Vaccination {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VaccinationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VaccinationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VaccinationUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := vaccination.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Vaccination.name": %w`, err)}
+		}
+	}
+	if _u.mutation.PetCleared() && len(_u.mutation.PetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Vaccination.pet"`)
+	}
+	return nil
+}
+
+func (_u *VaccinationUpdateOne) sqlSave(ctx context.Context) (_node *Vaccination, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vaccination.Table, vaccination.Columns, sqlgraph.NewFieldSpec(vaccination.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Vaccination.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vaccination.FieldID)
+		for _, f := range fields {
+			if !vaccination.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vaccination.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(vaccination.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AdministeredAt(); ok {
+		_spec.SetField(vaccination.FieldAdministeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.PetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vaccination.PetTable,
+			Columns: []string{vaccination.PetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vaccination.PetTable,
+			Columns: []string{vaccination.PetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Vaccination{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vaccination.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -22,6 +22,9 @@ func main() {
 		StrictMutate:          true,
 		ListNotFound:          true,
 		DefaultFilterID:       true,
+		EnableETags:           true,
+		EnableConditionalGET:  true,
+		ProblemTypeBaseURI:    "https://example.com/problems/",
 		GlobalRequestHeaders:  entrest.RequestIDHeader,
 		GlobalResponseHeaders: entrest.RateLimitHeaders,
 	})
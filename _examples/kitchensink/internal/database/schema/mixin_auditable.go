@@ -33,6 +33,8 @@ func (AuditableTimestamp) Fields() []ent.Field {
 				entrest.WithReadOnly(true),
 				entrest.WithSortable(true),
 				entrest.WithFilter(entrest.FilterGroupLength),
+				entrest.WithETag(true),
+				entrest.WithLastModified(true),
 			).
 			Comment("Time that the resource was last updated."),
 	}
@@ -38,9 +38,12 @@ func (Pet) Fields() []ent.Field {
 		field.Int("age").
 			Min(0).Max(50).
 			Annotations(
+				entrest.WithMinimum(0),
+				entrest.WithMaximum(50),
 				entrest.WithExample(2),
 				entrest.WithSortable(true),
 				entrest.WithFilter(entrest.FilterGroupEqualExact|entrest.FilterGroupArray|entrest.FilterGroupLength),
+				entrest.WithAggregate(entrest.AggregateSum, entrest.AggregateAvg, entrest.AggregateMin, entrest.AggregateMax),
 			),
 		field.Enum("type").
 			NamedValues(
@@ -51,11 +54,15 @@ func (Pet) Fields() []ent.Field {
 				"Amphibian", "AMPHIBIAN",
 				"Reptile", "REPTILE",
 				"Other", "OTHER",
-			).Annotations(
-			entrest.WithExample("DOG"),
-			entrest.WithSortable(true),
-			entrest.WithFilter(entrest.FilterGroupEqualExact|entrest.FilterGroupArray),
-		),
+			).
+			StorageKey("pet_type").
+			Annotations(
+				entrest.WithExample("DOG"),
+				entrest.WithSortable(true),
+				entrest.WithFilter(entrest.FilterGroupEqualExact|entrest.FilterGroupArray),
+				entrest.WithAggregate(entrest.AggregateGroupBy),
+				entrest.WithDistinct(true),
+			),
 	}
 }
 
@@ -72,6 +79,7 @@ func (Pet) Edges() []ent.Edge {
 			Comment("Categories that the pet belongs to.").
 			Annotations(
 				entrest.WithEagerLoad(true),
+				entrest.WithExpandable(true),
 				entrest.WithFilter(entrest.FilterEdge),
 				entrest.WithEdgeUpdateBulk(true),
 			),
@@ -87,6 +95,7 @@ func (Pet) Edges() []ent.Edge {
 			Comment("Pets that this pet is friends with.").
 			Annotations(
 				entrest.WithFilter(entrest.FilterEdge),
+				entrest.WithExpandable(true),
 			),
 		edge.From("followed_by", User.Type).
 			Ref("followed_pets").
@@ -94,6 +103,12 @@ func (Pet) Edges() []ent.Edge {
 			Comment("Users that this pet is followed by.").
 			Annotations(
 				entrest.WithFilter(entrest.FilterEdge),
+				entrest.WithExpandable(true),
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("vaccinations", Vaccination.Type).
+			Comment("Vaccinations the pet has received.").
+			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
 	}
@@ -108,7 +123,15 @@ func (Pet) Annotations() []schema.Annotation {
 			entrest.OperationCreateOrReplace,
 			entrest.OperationDelete,
 			entrest.OperationList,
+			entrest.OperationSearch,
+			entrest.OperationCount,
+			entrest.OperationAggregate,
+			entrest.OperationDistinct,
+			entrest.OperationBulkCreate,
+			entrest.OperationBulkUpdate,
+			entrest.OperationBulkDelete,
 		),
+		entrest.WithMaxBulkAffected(100),
 		entrest.WithDefaultSort("name"),
 		entrest.WithDefaultOrder(entrest.OrderAsc),
 	}
@@ -18,9 +18,22 @@ type Post struct {
 
 func (Post) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("title").MinLen(10).MaxLen(200),
-		field.String("slug"),
-		field.String("body").MinLen(10),
+		field.String("title").
+			MinLen(10).
+			MaxLen(200).
+			Annotations(
+				entrest.WithMinLength(10),
+			),
+		field.String("slug").
+			Unique().
+			Annotations(
+				entrest.WithLookupKey(true),
+			),
+		field.String("body").
+			MinLen(10).
+			Annotations(
+				entrest.WithMinLength(10),
+			),
 	}
 }
 
@@ -45,5 +58,7 @@ func (Post) Edges() []ent.Edge {
 }
 
 func (Post) Annotations() []schema.Annotation {
-	return []schema.Annotation{}
+	return []schema.Annotation{
+		entrest.WithPaginationMode(entrest.PaginationCursor),
+	}
 }
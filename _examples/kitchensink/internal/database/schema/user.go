@@ -74,6 +74,7 @@ func (User) Fields() []ent.Field {
 			Nillable().
 			Annotations(
 				entrest.WithSortable(true),
+				entrest.WithSortNulls(entrest.NullsLast),
 				entrest.WithExample("John.Smith@example.com"),
 				entrest.WithFilter(entrest.FilterGroupEqual|entrest.FilterGroupArray),
 				entrest.WithFilterGroup("search"),
@@ -130,6 +131,7 @@ func (User) Edges() []ent.Edge {
 			Annotations(
 				entrest.WithEagerLoad(true),
 				entrest.WithEagerLoadLimit(-1),
+				entrest.WithExpandable(true),
 				entrest.WithFilter(entrest.FilterEdge),
 				entsql.OnDelete(entsql.SetNull),
 			),
@@ -147,7 +149,9 @@ func (User) Edges() []ent.Edge {
 				entrest.WithFilter(entrest.FilterEdge),
 				entsql.OnDelete(entsql.Cascade),
 			),
-		edge.To("posts", Post.Type).Annotations(),
+		edge.To("posts", Post.Type).Annotations(
+			entrest.WithExpandable(true),
+		),
 	}
 }
 
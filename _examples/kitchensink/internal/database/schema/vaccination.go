@@ -0,0 +1,47 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/lrstanley/entrest"
+)
+
+type Vaccination struct {
+	ent.Schema
+}
+
+func (Vaccination) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the vaccine."),
+		field.Time("administered_at").
+			Default(time.Now).
+			Comment("When the vaccine was administered."),
+	}
+}
+
+func (Vaccination) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("pet", Pet.Type).
+			Ref("vaccinations").
+			Unique().
+			Required().
+			Comment("The pet that was vaccinated."),
+	}
+}
+
+func (Vaccination) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entrest.WithSubentity(true),
+		entrest.WithDescription("Vaccination is a vaccine administered to a pet. Only accessible through the pet."),
+	}
+}
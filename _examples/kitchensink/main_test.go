@@ -1,17 +1,24 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
@@ -19,8 +26,10 @@ import (
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/enttest"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/migrate"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/post"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/rest"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/user"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/vaccination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"modernc.org/sqlite"
@@ -30,6 +39,12 @@ var sqlRegister sync.Once
 
 func newClient(t *testing.T) *ent.Client {
 	t.Helper()
+	return openClient(t, "file:ent?mode=memory&_pragma=foreign_keys(1)&_time_format=sqlite")
+}
+
+// openClient opens a client using the provided SQLite DSN, and runs the migrations.
+func openClient(t *testing.T, dsn string) *ent.Client {
+	t.Helper()
 
 	sqlRegister.Do(func() {
 		sql.Register("sqlite3", &sqlite.Driver{})
@@ -45,7 +60,7 @@ func newClient(t *testing.T) *ent.Client {
 		),
 	}
 
-	db := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_pragma=foreign_keys(1)", opts...)
+	db := enttest.Open(t, "sqlite3", dsn, opts...)
 	return db
 }
 
@@ -92,6 +107,18 @@ func newCategory(db *ent.Client) *ent.CategoryCreate {
 		SetReadonly(gofakeit.UUID())
 }
 
+func newPost(db *ent.Client, author *ent.User) *ent.PostCreate {
+	return db.Post.Create().
+		SetTitle(gofakeit.Sentence(5)).
+		SetSlug(gofakeit.UUID()).
+		SetBody(gofakeit.Paragraph(1, 3, 10, " ")).
+		SetAuthor(author)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func TestHandler_Get(t *testing.T) {
 	t.Parallel()
 
@@ -166,8 +193,10 @@ func TestHandler_GetEdge(t *testing.T) {
 
 	assert.Equal(t, http.StatusNotFound, respPet.Data.Code)
 	assert.Empty(t, respPet.Value.Content)
-	assert.Equal(t, 0, respPet.Value.TotalCount)
-	assert.Equal(t, 1, respPet.Value.LastPage)
+	require.NotNil(t, respPet.Value.TotalCount)
+	require.NotNil(t, respPet.Value.LastPage)
+	assert.Equal(t, 0, *respPet.Value.TotalCount)
+	assert.Equal(t, 1, *respPet.Value.LastPage)
 
 	// And similar when invalid ID is used, just 400.
 
@@ -411,7 +440,8 @@ func TestHandler_List(t *testing.T) {
 				return
 			}
 
-			assert.Equal(t, tt.expectedTotalCount, resp.Value.TotalCount)
+			require.NotNil(t, resp.Value.TotalCount)
+			assert.Equal(t, tt.expectedTotalCount, *resp.Value.TotalCount)
 			assert.Len(t, resp.Value.Content, tt.expectedCount)
 			assert.Equal(t, tt.expectedIsLastPage, resp.Value.IsLastPage)
 
@@ -442,6 +472,266 @@ func TestHandler_List(t *testing.T) {
 	}
 }
 
+func TestHandler_PaginationCount(t *testing.T) {
+	ctx, db, s := newRestServer(t, &rest.ServerConfig{EnableLinks: true})
+	t.Cleanup(func() { db.Close() })
+
+	perPage := rest.UserPageConfig.ItemsPerPage
+	totalUsers := perPage + 5
+	users := db.User.CreateBulk(enttest.Multiple(newUser, db, totalUsers)...).SaveX(ctx)
+
+	t.Run("skip", func(t *testing.T) {
+		resp := enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, "/users?count=false", nil).Must(t)
+		assert.Nil(t, resp.Value.TotalCount)
+		assert.Nil(t, resp.Value.LastPage)
+		assert.True(t, resp.Value.HasMore)
+		assert.False(t, resp.Value.IsLastPage)
+		assert.Len(t, resp.Value.Content, perPage)
+		assert.Contains(t, resp.Data.Header().Get("Link"), `rel="next"`)
+
+		resp = enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, "/users?count=false&page=2", nil).Must(t)
+		assert.Nil(t, resp.Value.TotalCount)
+		assert.False(t, resp.Value.HasMore)
+		assert.True(t, resp.Value.IsLastPage)
+		assert.Len(t, resp.Value.Content, totalUsers-perPage)
+	})
+
+	t.Run("skip-not-found", func(t *testing.T) {
+		resp := enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, "/users?count=false&name.eq=does-not-exist", nil)
+		assert.Equal(t, http.StatusNotFound, resp.Data.Code)
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		resp := enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, "/users?count=exact", nil).Must(t)
+		require.NotNil(t, resp.Value.TotalCount)
+		require.NotNil(t, resp.Value.LastPage)
+		assert.Equal(t, totalUsers, *resp.Value.TotalCount)
+		assert.Equal(t, 2, *resp.Value.LastPage)
+		assert.True(t, resp.Value.HasMore)
+		assert.Len(t, resp.Value.Content, perPage)
+	})
+
+	t.Run("estimate-fallback", func(t *testing.T) {
+		resp := enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, "/users?count=estimate", nil).Must(t)
+		require.NotNil(t, resp.Value.TotalCount)
+		assert.Equal(t, totalUsers, *resp.Value.TotalCount)
+	})
+
+	t.Run("estimate", func(t *testing.T) {
+		var query string
+		rest.UserPageConfig.CountEstimator = func(_ context.Context, selector *entsql.Selector) (int, error) {
+			query, _ = selector.Query()
+			return 1000, nil
+		}
+		t.Cleanup(func() { rest.UserPageConfig.CountEstimator = nil })
+
+		resp := enttest.Request[rest.PagedResponse[ent.User]](
+			ctx, s, http.MethodGet,
+			"/users?count=estimate&sort=name&name.neq="+url.QueryEscape(users[0].Name),
+			nil,
+		).Must(t)
+		assert.Contains(t, query, "`users`")
+		assert.Contains(t, query, "WHERE")
+		assert.NotContains(t, query, "ORDER BY")
+		require.NotNil(t, resp.Value.TotalCount)
+		require.NotNil(t, resp.Value.LastPage)
+		assert.Equal(t, 1000, *resp.Value.TotalCount)
+		assert.Equal(t, 1000/perPage, *resp.Value.LastPage)
+		assert.True(t, resp.Value.HasMore)
+
+		// The last page corrects the estimate, as the exact count is known at that point.
+		resp = enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, "/users?count=estimate&page=2", nil).Must(t)
+		require.NotNil(t, resp.Value.TotalCount)
+		require.NotNil(t, resp.Value.LastPage)
+		assert.Equal(t, totalUsers, *resp.Value.TotalCount)
+		assert.Equal(t, 2, *resp.Value.LastPage)
+		assert.False(t, resp.Value.HasMore)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		resp := enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, "/users?count=invalid", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	})
+}
+
+func TestHandler_Expand(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	friend := newPet(db).SaveX(ctx)
+	category1 := newCategory(db).SaveX(ctx)
+	pet1 := newPet(db).AddFriends(friend).AddCategories(category1).SaveX(ctx)
+	user1 := newUser(db).AddPets(pet1).AddFollowedPets(pet1).SaveX(ctx)
+
+	t.Run("read-default", func(t *testing.T) {
+		resp := enttest.Request[ent.Pet](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID), nil).Must(t)
+		assert.Nil(t, resp.Value.Edges.Friends)
+		assert.NotNil(t, resp.Value.Edges.Owner) // Eager-loaded by default.
+	})
+
+	t.Run("read", func(t *testing.T) {
+		resp := enttest.Request[ent.Pet](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?expand=friends,followed_by", nil).Must(t)
+		require.Len(t, resp.Value.Edges.Friends, 1)
+		assert.Equal(t, friend.ID, resp.Value.Edges.Friends[0].ID)
+		require.Len(t, resp.Value.Edges.FollowedBy, 1)
+		assert.Equal(t, user1.ID, resp.Value.Edges.FollowedBy[0].ID)
+	})
+
+	for _, uri := range []string{
+		"/pets?id.eq=" + strconv.Itoa(pet1.ID) + "&expand=friends",
+		"/pets?id.eq=" + strconv.Itoa(pet1.ID) + "&expand=friends&expand=followed_by",
+		"/users/" + user1.ID.String() + "/pets?expand=friends",
+	} {
+		t.Run(uri, func(t *testing.T) {
+			resp := enttest.Request[rest.PagedResponse[ent.Pet]](ctx, s, http.MethodGet, uri, nil).Must(t)
+			require.Len(t, resp.Value.Content, 1)
+			require.Len(t, resp.Value.Content[0].Edges.Friends, 1)
+			assert.Equal(t, friend.ID, resp.Value.Content[0].Edges.Friends[0].ID)
+		})
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		resp := enttest.Request[ent.User](ctx, s, http.MethodGet, "/users/"+user1.ID.String()+"?expand=pets.categories", nil).Must(t)
+		require.Len(t, resp.Value.Edges.Pets, 1)
+		require.Len(t, resp.Value.Edges.Pets[0].Edges.Categories, 1)
+		assert.Equal(t, category1.ID, resp.Value.Edges.Pets[0].Edges.Categories[0].ID)
+
+		respPet := enttest.Request[ent.Pet](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?expand=followed_by.pets", nil).Must(t)
+		require.Len(t, respPet.Value.Edges.FollowedBy, 1)
+		require.Len(t, respPet.Value.Edges.FollowedBy[0].Edges.Pets, 1)
+		assert.Equal(t, pet1.ID, respPet.Value.Edges.FollowedBy[0].Edges.Pets[0].ID)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		resp := enttest.Request[ent.Pet](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?expand=friends.friends", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+
+		resp = enttest.Request[ent.Pet](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?expand=followed_by.pets.categories", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	})
+
+	t.Run("not-allowed", func(t *testing.T) {
+		resp := enttest.Request[ent.Pet](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?expand=owner", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+
+		respList := enttest.Request[rest.PagedResponse[ent.Pet]](ctx, s, http.MethodGet, "/pets?expand=invalid", nil)
+		assert.Equal(t, http.StatusBadRequest, respList.Data.Code)
+	})
+}
+
+func TestHandler_Fields(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	user1 := newUser(db).SaveX(ctx)
+	friend := newPet(db).SaveX(ctx)
+	pet1 := newPet(db).SetOwner(user1).AddFriends(friend).SaveX(ctx)
+
+	t.Run("read", func(t *testing.T) {
+		resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?fields=name,age", nil).Must(t)
+		assert.Equal(t, float64(pet1.ID), (*resp.Value)["id"])
+		assert.Equal(t, pet1.Name, (*resp.Value)["name"])
+		assert.Equal(t, float64(pet1.Age), (*resp.Value)["age"])
+		assert.NotContains(t, *resp.Value, "nicknames")
+		assert.NotContains(t, *resp.Value, "type")
+
+		// Edges are left untouched, unless fields are selected for them.
+		owner := (*resp.Value)["edges"].(map[string]any)["owner"].(map[string]any)
+		assert.Equal(t, *user1.Email, owner["email"])
+	})
+
+	t.Run("read-edge", func(t *testing.T) {
+		resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?fields[owner]=name", nil).Must(t)
+		assert.Equal(t, pet1.Name, (*resp.Value)["name"])
+
+		owner := (*resp.Value)["edges"].(map[string]any)["owner"].(map[string]any)
+		assert.Equal(t, user1.ID.String(), owner["id"])
+		assert.Equal(t, user1.Name, owner["name"])
+		assert.NotContains(t, owner, "email")
+	})
+
+	t.Run("read-expanded-edge", func(t *testing.T) {
+		resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?expand=friends&fields[friends]=name", nil).Must(t)
+
+		friends := (*resp.Value)["edges"].(map[string]any)["friends"].([]any)
+		require.Len(t, friends, 1)
+		assert.Equal(t, map[string]any{"id": float64(friend.ID), "name": friend.Name, "edges": map[string]any{}}, friends[0])
+
+		// Selected fields shouldn't be zeroed out.
+		respPet := enttest.Request[ent.Pet](ctx, s, http.MethodGet, "/pets/"+strconv.Itoa(pet1.ID)+"?fields=name&fields[owner]=email", nil).Must(t)
+		assert.Equal(t, pet1.Name, respPet.Value.Name)
+		require.NotNil(t, respPet.Value.Edges.Owner)
+		assert.Equal(t, user1.Email, respPet.Value.Edges.Owner.Email)
+	})
+
+	for _, uri := range []string{
+		"/pets?id.eq=" + strconv.Itoa(pet1.ID) + "&fields=name",
+		"/users/" + user1.ID.String() + "/pets?fields=name",
+	} {
+		t.Run(uri, func(t *testing.T) {
+			resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, uri, nil).Must(t)
+			assert.Contains(t, *resp.Value, "total_count")
+
+			content := (*resp.Value)["content"].([]any)
+			require.Len(t, content, 1)
+			assert.Equal(t, pet1.Name, content[0].(map[string]any)["name"])
+			assert.NotContains(t, content[0], "age")
+		})
+	}
+
+	t.Run("cursor", func(t *testing.T) {
+		db.Post.CreateBulk(enttest.Multiple(func(db *ent.Client) *ent.PostCreate {
+			return newPost(db, user1)
+		}, db, rest.PostPageConfig.ItemsPerPage*2)...).ExecX(ctx)
+
+		// The sort field isn't selected, but is still needed to build the cursor.
+		resp := enttest.Request[rest.CursorPagedResponse[ent.Post]](ctx, s, http.MethodGet, "/posts?sort=created_at&fields=title", nil).Must(t)
+		require.NotNil(t, resp.Value.NextCursor)
+		require.Len(t, resp.Value.Content, rest.PostPageConfig.ItemsPerPage)
+		assert.NotEmpty(t, resp.Value.Content[0].Title)
+		assert.Empty(t, resp.Value.Content[0].Body)
+
+		c, err := rest.DecodeCursor(*resp.Value.NextCursor)
+		require.NoError(t, err)
+
+		require.Len(t, c.Terms, 1)
+		assert.Equal(t, "created_at", c.Terms[0].Field)
+
+		var createdAt time.Time
+		require.NoError(t, json.Unmarshal(c.Terms[0].Value, &createdAt))
+		assert.False(t, createdAt.IsZero())
+
+		resp = enttest.Request[rest.CursorPagedResponse[ent.Post]](ctx, s, http.MethodGet, "/posts?fields=title", nil).Must(t)
+		require.NotNil(t, resp.Value.NextCursor)
+
+		next := enttest.Request[rest.CursorPagedResponse[ent.Post]](
+			ctx, s, http.MethodGet,
+			"/posts?fields=title&cursor="+url.QueryEscape(*resp.Value.NextCursor),
+			nil,
+		).Must(t)
+		require.NotEmpty(t, next.Value.Content)
+		assert.Greater(t, next.Value.Content[0].ID, resp.Value.Content[len(resp.Value.Content)-1].ID)
+		assert.NotEmpty(t, next.Value.Content[0].Title)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, uri := range []string{
+			"/pets/" + strconv.Itoa(pet1.ID) + "?fields=invalid",
+			"/pets/" + strconv.Itoa(pet1.ID) + "?fields[owner]=invalid",
+			"/pets/" + strconv.Itoa(pet1.ID) + "?fields[friends]=name", // Not expanded.
+			"/pets/" + strconv.Itoa(pet1.ID) + "?fields[invalid]=name",
+			"/pets?fields=invalid",
+		} {
+			resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, uri, nil)
+			assert.Equal(t, http.StatusBadRequest, resp.Data.Code, uri)
+		}
+	})
+}
+
 func TestHandler_Create(t *testing.T) {
 	ctx, db, s := newRestServer(t, nil)
 	t.Cleanup(func() { db.Close() })
@@ -774,6 +1064,88 @@ func TestHandler_SortRandom(t *testing.T) {
 	}
 }
 
+func TestHandler_SortMultiple(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	pet1 := newPet(db).SetName("a").SetAge(2).SaveX(ctx)
+	pet2 := newPet(db).SetName("b").SetAge(5).SaveX(ctx)
+	pet3 := newPet(db).SetName("a").SetAge(5).SaveX(ctx)
+	pet4 := newPet(db).SetName("a").SetAge(5).SaveX(ctx) // Same as pet3, so ordered by ID.
+
+	tests := []struct {
+		uri string
+		ids []int
+	}{
+		{uri: "/pets?sort=-age,name", ids: []int{pet3.ID, pet4.ID, pet2.ID, pet1.ID}},
+		{uri: "/pets?sort=-age&sort=name", ids: []int{pet3.ID, pet4.ID, pet2.ID, pet1.ID}},
+		// Order only applies to fields without a prefix.
+		{uri: "/pets?sort=age,name&order=desc&sort=-id", ids: []int{pet2.ID, pet4.ID, pet3.ID, pet1.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			resp := enttest.Request[rest.PagedResponse[ent.Pet]](ctx, s, http.MethodGet, tt.uri, nil).Must(t)
+
+			var ids []int
+			for _, p := range resp.Value.Content {
+				ids = append(ids, p.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+
+	for _, uri := range []string{
+		"/pets?sort=name,-name",
+		"/pets?sort=random,name",
+		"/pets?sort=-invalid",
+	} {
+		t.Run(uri, func(t *testing.T) {
+			resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, uri, nil)
+			assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+		})
+	}
+}
+
+func TestHandler_SortNulls(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	user1 := newUser(db).SetEmail("a@example.com").SaveX(ctx)
+	user2 := newUser(db).SaveX(ctx).Update().ClearEmail().SaveX(ctx)
+	user3 := newUser(db).SetEmail("b@example.com").SaveX(ctx)
+
+	tests := []struct {
+		uri string
+		ids []uuid.UUID
+	}{
+		// Email defaults to placing NULL values last, regardless of the order.
+		{uri: "/users?sort=email", ids: []uuid.UUID{user1.ID, user3.ID, user2.ID}},
+		{uri: "/users?sort=-email", ids: []uuid.UUID{user3.ID, user1.ID, user2.ID}},
+		{uri: "/users?sort=email&nulls=first", ids: []uuid.UUID{user2.ID, user1.ID, user3.ID}},
+		{uri: "/users?sort=-email&nulls=first", ids: []uuid.UUID{user2.ID, user3.ID, user1.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			resp := enttest.Request[rest.PagedResponse[ent.User]](ctx, s, http.MethodGet, tt.uri, nil).Must(t)
+
+			var ids []uuid.UUID
+			for _, u := range resp.Value.Content {
+				ids = append(ids, u.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+
+	resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/users?sort=email&nulls=middle", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+}
+
 func TestHandler_StrictMutate(t *testing.T) {
 	ctx, db, s := newRestServer(t, nil)
 	t.Cleanup(func() { db.Close() })
@@ -905,3 +1277,1151 @@ func TestHandler_FilterGroups(t *testing.T) {
 		assert.Equal(t, user1.ID, resp.Value.Content[0].ID)
 	}
 }
+
+func TestHandler_FilterExpression(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	alice := newUser(db).SetName("alice").SaveX(ctx)
+	john := newUser(db).SetName("john").SaveX(ctx)
+
+	pet1 := newPet(db).SetType(pet.TypeDog).SetAge(5).SetOwner(alice).SaveX(ctx)
+	pet2 := newPet(db).SetType(pet.TypeDog).SetAge(2).SetOwner(alice).SaveX(ctx)
+	pet3 := newPet(db).SetType(pet.TypeCat).SetAge(5).SetOwner(john).SaveX(ctx)
+	pet4 := newPet(db).SetType(pet.TypeCat).SetAge(1).SetOwner(alice).SaveX(ctx)
+
+	tests := []struct {
+		name  string
+		query string
+		ids   []int
+	}{
+		{
+			name:  "or-with-implicit-and",
+			query: "filter=" + url.QueryEscape(`{"or": [{"type.eq": "DOG", "age.gt": 3}, {"owner.name.eq": "john"}]}`),
+			ids:   []int{pet1.ID, pet3.ID},
+		},
+		{
+			name:  "nested-groups",
+			query: "filter=" + url.QueryEscape(`{"or": [{"and": [{"type.eq": "CAT"}, {"age.lt": 3}]}, {"age.in": [2]}]}`),
+			ids:   []int{pet2.ID, pet4.ID},
+		},
+		{
+			name:  "not",
+			query: "filter=" + url.QueryEscape(`{"not": {"type.eq": "DOG"}}`),
+			ids:   []int{pet3.ID, pet4.ID},
+		},
+		{
+			name:  "combined-with-parameters",
+			query: "age.gt=3&filter=" + url.QueryEscape(`{"not": {"type.eq": "DOG"}}`),
+			ids:   []int{pet3.ID},
+		},
+		{
+			name:  "niladic",
+			query: "filter=" + url.QueryEscape(`{"has.owner": true, "owner.name.neq": "john", "age.gt": 1}`),
+			ids:   []int{pet1.ID, pet2.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := enttest.Request[rest.PagedResponse[ent.Pet]](ctx, s, http.MethodGet, "/pets?sort=id&"+tt.query, nil).Must(t)
+
+			var ids []int
+			for _, p := range resp.Value.Content {
+				ids = append(ids, p.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+
+	for _, filter := range []string{
+		`not json`,
+		`[]`,
+		`{}`,
+		`{"unknown.eq": 1}`,
+		`{"age.gt": "five"}`,
+		`{"age.gt": null}`,
+		`{"or": []}`,
+		`{"and": {"age.gt": 1}}`,
+		`{"not": {"not": {"not": {"not": {"not": {"not": {"not": {"not": {"not": {"not": {"age.gt": 1}}}}}}}}}}}`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/pets?filter="+url.QueryEscape(filter), nil)
+			assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+		})
+	}
+}
+
+func TestHandler_Search(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	owner := newUser(db).SetName("john").SaveX(ctx)
+
+	pet1 := newPet(db).SetName("a").SetType(pet.TypeDog).SetAge(5).SaveX(ctx)
+	pet2 := newPet(db).SetName("b").SetType(pet.TypeCat).SetAge(5).SetOwner(owner).SaveX(ctx)
+	newPet(db).SetName("c").SetType(pet.TypeCat).SetAge(2).ExecX(ctx)
+	pet4 := newPet(db).SetName("d").SetType(pet.TypeDog).SetAge(1).SaveX(ctx)
+
+	tests := []struct {
+		name string
+		body map[string]any
+		ids  []int
+	}{
+		{
+			name: "filter-expression",
+			body: map[string]any{
+				"filter": map[string]any{"or": []any{
+					map[string]any{"type.eq": "DOG"},
+					map[string]any{"owner.name.eq": "john"},
+				}},
+				"sort": []string{"-age", "name"},
+			},
+			ids: []int{pet1.ID, pet2.ID, pet4.ID},
+		},
+		{
+			name: "filter-expression-string",
+			body: map[string]any{"filter": `{"age.gt": 4}`, "sort": []string{"-name"}},
+			ids:  []int{pet2.ID, pet1.ID},
+		},
+		{
+			name: "sort-string",
+			body: map[string]any{"filter": map[string]any{"type.eq": "DOG"}, "sort": "-name"},
+			ids:  []int{pet4.ID, pet1.ID},
+		},
+		{
+			name: "filter-parameters",
+			body: map[string]any{"pet_id_in": []int{pet1.ID, pet2.ID, pet4.ID}, "pet_age_gt": 1, "sort": []string{"-name"}, "per_page": 1},
+			ids:  []int{pet2.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := enttest.Request[rest.PagedResponse[ent.Pet]](ctx, s, http.MethodPost, "/pets/search", tt.body).Must(t)
+			assert.Equal(t, http.StatusOK, resp.Data.Code)
+
+			var ids []int
+			for _, p := range resp.Value.Content {
+				ids = append(ids, p.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+
+	resp := enttest.Request[map[string]any](ctx, s, http.MethodPost, "/pets/search", map[string]any{"sort": []string{"invalid"}})
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+
+	resp = enttest.Request[map[string]any](ctx, s, http.MethodPost, "/pets/search", map[string]any{"sort": 1})
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+
+	// Search isn't enabled for users.
+	resp = enttest.Request[map[string]any](ctx, s, http.MethodPost, "/users/search", map[string]any{})
+	assert.NotEqual(t, http.StatusOK, resp.Data.Code)
+}
+
+func TestHandler_BulkCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	owner := newUser(db).SaveX(ctx)
+
+	resp := enttest.Request[[]*ent.Pet](ctx, s, http.MethodPost, "/pets/bulk", []map[string]any{
+		{"name": "c", "age": 3, "type": "CAT", "owner": owner.ID},
+		{"name": "a", "age": 1, "type": "DOG"},
+		{"name": "b", "age": 2, "type": "BIRD"},
+	}).Must(t)
+	assert.Equal(t, http.StatusCreated, resp.Data.Code)
+
+	pets := *resp.Value
+	if assert.Len(t, pets, 3) {
+		assert.Equal(t, []string{"c", "a", "b"}, []string{pets[0].Name, pets[1].Name, pets[2].Name})
+		// Eager-loaded edges should be returned.
+		if assert.NotNil(t, pets[0].Edges.Owner) {
+			assert.Equal(t, owner.ID, pets[0].Edges.Owner.ID)
+		}
+	}
+
+	// Any invalid entity should prevent all entities from being created, and each
+	// invalid entity should be reported.
+	errResp := enttest.Request[[]*ent.Pet](ctx, s, http.MethodPost, "/pets/bulk", []map[string]any{
+		{"name": "d", "age": 4, "type": "DOG"},
+		{"name": "e", "age": 100, "type": "DOG"},
+		{"name": "f", "age": 6, "type": "INVALID"},
+	})
+	assert.Equal(t, http.StatusBadRequest, errResp.Data.Code)
+	if assert.NotNil(t, errResp.Error) {
+		assert.Contains(t, errResp.Error.Error, `item 1: validator failed for field "age"`)
+		assert.Contains(t, errResp.Error.Error, `item 2: validator failed for field "type"`)
+		assert.NotContains(t, errResp.Error.Error, "item 0:")
+	}
+	assert.Equal(t, 3, db.Pet.Query().CountX(ctx))
+
+	errResp = enttest.Request[[]*ent.Pet](ctx, s, http.MethodPost, "/pets/bulk", []map[string]any{})
+	assert.Equal(t, http.StatusBadRequest, errResp.Data.Code)
+}
+
+func TestHandler_Count(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	newPet(db).SetName("a").SetType(pet.TypeDog).SetAge(1).ExecX(ctx)
+	newPet(db).SetName("b").SetType(pet.TypeDog).SetAge(2).ExecX(ctx)
+	newPet(db).SetName("c").SetType(pet.TypeCat).SetAge(3).ExecX(ctx)
+
+	resp := enttest.Request[rest.CountResult](ctx, s, http.MethodGet, "/pets/count", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, 3, resp.Value.Count)
+
+	resp = enttest.Request[rest.CountResult](ctx, s, http.MethodGet, "/pets/count?type.eq=DOG", nil).Must(t)
+	assert.Equal(t, 2, resp.Value.Count)
+
+	resp = enttest.Request[rest.CountResult](ctx, s, http.MethodHead, "/pets?type.eq=CAT", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, "1", resp.Data.Header().Get("X-Total-Count"))
+	assert.Empty(t, resp.Data.Body.String())
+}
+
+func TestHandler_Aggregate(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	newPet(db).SetName("a").SetType(pet.TypeDog).SetAge(2).ExecX(ctx)
+	newPet(db).SetName("b").SetType(pet.TypeDog).SetAge(4).ExecX(ctx)
+	newPet(db).SetName("c").SetType(pet.TypeCat).SetAge(9).ExecX(ctx)
+
+	resp := enttest.Request[[]rest.PetAggregateRow](ctx, s, http.MethodGet, "/pets/aggregate?group_by=type&sum=age&avg=age&max=age", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, []rest.PetAggregateRow{
+		{Type: ptr(pet.TypeCat), Count: 1, AgeSum: ptr(9), AgeAvg: ptr(9.0), AgeMax: ptr(9)},
+		{Type: ptr(pet.TypeDog), Count: 2, AgeSum: ptr(6), AgeAvg: ptr(3.0), AgeMax: ptr(4)},
+	}, *resp.Value)
+
+	// Without grouping, a single row is returned for all matching entities.
+	resp = enttest.Request[[]rest.PetAggregateRow](ctx, s, http.MethodGet, "/pets/aggregate?min=age&type.eq=DOG", nil).Must(t)
+	assert.Equal(t, []rest.PetAggregateRow{{Count: 2, AgeMin: ptr(2)}}, *resp.Value)
+
+	resp = enttest.Request[[]rest.PetAggregateRow](ctx, s, http.MethodGet, "/pets/aggregate?group_by=name", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	resp = enttest.Request[[]rest.PetAggregateRow](ctx, s, http.MethodGet, "/pets/aggregate?sum=type", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+}
+
+func TestHandler_Distinct(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	newPet(db).SetName("a").SetType(pet.TypeDog).SetAge(2).ExecX(ctx)
+	newPet(db).SetName("b").SetType(pet.TypeDog).SetAge(4).ExecX(ctx)
+	newPet(db).SetName("c").SetType(pet.TypeCat).SetAge(4).ExecX(ctx)
+
+	resp := enttest.Request[rest.DistinctResponse[pet.Type]](ctx, s, http.MethodGet, "/pets/distinct/type", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, []pet.Type{pet.TypeCat, pet.TypeDog}, resp.Value.Content)
+	assert.True(t, resp.Value.IsLastPage)
+	assert.False(t, resp.Value.HasMore)
+
+	resp = enttest.Request[rest.DistinctResponse[pet.Type]](ctx, s, http.MethodGet, "/pets/distinct/type?age.eq=2", nil).Must(t)
+	assert.Equal(t, []pet.Type{pet.TypeDog}, resp.Value.Content)
+
+	resp = enttest.Request[rest.DistinctResponse[pet.Type]](ctx, s, http.MethodGet, "/pets/distinct/type?per_page=1", nil).Must(t)
+	assert.Equal(t, []pet.Type{pet.TypeCat}, resp.Value.Content)
+	assert.Equal(t, 1, resp.Value.Page)
+	assert.True(t, resp.Value.HasMore)
+	assert.False(t, resp.Value.IsLastPage)
+
+	resp = enttest.Request[rest.DistinctResponse[pet.Type]](ctx, s, http.MethodGet, "/pets/distinct/type?per_page=1&page=2", nil).Must(t)
+	assert.Equal(t, []pet.Type{pet.TypeDog}, resp.Value.Content)
+	assert.Equal(t, 2, resp.Value.Page)
+	assert.False(t, resp.Value.HasMore)
+	assert.True(t, resp.Value.IsLastPage)
+
+	resp = enttest.Request[rest.DistinctResponse[pet.Type]](ctx, s, http.MethodGet, "/pets/distinct/type?page=3", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Empty(t, resp.Value.Content)
+
+	resp = enttest.Request[rest.DistinctResponse[pet.Type]](ctx, s, http.MethodGet, "/pets/distinct/type?page=0", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+}
+
+func TestHandler_EdgeCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	user1 := newUser(db).SaveX(ctx)
+	user2 := newUser(db).SaveX(ctx)
+
+	data := map[string]any{
+		"name":  gofakeit.FirstName(),
+		"age":   gofakeit.Number(1, 20),
+		"type":  pet.TypeCat,
+		"owner": user2.ID,
+	}
+
+	// The owner is provided through the path, so it isn't accepted in the body (StrictMutate
+	// rejects unknown fields).
+	resp := enttest.Request[ent.Pet](ctx, s, http.MethodPost, "/users/"+user1.ID.String()+"/pets", data)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	assert.Equal(t, 0, db.Pet.Query().CountX(ctx))
+
+	delete(data, "owner")
+
+	resp = enttest.Request[ent.Pet](ctx, s, http.MethodPost, "/users/"+user1.ID.String()+"/pets", data).Must(t)
+	assert.Equal(t, http.StatusCreated, resp.Data.Code)
+	assert.Equal(t, data["name"], resp.Value.Name)
+
+	pet1 := rest.EagerLoadPet(db.Pet.Query().Where(pet.ID(resp.Value.ID))).OnlyX(ctx)
+	assert.Equal(t, user1.ID, pet1.Edges.Owner.ID)
+
+	resp = enttest.Request[ent.Pet](ctx, s, http.MethodPost, "/users/"+uuid.New().String()+"/pets", data)
+	assert.Equal(t, http.StatusNotFound, resp.Data.Code)
+	assert.Equal(t, 1, db.Pet.Query().CountX(ctx))
+}
+
+func TestHandler_Subentity(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	pet1 := newPet(db).SaveX(ctx)
+	pet2 := newPet(db).SaveX(ctx)
+	vacc := db.Vaccination.Create().SetName("rabies").SetPet(pet1).SaveX(ctx)
+
+	uri := "/pets/" + strconv.Itoa(pet1.ID) + "/vaccinations/" + strconv.Itoa(vacc.ID)
+	otherURI := "/pets/" + strconv.Itoa(pet2.ID) + "/vaccinations/" + strconv.Itoa(vacc.ID)
+
+	resp := enttest.Request[ent.Vaccination](ctx, s, http.MethodGet, uri, nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, "rabies", resp.Value.Name)
+
+	resp = enttest.Request[ent.Vaccination](ctx, s, http.MethodPatch, uri, map[string]any{"name": "distemper"}).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, "distemper", resp.Value.Name)
+
+	// Subentities which don't belong to the parent in the path can't be accessed.
+	for _, method := range []string{http.MethodGet, http.MethodPatch, http.MethodDelete} {
+		resp = enttest.Request[ent.Vaccination](ctx, s, method, otherURI, map[string]any{"name": "other"})
+		assert.Equal(t, http.StatusNotFound, resp.Data.Code, method)
+	}
+	assert.Equal(t, "distemper", db.Vaccination.GetX(ctx, vacc.ID).Name)
+
+	// Subentities don't have top-level endpoints.
+	resp = enttest.Request[ent.Vaccination](ctx, s, http.MethodGet, "/vaccinations/"+strconv.Itoa(vacc.ID), nil)
+	assert.Equal(t, http.StatusNotFound, resp.Data.Code)
+
+	resp = enttest.Request[ent.Vaccination](ctx, s, http.MethodDelete, uri, nil).Must(t)
+	assert.Equal(t, http.StatusNoContent, resp.Data.Code)
+	assert.False(t, db.Vaccination.Query().Where(vaccination.ID(vacc.ID)).ExistX(ctx))
+}
+
+func TestHandler_EdgeLink(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	pet1 := newPet(db).SaveX(ctx)
+	pet2 := newPet(db).SaveX(ctx)
+	uri := "/pets/" + strconv.Itoa(pet1.ID) + "/friends/" + strconv.Itoa(pet2.ID)
+
+	resp := enttest.Request[string](ctx, s, http.MethodPut, uri, nil).Must(t)
+	assert.Equal(t, http.StatusNoContent, resp.Data.Code)
+	assert.Equal(t, []int{pet2.ID}, pet1.QueryFriends().IDsX(ctx))
+
+	// Linking again should have no effect.
+	resp = enttest.Request[string](ctx, s, http.MethodPut, uri, nil).Must(t)
+	assert.Equal(t, http.StatusNoContent, resp.Data.Code)
+	assert.Equal(t, []int{pet2.ID}, pet1.QueryFriends().IDsX(ctx))
+
+	resp = enttest.Request[string](ctx, s, http.MethodPut, "/pets/"+strconv.Itoa(pet1.ID)+"/friends/1000", nil)
+	assert.Equal(t, http.StatusNotFound, resp.Data.Code)
+
+	resp = enttest.Request[string](ctx, s, http.MethodPut, "/pets/1000/friends/"+strconv.Itoa(pet2.ID), nil)
+	assert.Equal(t, http.StatusNotFound, resp.Data.Code)
+
+	resp = enttest.Request[string](ctx, s, http.MethodDelete, uri, nil).Must(t)
+	assert.Equal(t, http.StatusNoContent, resp.Data.Code)
+	assert.Empty(t, pet1.QueryFriends().IDsX(ctx))
+	assert.True(t, db.Pet.Query().Where(pet.ID(pet2.ID)).ExistX(ctx))
+
+	// Unique edges can't be linked/unlinked.
+	resp = enttest.Request[string](ctx, s, http.MethodPut, "/pets/"+strconv.Itoa(pet1.ID)+"/owner/1", nil)
+	require.NotNil(t, resp.Error)
+	assert.NotEqual(t, http.StatusNoContent, resp.Data.Code)
+}
+
+func TestHandler_CompositeID(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	user1 := newUser(db).SaveX(ctx)
+	pet1 := newPet(db).SaveX(ctx)
+	pet2 := newPet(db).SaveX(ctx)
+	db.Follows.Create().SetUser(user1).SetPet(pet1).ExecX(ctx)
+	db.Follows.Create().SetUser(user1).SetPet(pet2).ExecX(ctx)
+	uri := "/follows/" + user1.ID.String() + "/" + strconv.Itoa(pet1.ID)
+
+	resp := enttest.Request[ent.Follows](ctx, s, http.MethodGet, uri, nil).Must(t)
+	assert.Equal(t, user1.ID, resp.Value.UserID)
+	assert.Equal(t, pet1.ID, resp.Value.PetID)
+	require.NotNil(t, resp.Value.Edges.Pet)
+	assert.Equal(t, pet1.ID, resp.Value.Edges.Pet.ID)
+
+	resp = enttest.Request[ent.Follows](ctx, s, http.MethodPatch, uri, map[string]any{}).Must(t)
+	assert.Equal(t, pet1.ID, resp.Value.PetID)
+
+	respErr := enttest.Request[string](ctx, s, http.MethodGet, "/follows/"+user1.ID.String()+"/1000", nil)
+	assert.Equal(t, http.StatusNotFound, respErr.Data.Code)
+
+	respErr = enttest.Request[string](ctx, s, http.MethodGet, "/follows/invalid/"+strconv.Itoa(pet1.ID), nil)
+	assert.Equal(t, http.StatusBadRequest, respErr.Data.Code)
+
+	respErr = enttest.Request[string](ctx, s, http.MethodDelete, uri, nil).Must(t)
+	assert.Equal(t, http.StatusNoContent, respErr.Data.Code)
+	assert.Equal(t, []int{pet2.ID}, user1.QueryFollowedPets().IDsX(ctx))
+
+	respErr = enttest.Request[string](ctx, s, http.MethodDelete, uri, nil)
+	assert.Equal(t, http.StatusNotFound, respErr.Data.Code)
+}
+
+func TestHandler_LookupKey(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	author := newUser(db).SaveX(ctx)
+	post1 := newPost(db, author).SetSlug("hello-world").SaveX(ctx)
+
+	resp := enttest.Request[ent.Post](ctx, s, http.MethodGet, "/posts/by/slug/hello-world", nil).Must(t)
+	assert.Equal(t, post1.ID, resp.Value.ID)
+	require.NotNil(t, resp.Value.Edges.Author)
+	assert.Equal(t, author.ID, resp.Value.Edges.Author.ID)
+
+	resp = enttest.Request[ent.Post](ctx, s, http.MethodPatch, "/posts/by/slug/hello-world", map[string]any{
+		"title": "An updated title",
+	}).Must(t)
+	assert.Equal(t, post1.ID, resp.Value.ID)
+	assert.Equal(t, "An updated title", resp.Value.Title)
+
+	respErr := enttest.Request[string](ctx, s, http.MethodGet, "/posts/by/slug/does-not-exist", nil)
+	assert.Equal(t, http.StatusNotFound, respErr.Data.Code)
+
+	respErr = enttest.Request[string](ctx, s, http.MethodDelete, "/posts/by/slug/hello-world", nil).Must(t)
+	assert.Equal(t, http.StatusNoContent, respErr.Data.Code)
+	assert.False(t, db.Post.Query().Where(post.ID(post1.ID)).ExistX(ctx))
+}
+
+func TestHandler_BulkDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	newPet(db).SetName("a").SetType(pet.TypeDog).SetAge(1).ExecX(ctx)
+	newPet(db).SetName("b").SetType(pet.TypeDog).SetAge(2).ExecX(ctx)
+	kept := newPet(db).SetName("c").SetType(pet.TypeCat).SetAge(3).SaveX(ctx)
+
+	resp := enttest.Request[rest.BulkResult](ctx, s, http.MethodDelete, "/pets?type.eq=DOG&dry_run=true", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, rest.BulkResult{Affected: 2, DryRun: true}, *resp.Value)
+	assert.Equal(t, 3, db.Pet.Query().CountX(ctx))
+
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodDelete, "/pets?type.eq=DOG", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, rest.BulkResult{Affected: 2}, *resp.Value)
+	assert.Equal(t, []int{kept.ID}, db.Pet.Query().IDsX(ctx))
+
+	// Filters are required, so the whole table can't be deleted by accident.
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodDelete, "/pets", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+
+	// Only annotated filters can be used.
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodDelete, "/pets?description.eq=foo", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	assert.Equal(t, 1, db.Pet.Query().CountX(ctx))
+
+	// Requests matching more than the maximum number of entities are rejected.
+	builders := make([]*ent.PetCreate, rest.PetMaxBulkAffected+1)
+	for i := range builders {
+		builders[i] = newPet(db).SetName("bulk").SetType(pet.TypeFish).SetAge(1)
+	}
+	db.Pet.CreateBulk(builders...).ExecX(ctx)
+
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodDelete, "/pets?type.eq=FISH", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	assert.Equal(t, rest.PetMaxBulkAffected+2, db.Pet.Query().CountX(ctx))
+
+	// Dry runs still return the number of matching entities, so filters can be narrowed down.
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodDelete, "/pets?type.eq=FISH&dry_run=true", nil).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, rest.BulkResult{Affected: rest.PetMaxBulkAffected + 1, DryRun: true}, *resp.Value)
+	assert.Equal(t, rest.PetMaxBulkAffected+2, db.Pet.Query().CountX(ctx))
+}
+
+func TestHandler_BulkUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	pet1 := newPet(db).SetName("a").SetType(pet.TypeDog).SetAge(1).SaveX(ctx)
+	pet2 := newPet(db).SetName("b").SetType(pet.TypeDog).SetAge(2).SaveX(ctx)
+	pet3 := newPet(db).SetName("c").SetType(pet.TypeCat).SetAge(3).SaveX(ctx)
+
+	resp := enttest.Request[rest.BulkResult](ctx, s, http.MethodPatch, "/pets?type.eq=DOG&dry_run=true", map[string]any{"age": 10}).Must(t)
+	assert.Equal(t, rest.BulkResult{Affected: 2, DryRun: true}, *resp.Value)
+	assert.Equal(t, 1, db.Pet.GetX(ctx, pet1.ID).Age)
+
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodPatch, "/pets?type.eq=DOG", map[string]any{"age": 10}).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, rest.BulkResult{Affected: 2}, *resp.Value)
+	assert.Equal(t, 10, db.Pet.GetX(ctx, pet1.ID).Age)
+	assert.Equal(t, 10, db.Pet.GetX(ctx, pet2.ID).Age)
+	assert.Equal(t, 3, db.Pet.GetX(ctx, pet3.ID).Age)
+
+	// Validators still apply.
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodPatch, "/pets?type.eq=DOG", map[string]any{"age": 100})
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	assert.Equal(t, 10, db.Pet.GetX(ctx, pet1.ID).Age)
+
+	resp = enttest.Request[rest.BulkResult](ctx, s, http.MethodPatch, "/pets", map[string]any{"age": 5})
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	assert.Equal(t, 3, db.Pet.GetX(ctx, pet3.ID).Age)
+}
+
+func TestHandler_CursorPagination(t *testing.T) {
+	ctx, db, s := newRestServer(t, &rest.ServerConfig{EnableLinks: true})
+	t.Cleanup(func() { db.Close() })
+
+	user1 := newUser(db).SaveX(ctx)
+	totalPosts := rest.PostPageConfig.ItemsPerPage*2 + 5
+
+	// Only use a few distinct timestamps, so the cursor has to handle ties on them.
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	var created int
+	db.Post.CreateBulk(enttest.Multiple(func(db *ent.Client) *ent.PostCreate {
+		created++
+		return newPost(db, user1).SetCreatedAt(base.Add(time.Duration(created%3) * time.Minute))
+	}, db, totalPosts)...).ExecX(ctx)
+
+	for _, uri := range []string{
+		"/posts",
+		"/posts?sort=created_at&order=desc",
+		"/posts?sort=-created_at,updated_at",
+		"/posts?sort=created_at,-id,updated_at",
+		"/users/" + user1.ID.String() + "/posts",
+	} {
+		t.Run(uri, func(t *testing.T) {
+			var ids []int
+			next := uri
+
+			for range 10 {
+				resp := enttest.Request[rest.CursorPagedResponse[ent.Post]](ctx, s, http.MethodGet, next, nil).Must(t)
+				require.Equal(t, http.StatusOK, resp.Data.Code)
+
+				for _, p := range resp.Value.Content {
+					ids = append(ids, p.ID)
+				}
+
+				if resp.Value.IsLastPage {
+					assert.Nil(t, resp.Value.NextCursor)
+					assert.NotContains(t, resp.Data.Header().Get("Link"), `rel="next"`)
+					break
+				}
+
+				require.NotNil(t, resp.Value.NextCursor)
+				require.Len(t, resp.Value.Content, rest.PostPageConfig.ItemsPerPage)
+				assert.Contains(t, resp.Data.Header().Get("Link"), url.QueryEscape(*resp.Value.NextCursor))
+
+				u, err := url.Parse(next)
+				require.NoError(t, err)
+				query := u.Query()
+				query.Set("cursor", *resp.Value.NextCursor)
+				u.RawQuery = query.Encode()
+				next = u.String()
+			}
+
+			require.Len(t, ids, totalPosts)
+			assert.Len(t, slices.Compact(slices.Sorted(slices.Values(ids))), totalPosts, "expected no duplicate posts across pages")
+		})
+	}
+
+	t.Run("invalid-cursor", func(t *testing.T) {
+		resp := enttest.Request[rest.CursorPagedResponse[ent.Post]](ctx, s, http.MethodGet, "/posts?cursor=invalid", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	})
+
+	t.Run("mismatched-sort", func(t *testing.T) {
+		resp := enttest.Request[rest.CursorPagedResponse[ent.Post]](ctx, s, http.MethodGet, "/posts", nil).Must(t)
+		require.NotNil(t, resp.Value.NextCursor)
+
+		resp = enttest.Request[rest.CursorPagedResponse[ent.Post]](
+			ctx, s, http.MethodGet,
+			"/posts?sort=created_at&cursor="+url.QueryEscape(*resp.Value.NextCursor),
+			nil,
+		)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+	})
+}
+
+// requestWithHeaders executes a request against the handler, with the provided headers.
+func requestWithHeaders(t *testing.T, handler http.Handler, method, path string, headers http.Header, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		r = bytes.NewReader(b)
+	}
+
+	req := httptest.NewRequest(method, path, r)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func newRestHandler(t *testing.T) (ctx context.Context, db *ent.Client, handler http.Handler) {
+	t.Helper()
+	ctx = context.Background()
+	db = newClient(t)
+	t.Cleanup(func() { db.Close() })
+
+	srv, err := rest.NewServer(db, nil)
+	require.NoError(t, err)
+	return ctx, db, srv.Handler()
+}
+
+func TestHandler_ETag(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, handler := newRestHandler(t)
+
+	request := func(method, path, ifMatch string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		headers := http.Header{}
+		if ifMatch != "" {
+			headers.Set("If-Match", ifMatch)
+		}
+		return requestWithHeaders(t, handler, method, path, headers, body)
+	}
+
+	p := newPet(db).SaveX(ctx)
+	uri := "/pets/" + strconv.Itoa(p.ID)
+
+	resp := request(http.MethodGet, uri, "", nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	etag := resp.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// Conditional GET requests are also enabled, so the ETag of the response is prefixed with the
+	// ETag of the entity.
+	assert.True(t, strings.HasPrefix(etag, strings.TrimSuffix(rest.PetETag(p), `"`)+"-"), etag)
+
+	// Partial responses have an ETag of their own, which can't be used with If-Match.
+	resp = request(http.MethodGet, uri+"?fields=name", "", nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.NotEmpty(t, resp.Header().Get("ETag"))
+	assert.NotEqual(t, etag, resp.Header().Get("ETag"))
+
+	// Without If-Match, updates are unconditional.
+	resp = request(http.MethodPatch, uri, "", map[string]any{"name": "first"})
+	require.Equal(t, http.StatusOK, resp.Code)
+	updated := resp.Header().Get("ETag")
+	assert.NotEqual(t, etag, updated)
+
+	// Stale ETags are rejected, and the entity is left untouched.
+	resp = request(http.MethodPatch, uri, etag, map[string]any{"name": "second"})
+	assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+	assert.Equal(t, "first", db.Pet.GetX(ctx, p.ID).Name)
+
+	resp = request(http.MethodPatch, uri, etag+", "+updated, map[string]any{"name": "second"})
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "second", db.Pet.GetX(ctx, p.ID).Name)
+
+	resp = request(http.MethodPatch, uri, "*", map[string]any{"name": "third"})
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	resp = request(http.MethodDelete, uri, etag, nil)
+	assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+	assert.True(t, db.Pet.Query().Where(pet.ID(p.ID)).ExistX(ctx))
+
+	resp = request(http.MethodDelete, uri, rest.PetETag(db.Pet.GetX(ctx, p.ID)), nil)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	// The entity no longer exists, so there is nothing to match against.
+	resp = request(http.MethodDelete, uri, "*", nil)
+	assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+
+	t.Run("edges", func(t *testing.T) {
+		p1 := newPet(db).SaveX(ctx)
+		p2 := newPet(db).SaveX(ctx)
+		vacc := db.Vaccination.Create().SetName("rabies").SetPet(p1).SaveX(ctx)
+		stale := rest.PetETag(p1)
+
+		// Link/unlink are compared against the ETag of the entity the edge belongs to.
+		linkURI := "/pets/" + strconv.Itoa(p1.ID) + "/friends/" + strconv.Itoa(p2.ID)
+		db.Pet.UpdateOne(p1).SetName("changed").ExecX(ctx)
+
+		for _, method := range []string{http.MethodPut, http.MethodDelete} {
+			resp := request(method, linkURI, stale, nil)
+			assert.Equal(t, http.StatusPreconditionFailed, resp.Code, method)
+		}
+		assert.Empty(t, p1.QueryFriends().IDsX(ctx))
+
+		resp := request(http.MethodPut, linkURI, rest.PetETag(db.Pet.GetX(ctx, p1.ID)), nil)
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Equal(t, []int{p2.ID}, p1.QueryFriends().IDsX(ctx))
+
+		// Nested subentity operations are compared against the ETag of the subentity.
+		vaccURI := "/pets/" + strconv.Itoa(p1.ID) + "/vaccinations/" + strconv.Itoa(vacc.ID)
+
+		resp = request(http.MethodPatch, vaccURI, rest.PetETag(p1), map[string]any{"name": "distemper"})
+		assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+
+		resp = request(http.MethodPatch, vaccURI, rest.VaccinationETag(vacc), map[string]any{"name": "distemper"})
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		resp = request(http.MethodDelete, vaccURI, rest.VaccinationETag(vacc), nil)
+		assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+		assert.True(t, db.Vaccination.Query().Where(vaccination.ID(vacc.ID)).ExistX(ctx))
+
+		resp = request(http.MethodDelete, vaccURI, rest.VaccinationETag(db.Vaccination.GetX(ctx, vacc.ID)), nil)
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+	})
+}
+
+func TestHandler_ETagConcurrent(t *testing.T) {
+	t.Parallel()
+
+	// Each connection to an in-memory database has its own database, so a file is used
+	// instead. Immediate transactions make concurrent writers wait for each other, rather
+	// than failing.
+	ctx := context.Background()
+	db := openClient(t, "file:"+filepath.Join(t.TempDir(), "ent.db")+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(10000)&_txlock=immediate")
+	t.Cleanup(func() { db.Close() })
+
+	srv, err := rest.NewServer(db, nil)
+	require.NoError(t, err)
+	handler := srv.Handler()
+
+	p := newPet(db).SaveX(ctx)
+	headers := http.Header{"If-Match": {rest.PetETag(p)}}
+
+	// The check and the update are atomic, so only one of the requests using the same
+	// ETag can succeed.
+	codes := make([]int, 5)
+	var wg sync.WaitGroup
+	for i := range codes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes[i] = requestWithHeaders(
+				t, handler, http.MethodPatch, "/pets/"+strconv.Itoa(p.ID), headers,
+				map[string]any{"name": "concurrent-" + strconv.Itoa(i)},
+			).Code
+		}()
+	}
+	wg.Wait()
+
+	var succeeded int
+	for _, code := range codes {
+		if code == http.StatusOK {
+			succeeded++
+			continue
+		}
+		assert.Equal(t, http.StatusPreconditionFailed, code)
+	}
+	assert.Equal(t, 1, succeeded, codes)
+}
+
+func TestHandler_ConditionalGET(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, handler := newRestHandler(t)
+
+	author := newUser(db).SaveX(ctx)
+	p := newPost(db, author).SaveX(ctx)
+	uri := "/posts/" + strconv.Itoa(p.ID)
+
+	resp := requestWithHeaders(t, handler, http.MethodGet, uri, nil, nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	etag := resp.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	modified := resp.Header().Get("Last-Modified")
+	require.NotEmpty(t, modified)
+
+	resp = requestWithHeaders(t, handler, http.MethodGet, uri, http.Header{"If-None-Match": {etag}}, nil)
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+	assert.Empty(t, resp.Body.String())
+	assert.Equal(t, etag, resp.Header().Get("ETag"))
+
+	resp = requestWithHeaders(t, handler, http.MethodGet, uri, http.Header{"If-None-Match": {`"other", W/` + etag}}, nil)
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+
+	resp = requestWithHeaders(t, handler, http.MethodGet, uri, http.Header{"If-Modified-Since": {modified}}, nil)
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+
+	// If-Modified-Since is ignored when If-None-Match is provided.
+	resp = requestWithHeaders(t, handler, http.MethodGet, uri, http.Header{
+		"If-None-Match":     {`"other"`},
+		"If-Modified-Since": {modified},
+	}, nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = requestWithHeaders(t, handler, http.MethodGet, uri, http.Header{
+		"If-Modified-Since": {p.UpdatedAt.Add(-time.Hour).UTC().Format(http.TimeFormat)},
+	}, nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// Lists have an ETag derived from the whole response, which changes as the results do.
+	resp = requestWithHeaders(t, handler, http.MethodGet, "/posts", nil, nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	listETag := resp.Header().Get("ETag")
+	require.NotEmpty(t, listETag)
+	assert.Empty(t, resp.Header().Get("Last-Modified"))
+
+	resp = requestWithHeaders(t, handler, http.MethodGet, "/posts", http.Header{"If-None-Match": {listETag}}, nil)
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+
+	newPost(db, author).ExecX(ctx)
+
+	resp = requestWithHeaders(t, handler, http.MethodGet, "/posts", http.Header{"If-None-Match": {listETag}}, nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotEqual(t, listETag, resp.Header().Get("ETag"))
+
+	// Updates change the ETag, so the cached copy is no longer current.
+	resp = requestWithHeaders(t, handler, http.MethodPatch, uri, nil, map[string]any{"title": "An updated title"})
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	resp = requestWithHeaders(t, handler, http.MethodGet, uri, http.Header{"If-None-Match": {etag}}, nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotEqual(t, etag, resp.Header().Get("ETag"))
+
+	t.Run("edges", func(t *testing.T) {
+		pet := newPet(db).AddFriends(newPet(db).SaveX(ctx)).SaveX(ctx)
+		uri := "/pets/" + strconv.Itoa(pet.ID)
+
+		resp := requestWithHeaders(t, handler, http.MethodGet, uri, nil, nil)
+		require.Equal(t, http.StatusOK, resp.Code)
+		etag := resp.Header().Get("ETag")
+
+		// Expanding edges changes the response, and as such, the ETag.
+		resp = requestWithHeaders(t, handler, http.MethodGet, uri+"?expand=friends", http.Header{"If-None-Match": {etag}}, nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.NotEqual(t, etag, resp.Header().Get("ETag"))
+
+		// As do changes to eager-loaded edges, even when the entity itself is unchanged.
+		newCategory(db).AddPets(pet).ExecX(ctx)
+
+		resp = requestWithHeaders(t, handler, http.MethodGet, uri, http.Header{"If-None-Match": {etag}}, nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		newETag := resp.Header().Get("ETag")
+		assert.NotEqual(t, etag, newETag)
+
+		// The ETag is prefixed with the ETag of the entity, so it can still be used with If-Match.
+		resp = requestWithHeaders(t, handler, http.MethodPatch, uri, http.Header{"If-Match": {newETag}}, map[string]any{"name": "updated"})
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		resp = requestWithHeaders(t, handler, http.MethodPatch, uri, http.Header{"If-Match": {newETag}}, map[string]any{"name": "other"})
+		assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+	})
+}
+
+func TestHandler_PatchFormats(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, handler := newRestHandler(t)
+
+	owner := newUser(db).SaveX(ctx)
+	cats := db.Category.CreateBulk(enttest.Multiple(newCategory, db, 3)...).SaveX(ctx)
+	p := newPet(db).SetDescription("original").AddCategories(cats[0], cats[1]).SaveX(ctx)
+	uri := "/pets/" + strconv.Itoa(p.ID)
+
+	patch := func(t *testing.T, contentType string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		return requestWithHeaders(t, handler, http.MethodPatch, uri, http.Header{
+			"Content-Type": {contentType},
+		}, body)
+	}
+
+	t.Run("merge-patch", func(t *testing.T) {
+		// Edge arrays replace the linked entities, and null clears a field or unique edge.
+		resp := patch(t, "application/merge-patch+json", map[string]any{
+			"name":        "merged",
+			"description": nil,
+			"owner":       owner.ID,
+			"categories":  []int{cats[1].ID, cats[2].ID},
+		})
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		updated := db.Pet.Query().Where(pet.ID(p.ID)).WithOwner().WithCategories().OnlyX(ctx)
+		assert.Equal(t, "merged", updated.Name)
+		assert.Nil(t, updated.Description)
+		require.NotNil(t, updated.Edges.Owner)
+		assert.Equal(t, owner.ID, updated.Edges.Owner.ID)
+		assert.ElementsMatch(t, []int{cats[1].ID, cats[2].ID}, categoryIDs(updated.Edges.Categories))
+
+		resp = patch(t, "application/merge-patch+json", map[string]any{"owner": nil})
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.False(t, db.Pet.Query().Where(pet.ID(p.ID)).QueryOwner().ExistX(ctx))
+
+		// Edges are arrays of IDs, not objects.
+		resp = patch(t, "application/merge-patch+json", map[string]any{"categories": map[string]any{"0": cats[0].ID}})
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		// Required fields can't be removed or null, as they'd otherwise be set to their zero value.
+		resp := patch(t, "application/merge-patch+json", map[string]any{"age": nil, "name": nil})
+		require.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.Contains(t, resp.Body.String(), `"field":"age"`)
+		assert.Contains(t, resp.Body.String(), `"field":"name"`)
+
+		for _, ops := range [][]map[string]any{
+			{{"op": "replace", "path": "/age", "value": nil}},
+			{{"op": "remove", "path": "/name"}},
+		} {
+			resp = patch(t, "application/json-patch+json", ops)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, ops)
+		}
+
+		updated := db.Pet.GetX(ctx, p.ID)
+		assert.Equal(t, "merged", updated.Name)
+		assert.Equal(t, p.Age, updated.Age)
+
+		// Optional fields which aren't nillable are cleared.
+		resp = patch(t, "application/json-patch+json", []map[string]any{
+			{"op": "remove", "path": "/nicknames"},
+		})
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Empty(t, db.Pet.GetX(ctx, p.ID).Nicknames)
+	})
+
+	t.Run("json-patch", func(t *testing.T) {
+		// Categories are currently [1, 2] (by index), ordered by ID.
+		resp := patch(t, "application/json-patch+json", []map[string]any{
+			{"op": "test", "path": "/name", "value": "merged"},
+			{"op": "test", "path": "/categories/0", "value": cats[1].ID},
+			{"op": "replace", "path": "/name", "value": "patched"},
+			{"op": "copy", "from": "/name", "path": "/description"},
+			{"op": "remove", "path": "/categories/0"},
+			{"op": "add", "path": "/categories/-", "value": cats[0].ID},
+			{"op": "add", "path": "/owner", "value": owner.ID},
+		})
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		updated := db.Pet.Query().Where(pet.ID(p.ID)).WithOwner().WithCategories().OnlyX(ctx)
+		assert.Equal(t, "patched", updated.Name)
+		require.NotNil(t, updated.Description)
+		assert.Equal(t, "patched", *updated.Description)
+		require.NotNil(t, updated.Edges.Owner)
+		assert.Equal(t, owner.ID, updated.Edges.Owner.ID)
+		assert.ElementsMatch(t, []int{cats[0].ID, cats[2].ID}, categoryIDs(updated.Edges.Categories))
+
+		resp = patch(t, "application/json-patch+json", []map[string]any{
+			{"op": "move", "from": "/description", "path": "/name"},
+			{"op": "remove", "path": "/owner"},
+		})
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		updated = db.Pet.Query().Where(pet.ID(p.ID)).WithOwner().OnlyX(ctx)
+		assert.Equal(t, "patched", updated.Name)
+		assert.Nil(t, updated.Description)
+		assert.Nil(t, updated.Edges.Owner)
+	})
+
+	t.Run("test-failed", func(t *testing.T) {
+		resp := patch(t, "application/json-patch+json", []map[string]any{
+			{"op": "replace", "path": "/name", "value": "not-applied"},
+			{"op": "test", "path": "/name", "value": "something-else"},
+		})
+		assert.Equal(t, http.StatusConflict, resp.Code, resp.Body.String())
+		assert.Equal(t, "patched", db.Pet.GetX(ctx, p.ID).Name)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, ops := range [][]map[string]any{
+			{{"op": "replace", "path": "/name"}},
+			{{"op": "replace", "path": "name", "value": "foo"}},
+			{{"op": "remove", "path": "/does-not-exist"}},
+			{{"op": "remove", "path": "/categories/5"}},
+			{{"op": "move", "from": "/categories", "path": "/categories/0"}},
+			{{"op": "unknown", "path": "/name", "value": "foo"}},
+			{{"op": "add", "path": "/unknown", "value": "foo"}},
+		} {
+			resp := patch(t, "application/json-patch+json", ops)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, ops)
+		}
+		assert.Equal(t, "patched", db.Pet.GetX(ctx, p.ID).Name)
+
+		// Patch documents can only be used to update a single entity.
+		resp := requestWithHeaders(t, handler, http.MethodPost, "/pets", http.Header{
+			"Content-Type": {"application/merge-patch+json"},
+		}, map[string]any{"name": "new", "age": 1, "type": "DOG"})
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+		resp = requestWithHeaders(t, handler, http.MethodPatch, "/pets?name.eq=patched", http.Header{
+			"Content-Type": {"application/merge-patch+json"},
+		}, map[string]any{"age": 50})
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.NotEqual(t, 50, db.Pet.GetX(ctx, p.ID).Age)
+	})
+}
+
+func categoryIDs(categories []*ent.Category) (ids []int) {
+	for _, c := range categories {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+func TestHandler_DefaultErrorFormat(t *testing.T) {
+	t.Parallel()
+
+	_, _, handler := newRestHandler(t)
+
+	resp := requestWithHeaders(t, handler, http.MethodGet, "/pets/123456", http.Header{
+		"X-Request-Id": {"test-request-id"},
+	}, nil)
+	require.Equal(t, http.StatusNotFound, resp.Code)
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.NotEmpty(t, body["error"])
+	assert.Equal(t, "Not Found", body["type"])
+	assert.InDelta(t, http.StatusNotFound, body["code"], 0)
+	assert.Equal(t, "test-request-id", body["request_id"])
+	assert.NotEmpty(t, body["timestamp"])
+	assert.NotContains(t, body, "status")
+}
+
+func TestNewProblemDetails(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodPatch, "/pets/1?pretty=true", http.NoBody)
+
+	p := rest.NewProblemDetails(r, rest.ErrorResponse{
+		Error:     "bad request: validation failed",
+		Type:      http.StatusText(http.StatusBadRequest),
+		Code:      http.StatusBadRequest,
+		RequestID: "test-request-id",
+		Timestamp: "2024-04-26T12:19:01Z",
+		Errors:    []rest.FieldError{{Field: "age", Reason: "value out of range"}},
+	})
+
+	assert.Equal(t, "https://example.com/problems/bad-request", p.Type)
+	assert.Equal(t, "Bad Request", p.Title)
+	assert.Equal(t, http.StatusBadRequest, p.Status)
+	assert.Equal(t, "bad request: validation failed", p.Detail)
+	assert.Equal(t, "/pets/1", p.Instance)
+	assert.Equal(t, "test-request-id", p.RequestID)
+	assert.Equal(t, "2024-04-26T12:19:01Z", p.Timestamp)
+	assert.Equal(t, []rest.FieldError{{Field: "age", Reason: "value out of range"}}, p.Errors)
+
+	p = rest.NewProblemDetails(r, rest.ErrorResponse{Code: http.StatusTooManyRequests})
+	assert.Equal(t, "https://example.com/problems/too-many-requests", p.Type)
+	assert.Empty(t, p.Errors)
+}
+
+func TestHandler_FieldErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+	t.Cleanup(func() { db.Close() })
+
+	pet1 := newPet(db).SaveX(ctx)
+	uri := "/pets/" + strconv.Itoa(pet1.ID)
+
+	t.Run("validation", func(t *testing.T) {
+		resp := enttest.Request[ent.Pet](ctx, s, http.MethodPatch, uri, map[string]any{"age": 100})
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+		require.NotNil(t, resp.Error)
+		require.Len(t, resp.Error.Errors, 1)
+		assert.Equal(t, "age", resp.Error.Errors[0].Field)
+		assert.NotEmpty(t, resp.Error.Errors[0].Reason)
+	})
+
+	t.Run("json-type", func(t *testing.T) {
+		resp := enttest.Request[ent.Pet](ctx, s, http.MethodPatch, uri, map[string]any{"age": "old"})
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+		require.NotNil(t, resp.Error)
+		require.Len(t, resp.Error.Errors, 1)
+		assert.Equal(t, "age", resp.Error.Errors[0].Field)
+		assert.Contains(t, resp.Error.Errors[0].Reason, "string")
+	})
+
+	t.Run("json-unknown-field", func(t *testing.T) {
+		resp := enttest.Request[ent.Pet](ctx, s, http.MethodPatch, uri, map[string]any{"color": "brown"})
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+		require.NotNil(t, resp.Error)
+		require.Len(t, resp.Error.Errors, 1)
+		assert.Equal(t, "color", resp.Error.Errors[0].Field)
+	})
+
+	t.Run("json-syntax", func(t *testing.T) {
+		// Request marshals the body, so malformed JSON has to be sent directly.
+		_, _, handler := newRestHandler(t)
+
+		req := httptest.NewRequest(http.MethodPatch, uri, strings.NewReader(`{"age": x}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var p struct {
+			Errors []rest.FieldError `json:"errors"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+		require.Len(t, p.Errors, 1)
+		assert.Empty(t, p.Errors[0].Field)
+		assert.Contains(t, p.Errors[0].Reason, "offset")
+	})
+
+	t.Run("form", func(t *testing.T) {
+		resp := enttest.Request[string](ctx, s, http.MethodGet, "/pets?page=first", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+		require.NotNil(t, resp.Error)
+		require.Len(t, resp.Error.Errors, 1)
+		assert.Equal(t, "page", resp.Error.Errors[0].Field)
+		assert.Equal(t, "first", resp.Error.Errors[0].Value)
+	})
+
+	t.Run("masked", func(t *testing.T) {
+		mctx, mdb, ms := newRestServer(t, &rest.ServerConfig{MaskErrors: true})
+		t.Cleanup(func() { mdb.Close() })
+
+		resp := enttest.Request[string](mctx, ms, http.MethodGet, "/pets?page=first", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, http.StatusText(http.StatusBadRequest), resp.Error.Error)
+		assert.Equal(t, []rest.FieldError{{Field: "page", Reason: "invalid value"}}, resp.Error.Errors)
+		assert.NotContains(t, resp.Data.Body.String(), "first")
+	})
+}
@@ -88,28 +88,39 @@ type Annotation struct {
 	Deprecated           bool                 `json:",omitempty" ent:"schema,edge,field"`
 	Schema               *ogen.Schema         `json:",omitempty" ent:"field"`
 	ReadOnly             bool                 `json:",omitempty" ent:"field"`
+	Constraints          *Constraints         `json:",omitempty" ent:"field"`
 
 	// All others.
 
-	Pagination         *bool       `json:",omitempty" ent:"schema,edge"`
-	MinItemsPerPage    int         `json:",omitempty" ent:"schema,edge"`
-	MaxItemsPerPage    int         `json:",omitempty" ent:"schema,edge"`
-	ItemsPerPage       int         `json:",omitempty" ent:"schema,edge"`
-	EagerLoad          *bool       `json:",omitempty" ent:"edge"`
-	EagerLoadLimit     *int        `json:",omitempty" ent:"edge"`
-	EdgeEndpoint       *bool       `json:",omitempty" ent:"edge"`
-	EdgeUpdateBulk     bool        `json:",omitempty" ent:"edge"`
-	Filter             Predicate   `json:",omitempty" ent:"schema,edge,field"`
-	FilterGroup        string      `json:",omitempty" ent:"edge,field"`
-	DisableHandler     bool        `json:",omitempty" ent:"schema,edge"`
-	IsSubentity        bool        `json:",omitempty" ent:"schema"`
-	Sortable           bool        `json:",omitempty" ent:"field"`
-	DefaultSort        *string     `json:",omitempty" ent:"schema"`
-	DefaultOrder       *SortOrder  `json:",omitempty" ent:"schema"`
-	Skip               bool        `json:",omitempty" ent:"schema,edge,field"`
-	AllowClientIDs     *bool       `json:",omitempty" ent:"schema"`
-	Operations         []Operation `json:",omitempty" ent:"schema,edge"`
-	ExcludedOperations []Operation `json:",omitempty" ent:"schema,edge"`
+	Pagination         *bool           `json:",omitempty" ent:"schema,edge"`
+	PaginationMode     PaginationMode  `json:",omitempty" ent:"schema"`
+	PaginationCount    CountMode       `json:",omitempty" ent:"schema"`
+	MinItemsPerPage    int             `json:",omitempty" ent:"schema,edge"`
+	MaxItemsPerPage    int             `json:",omitempty" ent:"schema,edge"`
+	ItemsPerPage       int             `json:",omitempty" ent:"schema,edge"`
+	MaxBulkAffected    int             `json:",omitempty" ent:"schema"`
+	EagerLoad          *bool           `json:",omitempty" ent:"edge"`
+	EagerLoadLimit     *int            `json:",omitempty" ent:"edge"`
+	Expandable         bool            `json:",omitempty" ent:"edge"`
+	EdgeEndpoint       *bool           `json:",omitempty" ent:"edge"`
+	EdgeUpdateBulk     bool            `json:",omitempty" ent:"edge"`
+	Filter             Predicate       `json:",omitempty" ent:"schema,edge,field"`
+	FilterGroup        string          `json:",omitempty" ent:"edge,field"`
+	DisableHandler     bool            `json:",omitempty" ent:"schema,edge"`
+	IsSubentity        bool            `json:",omitempty" ent:"schema"`
+	Sortable           bool            `json:",omitempty" ent:"field"`
+	DefaultSort        *string         `json:",omitempty" ent:"schema"`
+	DefaultOrder       *SortOrder      `json:",omitempty" ent:"schema"`
+	SortNulls          NullsOrder      `json:",omitempty" ent:"field"`
+	Aggregate          []AggregateFunc `json:",omitempty" ent:"field"`
+	Distinct           bool            `json:",omitempty" ent:"field"`
+	LookupKey          bool            `json:",omitempty" ent:"field"`
+	ETag               bool            `json:",omitempty" ent:"field"`
+	LastModified       bool            `json:",omitempty" ent:"field"`
+	Skip               bool            `json:",omitempty" ent:"schema,edge,field"`
+	AllowClientIDs     *bool           `json:",omitempty" ent:"schema"`
+	Operations         []Operation     `json:",omitempty" ent:"schema,edge"`
+	ExcludedOperations []Operation     `json:",omitempty" ent:"schema,edge"`
 }
 
 // getSupportedType uses reflection to check if the annotation is supported on the
@@ -197,10 +208,17 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation { // nolint:goc
 	if am.Schema != nil {
 		a.Schema = am.Schema
 	}
+	a.Constraints = a.Constraints.Merge(am.Constraints)
 
 	if am.Pagination != nil {
 		a.Pagination = am.Pagination
 	}
+	if am.PaginationMode != "" {
+		a.PaginationMode = am.PaginationMode
+	}
+	if am.PaginationCount != "" {
+		a.PaginationCount = am.PaginationCount
+	}
 	if am.MinItemsPerPage != 0 {
 		a.MinItemsPerPage = am.MinItemsPerPage
 	}
@@ -210,12 +228,16 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation { // nolint:goc
 	if am.ItemsPerPage != 0 {
 		a.ItemsPerPage = am.ItemsPerPage
 	}
+	if am.MaxBulkAffected != 0 {
+		a.MaxBulkAffected = am.MaxBulkAffected
+	}
 	if am.EagerLoad != nil {
 		a.EagerLoad = am.EagerLoad
 	}
 	if am.EagerLoadLimit != nil {
 		a.EagerLoadLimit = am.EagerLoadLimit
 	}
+	a.Expandable = a.Expandable || am.Expandable
 	if am.EdgeEndpoint != nil {
 		a.EdgeEndpoint = am.EdgeEndpoint
 	}
@@ -235,6 +257,16 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation { // nolint:goc
 	if am.DefaultOrder != nil {
 		a.DefaultOrder = am.DefaultOrder
 	}
+	if am.SortNulls != "" {
+		a.SortNulls = am.SortNulls
+	}
+	a.Distinct = a.Distinct || am.Distinct
+	a.LookupKey = a.LookupKey || am.LookupKey
+	a.ETag = a.ETag || am.ETag
+	a.LastModified = a.LastModified || am.LastModified
+	if len(am.Aggregate) > 0 {
+		a.Aggregate = sliceCompact(append(a.Aggregate, am.Aggregate...))
+	}
 	a.Skip = a.Skip || am.Skip
 	if am.AllowClientIDs != nil {
 		a.AllowClientIDs = am.AllowClientIDs
@@ -287,6 +319,28 @@ func (a *Annotation) GetPagination(config *Config, edge *gen.Edge) bool {
 	return *a.Pagination
 }
 
+// GetPaginationMode returns the pagination mode annotation (or defaults from
+// [Config.PaginationMode]). Types without an ID field cannot be keyed on by a cursor,
+// so they always use [PaginationOffset].
+func (a *Annotation) GetPaginationMode(config *Config, hasID bool) PaginationMode {
+	if !hasID {
+		return PaginationOffset
+	}
+	if a.PaginationMode == "" {
+		return config.PaginationMode
+	}
+	return a.PaginationMode
+}
+
+// GetPaginationCount returns the pagination count mode annotation (or defaults from
+// [Config.PaginationCount]).
+func (a *Annotation) GetPaginationCount(config *Config) CountMode {
+	if a.PaginationCount == "" {
+		return config.PaginationCount
+	}
+	return a.PaginationCount
+}
+
 // GetMinItemsPerPage returns the minimum number of items per page for paginated calls
 // (or defaults from [Config.MinItemsPerPage]).
 func (a *Annotation) GetMinItemsPerPage(config *Config) int {
@@ -314,6 +368,15 @@ func (a *Annotation) GetItemsPerPage(config *Config) int {
 	return a.ItemsPerPage
 }
 
+// GetMaxBulkAffected returns the maximum number of entities which can be affected by a
+// single bulk delete or bulk update request (or defaults from [Config.MaxBulkAffected]).
+func (a *Annotation) GetMaxBulkAffected(config *Config) int {
+	if a.MaxBulkAffected == 0 {
+		return config.MaxBulkAffected
+	}
+	return a.MaxBulkAffected
+}
+
 // GetEagerLoad returns if the edge should be eager-loaded (or defaults from
 // [Config.DefaultEagerLoad]).
 func (a *Annotation) GetEagerLoad(config *Config) bool {
@@ -486,6 +549,20 @@ func WithPagination(v bool) Annotation {
 	return Annotation{Pagination: &v}
 }
 
+// WithPaginationMode sets the pagination mode for the schema in the REST API. This is not
+// required to be provided unless you want a mode different from [Config.PaginationMode].
+// See [PaginationCursor] for the limitations of cursor pagination.
+func WithPaginationMode(v PaginationMode) Annotation {
+	return Annotation{PaginationMode: v}
+}
+
+// WithPaginationCount sets how the total number of results is calculated for the schema
+// when using offset pagination. This is not required to be provided unless you want a
+// count mode different from [Config.PaginationCount].
+func WithPaginationCount(v CountMode) Annotation {
+	return Annotation{PaginationCount: v}
+}
+
 // WithMinItemsPerPage sets an explicit minimum number of items per page for paginated calls.
 func WithMinItemsPerPage(v int) Annotation {
 	return Annotation{MinItemsPerPage: v}
@@ -501,6 +578,13 @@ func WithItemsPerPage(v int) Annotation {
 	return Annotation{ItemsPerPage: v}
 }
 
+// WithMaxBulkAffected sets an explicit maximum number of entities which can be affected
+// by a single bulk delete or bulk update request. Requests matching more entities are
+// rejected, without modifying any entities.
+func WithMaxBulkAffected(v int) Annotation {
+	return Annotation{MaxBulkAffected: v}
+}
+
 // WithEagerLoad sets the edge to be eager-loaded in the REST API for each associated
 // entity. Note that edges are not eager-loaded by default. Eager-loading, when enabled,
 // means that the configured edge is always fetched when the parent entity is fetched
@@ -520,6 +604,13 @@ func WithEagerLoadLimit(v int) Annotation {
 	return Annotation{EagerLoadLimit: &v}
 }
 
+// WithExpandable allows clients to eager-load the edge at request time, by providing the
+// edge name in the "expand" query parameter on read and list operations. Only edges with
+// this annotation can be expanded. [WithEagerLoadLimit] also applies to expanded edges.
+func WithExpandable(v bool) Annotation {
+	return Annotation{Expandable: v}
+}
+
 // WithEdgeEndpoint sets the edge to have an endpoint. If the edge is eager-loaded,
 // and the global config is set to disable endpoints for edges which are also
 // eager-loaded, this will default to false. Not required to be provided unless
@@ -607,6 +698,54 @@ func WithDefaultOrder(v SortOrder) Annotation {
 	return Annotation{DefaultOrder: &v}
 }
 
+// WithSortNulls sets where NULL values of the field are placed when sorting by it, unless
+// overridden by the client with the "nulls" query parameter. If not specified, the database
+// default is used, which differs between databases. Only applies to optional or nillable fields.
+func WithSortNulls(v NullsOrder) Annotation {
+	return Annotation{SortNulls: v}
+}
+
+// WithAggregate allows the field to be used with the aggregate operation (see
+// [OperationAggregate]), using the provided aggregate functions. Use [AggregateGroupBy]
+// to allow grouping results by the field. Example:
+//
+//	entrest.WithAggregate(entrest.AggregateGroupBy) // Group by the field.
+//	entrest.WithAggregate(entrest.AggregateSum, entrest.AggregateAvg) // Sum/average of a numeric field.
+func WithAggregate(v ...AggregateFunc) Annotation {
+	return Annotation{Aggregate: v}
+}
+
+// WithDistinct allows clients to list the distinct values of the field, using the distinct
+// operation (see [OperationDistinct]), e.g. to populate filter dropdowns. Only string, enum,
+// numeric and boolean fields are supported.
+func WithDistinct(v bool) Annotation {
+	return Annotation{Distinct: v}
+}
+
+// WithLookupKey allows clients to read, update and delete entities using the field, as an
+// alternative to the ID (e.g. "/users/by/email/{email}"). The field must be unique, and
+// only string, int and UUID fields are supported.
+func WithLookupKey(v bool) Annotation {
+	return Annotation{LookupKey: v}
+}
+
+// WithETag sets the field which the ETag of the entity is derived from, when ETags are
+// enabled (see [Config.EnableETags]). This should be a field which changes on every update,
+// like a version number or an "updated_at" timestamp. If no field on the schema has this
+// annotation, the ETag is derived from a hash of all fields of the entity instead.
+func WithETag(v bool) Annotation {
+	return Annotation{ETag: v}
+}
+
+// WithLastModified sets the timestamp field which the "Last-Modified" header of single entity
+// responses is derived from, when conditional GET requests are enabled (see
+// [Config.EnableConditionalGET]). This allows clients to make conditional requests using the
+// "If-Modified-Since" header. Note that only changes to the entity itself are reflected, not
+// changes to its edges, so clients should prefer the "If-None-Match" header.
+func WithLastModified(v bool) Annotation {
+	return Annotation{LastModified: v}
+}
+
 // WithSkip sets the schema, edge, or field to be skipped in the REST API. Primarily useful if an entire
 // schema shouldn't be queryable, or if there is a sensitive field that should never be returned (but
 // sensitive isn't set on the field for some reason).
@@ -653,6 +792,40 @@ func WithSchema(v *ogen.Schema) Annotation {
 	return Annotation{Schema: v}
 }
 
+// WithMinLength sets the minimum length of a string field in the OpenAPI schema. Ent
+// doesn't expose the parameters of validators (e.g. MinLen and NotEmpty) to code
+// generation, so the constraint has to be repeated using this annotation.
+func WithMinLength(v uint64) Annotation {
+	return Annotation{Constraints: &Constraints{MinLength: &v}}
+}
+
+// WithMaxLength sets the maximum length of a string field in the OpenAPI schema. This
+// is derived automatically from the MaxLen and MaxRuneLen validators, so it's only
+// necessary to override the derived value or with custom validators.
+func WithMaxLength(v uint64) Annotation {
+	return Annotation{Constraints: &Constraints{MaxLength: &v}}
+}
+
+// WithPattern sets the regular expression which a string field must match in the
+// OpenAPI schema, typically the same expression provided to the Match validator. The
+// expression is only checked with Go's regexp package (RE2), however OpenAPI clients
+// use ECMA-262, so it should only use syntax supported by both.
+func WithPattern(v string) Annotation {
+	return Annotation{Constraints: &Constraints{Pattern: v}}
+}
+
+// WithMinimum sets the inclusive minimum value of a numeric field in the OpenAPI schema,
+// e.g. 1 for fields using the Positive validator, or the lower bound of Range.
+func WithMinimum(v float64) Annotation {
+	return Annotation{Constraints: &Constraints{Minimum: &v}}
+}
+
+// WithMaximum sets the inclusive maximum value of a numeric field in the OpenAPI schema,
+// e.g. the upper bound of the Range validator.
+func WithMaximum(v float64) Annotation {
+	return Annotation{Constraints: &Constraints{Maximum: &v}}
+}
+
 // WithIncludeOperations explicitly sets which operations are enabled in the REST API for the
 // schema, overriding Config.DefaultOperations entirely.
 func WithIncludeOperations(v ...Operation) Annotation {
@@ -130,6 +130,17 @@ func TestAnnotation_Merge(t *testing.T) {
 				Description: "bar",
 			},
 		},
+		{
+			name: "constraints",
+			annotations: []Annotation{
+				WithMinLength(1),
+				WithMaxLength(10),
+				WithMaxLength(20),
+			},
+			want: Annotation{
+				Constraints: &Constraints{MinLength: ptr(uint64(1)), MaxLength: ptr(uint64(20))},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -208,6 +219,74 @@ func TestAnnotation_EdgeUpdateBulk(t *testing.T) {
 	assert.NotNil(t, r.json(`$.components.schemas.PetUpdate.properties.remove_friends`))
 }
 
+func TestAnnotation_Expandable(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.owner", WithExpandable(true))
+			injectAnnotations(t, g, "Pet.friends", WithExpandable(true))
+			return nil
+		},
+	})
+
+	assert.Equal(t, []any{"owner", "friends"}, r.json(`$.components.parameters.PetExpand.schema.items.enum`))
+	assert.Equal(t, "expand", r.json(`$.components.parameters.PetExpand.name`))
+	assert.Equal(t, false, r.json(`$.components.parameters.PetExpand.explode`))
+
+	// Read and list operations (including edge operations returning pets).
+	for _, path := range []string{"/pets", "/pets/{petID}", "/users/{userID}/pets", "/categories/{categoryID}/pets"} {
+		assert.Contains(t, r.json(`$.paths.`+path+`.get.parameters.*.$ref`), "#/components/parameters/PetExpand", path)
+	}
+	assert.Nil(t, r.json(`$.paths./pets.post.parameters`))
+
+	// Expandable edges are included in the read schema, and documented.
+	assert.NotNil(t, r.json(`$.components.schemas.PetEdges.properties.owner`))
+	assert.NotNil(t, r.json(`$.components.schemas.PetEdges.properties.friends`))
+	assert.Contains(t, r.json(`$.components.schemas.PetEdges.description`), "owner, friends")
+	assert.Contains(t, r.json(`$.components.schemas.PetRead.description`), "owner, friends")
+
+	// Types without expandable edges have no parameter.
+	assert.Nil(t, r.json(`$.components.parameters.UserExpand`))
+	assert.NotContains(t, r.json(`$.paths./users.get.parameters.*.$ref`), "#/components/parameters/UserExpand")
+}
+
+func TestAnnotation_ExpandableNested(t *testing.T) {
+	t.Parallel()
+
+	annotate := func(g *gen.Graph, _ *ogen.Spec) error {
+		injectAnnotations(t, g, "User.pets", WithExpandable(true))
+		injectAnnotations(t, g, "Pet.categories", WithExpandable(true))
+		injectAnnotations(t, g, "Pet.friends", WithExpandable(true))
+		injectAnnotations(t, g, "Category.pets", WithExpandable(true))
+		return nil
+	}
+
+	t.Run("default-depth", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{PreGenerateHook: annotate})
+
+		// Edges leading back to a type already in the path can be expanded, but not
+		// any further.
+		assert.Equal(
+			t,
+			[]any{"pets", "pets.categories", "pets.categories.pets", "pets.friends"},
+			r.json(`$.components.parameters.UserExpand.schema.items.enum`),
+		)
+		assert.Equal(
+			t,
+			[]any{"categories", "categories.pets", "friends"},
+			r.json(`$.components.parameters.PetExpand.schema.items.enum`),
+		)
+	})
+
+	t.Run("max-depth", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{MaxExpandDepth: 1, PreGenerateHook: annotate})
+		assert.Equal(t, []any{"pets"}, r.json(`$.components.parameters.UserExpand.schema.items.enum`))
+	})
+}
+
 func TestAnnotation_EdgesInUpsert(t *testing.T) {
 	t.Parallel()
 
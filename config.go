@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"net/http"
 	"slices"
 
 	"entgo.io/ent/entc"
@@ -37,6 +39,17 @@ type Config struct {
 	// It scan still be enabled on a per-schema basis with annotations.
 	DisablePagination bool
 
+	// PaginationMode controls how paginated list endpoints page through results for
+	// all schemas by default. Defaults to [PaginationOffset]. This can be overridden
+	// on a per-schema basis with annotations.
+	PaginationMode PaginationMode
+
+	// PaginationCount controls how the total number of results is calculated for offset
+	// paginated list endpoints for all schemas by default. Defaults to [CountExact].
+	// Clients can request a cheaper count mode (see [CountModes]) with the "count" query
+	// parameter. This can be overridden on a per-schema basis with annotations.
+	PaginationCount CountMode
+
 	// WrapUnpagedResults if set to true, wraps unpaged list results in response objects
 	// instead of the default plain arrays.
 	// Only applicable if pagination is disabled for an endpoint or globally.
@@ -54,6 +67,11 @@ type Config struct {
 	// This can be overridden on a per-schema basis with annotations.
 	ItemsPerPage int
 
+	// MaxBulkAffected controls the default maximum number of entities which can be
+	// affected by a single bulk delete or bulk update request. Requests which match more
+	// entities are rejected. This can be overridden on a per-schema basis with annotations.
+	MaxBulkAffected int
+
 	// DefaultEagerLoad enables eager loading of all edges by default. This can be
 	// overridden on a per-edge basis with annotations. If edges load a lot of data
 	// or are expensive, this can be a performance hit and isn't recommended.
@@ -88,6 +106,12 @@ type Config struct {
 	// This can be overridden on a per-edge basis with annotations.
 	EagerLoadLimit int
 
+	// MaxExpandDepth controls the maximum depth of nested edges which clients can request
+	// with the "expand" query parameter (e.g. "pets.categories" has a depth of 2). Each
+	// edge in the path must allow expansion, and paths which revisit a type (cycles) are
+	// not allowed. The default, when not specified, is 3.
+	MaxExpandDepth int
+
 	// AddEdgesToTags enables the addition of edge fields to the "tags" field in the
 	// OpenAPI spec. This is helpful to see if querying a specific entity also returns
 	// the thing you're looking for, though can be very noisy for large schemas. Note
@@ -105,6 +129,11 @@ type Config struct {
 	// defaults to [OperationCreate, OperationRead, OperationUpdate, OperationDelete, OperationList].
 	// Note: OperationUpsert and OperationCreateOrReplace are not included by default as they
 	// require an explicitly defined ID field.
+	// OperationSearch, OperationCount, OperationAggregate and OperationDistinct are also not
+	// included by default, as they're only needed for specific use cases (e.g. list queries
+	// which are too long to fit in a URL, or dashboards which only need the number of
+	// results), and neither are OperationBulkCreate, OperationBulkDelete and
+	// OperationBulkUpdate, as they affect many entities in a single request.
 	DefaultOperations []Operation
 
 	// GlobalRequestHeaders are headers to add to every request, which can be optional
@@ -121,9 +150,24 @@ type Config struct {
 	// added to all path operations. Note that some status codes are excluded on specific
 	// operations (e.g. 404 on list, 409 on non-create/update, etc). If not specified,
 	// a default set of responses will be generated which can be used with entrest's
-	// built-in auto-generated HTTP handlers (see below). Defaults to [DefaultErrorResponses].
+	// built-in auto-generated HTTP handlers (see below). Defaults to [DefaultErrorResponses],
+	// or the Problem Details equivalent when [Config.ProblemDetails] is enabled.
 	GlobalErrorResponses ErrorResponses
 
+	// ProblemDetails if set to true, switches the format of error responses (both in the
+	// spec, and in the generated error handler) to Problem Details (RFC 9457), using the
+	// "application/problem+json" content type, rather than the "error", "type" and "code"
+	// format. See [ProblemDetailsObject].
+	ProblemDetails bool
+
+	// ProblemTypeBaseURI is the base URI which the "type" of Problem Details responses is
+	// derived from (including those built with the generated NewProblemDetails function),
+	// suffixed with the kebab-cased HTTP status text (e.g. with a base URI of
+	// "https://example.com/problems/", a 404 has a type of "https://example.com/problems/not-found").
+	// If not specified, the type will be "about:blank", which indicates that the problem
+	// has no additional semantics beyond the HTTP status code.
+	ProblemTypeBaseURI string
+
 	// Handler enables the generation of HTTP handlers for the specified server/routing
 	// library. If this is disabled, no Go code will be generated, and only the OpenAPI
 	// spec will be generated.
@@ -142,6 +186,31 @@ type Config struct {
 	// with the "content" field being an empty array.
 	ListNotFound bool
 
+	// EnableETags enables optimistic concurrency control using ETags. Responses which
+	// contain a single entity include an "ETag" header, and the update, upsert, replace
+	// and delete operations reject requests with a 412 "Precondition Failed" response if
+	// the provided "If-Match" header doesn't match the current ETag of the entity. ETags
+	// are derived from the field annotated with [WithETag] (e.g. a version or "updated_at"
+	// field), or a hash of the entity fields if the schema has no such field. Nested
+	// subentity operations, and edge link/unlink operations (using the ETag of the entity
+	// the edge belongs to) also accept the "If-Match" header. The entity is locked while
+	// the ETag is checked and the operation is performed, in a single transaction.
+	EnableETags bool
+
+	// RequireIfMatch if set to true (and [Config.EnableETags] is enabled), will cause a 428
+	// "Precondition Required" response if the "If-Match" header is missing on requests which
+	// modify or delete an existing entity, or link/unlink edges of one.
+	RequireIfMatch bool
+
+	// EnableConditionalGET enables conditional GET requests on read and list endpoints,
+	// which respond with a 304 "Not Modified" response if the "If-None-Match" header matches
+	// the ETag of the response (or "If-Modified-Since", for schemas with a [WithLastModified]
+	// field). The ETag of these responses is derived from a hash of the response body, so it
+	// changes when eager-loaded edges change, or different edges are expanded. When
+	// [Config.EnableETags] is also enabled, it's prefixed with the ETag of the entity, and
+	// can still be provided in the "If-Match" header.
+	EnableConditionalGET bool
+
 	// DisableSpecHandler disables the generation of an OpenAPI spec handler (e.g.
 	// /openapi.json). Disabling this will also disable embedding the spec into the
 	// binary/rest generated library.
@@ -200,6 +269,20 @@ func (c *Config) Validate() error {
 		return errors.New("Config.Spec and Config.SpecFromPath cannot be provided at the same time")
 	}
 
+	switch c.PaginationMode {
+	case "":
+		c.PaginationMode = PaginationOffset
+	case PaginationOffset, PaginationCursor:
+	default:
+		return fmt.Errorf("unsupported pagination mode provided: %s", c.PaginationMode)
+	}
+
+	if c.PaginationCount == "" {
+		c.PaginationCount = CountExact
+	} else if !slices.Contains(CountModes, c.PaginationCount) {
+		return fmt.Errorf("unsupported pagination count mode provided: %s", c.PaginationCount)
+	}
+
 	if c.MinItemsPerPage < 1 {
 		c.MinItemsPerPage = defaultMinItemsPerPage
 	}
@@ -224,6 +307,10 @@ func (c *Config) Validate() error {
 		c.ItemsPerPage = c.MaxItemsPerPage
 	}
 
+	if c.MaxBulkAffected < 1 {
+		c.MaxBulkAffected = defaultMaxBulkAffected
+	}
+
 	if c.EagerLoadLimit < -1 {
 		c.EagerLoadLimit = -1
 	}
@@ -232,12 +319,36 @@ func (c *Config) Validate() error {
 		c.EagerLoadLimit = 1000
 	}
 
+	if c.MaxExpandDepth < 1 {
+		c.MaxExpandDepth = defaultMaxExpandDepth
+	}
+
 	if c.DefaultOperations == nil {
 		c.DefaultOperations = DefaultOperations
 	}
 
 	if len(c.GlobalErrorResponses) == 0 {
 		c.GlobalErrorResponses = DefaultErrorResponses
+
+		if c.ProblemDetails {
+			c.GlobalErrorResponses = make(ErrorResponses, len(DefaultErrorResponses))
+			for code := range DefaultErrorResponses {
+				c.GlobalErrorResponses[code] = ProblemDetailsObject(code, c.ProblemTypeBaseURI)
+			}
+		}
+	}
+
+	if c.EnableETags {
+		// Clone, so the defaults (or user-provided responses) aren't modified.
+		c.GlobalErrorResponses = maps.Clone(c.GlobalErrorResponses)
+
+		if _, ok := c.GlobalErrorResponses[http.StatusPreconditionFailed]; !ok {
+			c.GlobalErrorResponses[http.StatusPreconditionFailed] = c.errorResponseObject(http.StatusPreconditionFailed)
+		}
+
+		if _, ok := c.GlobalErrorResponses[http.StatusPreconditionRequired]; !ok && c.RequireIfMatch {
+			c.GlobalErrorResponses[http.StatusPreconditionRequired] = c.errorResponseObject(http.StatusPreconditionRequired)
+		}
 	}
 
 	for k := range c.GlobalErrorResponses {
@@ -258,6 +369,15 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// errorResponseObject returns the error schema for the provided HTTP status code, in the
+// format configured with [Config.ProblemDetails].
+func (c *Config) errorResponseObject(code int) *ogen.Schema {
+	if c.ProblemDetails {
+		return ProblemDetailsObject(code, c.ProblemTypeBaseURI)
+	}
+	return ErrorResponseObject(code)
+}
+
 func (c Config) Name() string {
 	return "RestConfig"
 }
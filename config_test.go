@@ -100,7 +100,7 @@ func TestConfig_DisablePagination(t *testing.T) {
 		t.Parallel()
 		r := mustBuildSpec(t, &Config{DisablePagination: false})
 
-		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories..responses..schema.$ref`), "/CategoryList")
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories.get.responses..schema.$ref`), "/CategoryList")
 		assert.Contains(t, r.json(`$.components.schemas.CategoryList.allOf.*.$ref`), "/PagedResponse")
 	})
 
@@ -132,7 +132,7 @@ func TestConfig_DisablePagination(t *testing.T) {
 			},
 		})
 
-		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories..responses..schema.$ref`), "/PetCategoryList")
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories.get.responses..schema.$ref`), "/PetCategoryList")
 		assert.Contains(t, r.json(`$.components.schemas.PetCategoryList.allOf.*.$ref`), "/PagedResponse")
 	})
 
@@ -147,11 +147,124 @@ func TestConfig_DisablePagination(t *testing.T) {
 				return nil
 			},
 		})
-		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories..responses..schema.$ref`), "/CategoryList")
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories.get.responses..schema.$ref`), "/CategoryList")
 		assert.Contains(t, r.json(`$.components.schemas.CategoryList.allOf.*.$ref`), "/PagedResponse")
 	})
 }
 
+func TestConfig_PaginationMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default-offset", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{})
+		assert.Contains(t, r.json(`$.components.schemas.PetList.allOf.*.$ref`), "#/components/schemas/PagedResponse")
+		assert.Contains(t, r.json(`$.paths./pets.get.parameters.*.$ref`), "#/components/parameters/Page")
+		assert.Nil(t, r.json(`$.components.schemas.CursorPagedResponse`))
+	})
+
+	t.Run("global-cursor", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{
+			PaginationMode: PaginationCursor,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.name", WithSortable(true))
+				injectAnnotations(t, g, "Pet.age", WithSortable(true))
+				return nil
+			},
+		})
+		assert.Contains(t, r.json(`$.components.schemas.PetList.allOf.*.$ref`), "#/components/schemas/CursorPagedResponse")
+		assert.Contains(t, r.json(`$.paths./pets.get.parameters.*.$ref`), "#/components/parameters/Cursor")
+		assert.NotContains(t, r.json(`$.paths./pets.get.parameters.*.$ref`), "#/components/parameters/Page")
+		assert.Equal(t, "cursor", r.json(`$.components.parameters.Cursor.name`))
+		assert.Equal(t, true, r.json(`$.components.schemas.CursorPagedResponse.properties.next_cursor.nullable`))
+
+		// Edge endpoints use the pagination mode of the edge type.
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories.get.parameters.*.$ref`), "#/components/parameters/Cursor")
+
+		// Edge, random, and nullable field sorting can't be used with cursors.
+		assert.Equal(t, []any{"id", "-id", "name", "-name"}, r.json(`$.components.schemas.PetSortableFields.enum`))
+
+		// Cursors are keyed on all sort fields, so multiple can be provided.
+		assert.Nil(t, r.json(`$.paths./pets.get.parameters[?(@.name == "sort")].schema.maxItems`))
+	})
+
+	t.Run("local-cursor", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithPaginationMode(PaginationCursor))
+				return nil
+			},
+		})
+		assert.Contains(t, r.json(`$.components.schemas.PetList.allOf.*.$ref`), "#/components/schemas/CursorPagedResponse")
+		assert.Contains(t, r.json(`$.components.schemas.CategoryList.allOf.*.$ref`), "#/components/schemas/PagedResponse")
+	})
+
+	t.Run("no-id-fallback", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{PaginationMode: PaginationCursor})
+		assert.Contains(t, r.json(`$.components.schemas.FollowList.allOf.*.$ref`), "#/components/schemas/PagedResponse")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		c := &Config{PaginationMode: "foo"}
+		assert.Error(t, c.Validate())
+	})
+}
+
+func TestConfig_PaginationCount(t *testing.T) {
+	t.Parallel()
+
+	base := `$.paths./pets.get.parameters[?(@.name == "count")].schema`
+
+	t.Run("default-exact", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{})
+		assert.Equal(t, "exact", r.json(base+`.default`))
+		assert.Equal(t, []any{"false", "estimate", "exact"}, r.json(base+`.enum`))
+		assert.Equal(t, true, r.json(`$.components.schemas.PagedResponse.properties.total_count.nullable`))
+		assert.Equal(t, true, r.json(`$.components.schemas.PagedResponse.properties.last_page.nullable`))
+		assert.Equal(t, "boolean", r.json(`$.components.schemas.PagedResponse.properties.has_more.type`))
+	})
+
+	t.Run("global-estimate", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{PaginationCount: CountEstimate})
+		assert.Equal(t, "estimate", r.json(base+`.default`))
+		assert.Equal(t, []any{"false", "estimate"}, r.json(base+`.enum`))
+	})
+
+	t.Run("local-none", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithPaginationCount(CountNone))
+				return nil
+			},
+		})
+		assert.Nil(t, r.json(base))
+		assert.Equal(t, "exact", r.json(`$.paths./categories.get.parameters[?(@.name == "count")].schema.default`))
+
+		// Edge endpoints use the count mode of the edge type.
+		assert.NotNil(t, r.json(`$.paths./categories/{categoryID}/pets.get.parameters[?(@.name == "per_page")]`))
+		assert.Nil(t, r.json(`$.paths./categories/{categoryID}/pets.get.parameters[?(@.name == "count")]`))
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{PaginationMode: PaginationCursor})
+		assert.Nil(t, r.json(base))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		c := &Config{PaginationCount: "foo"}
+		assert.Error(t, c.Validate())
+	})
+}
+
 func TestConfig_ItemsPerPage(t *testing.T) {
 	t.Parallel()
 
@@ -235,7 +348,7 @@ func TestConfig_DisableEagerLoadNonPagedOpt(t *testing.T) {
 
 		// The edge endpoint should point to the paged schema, despite us eager-loading
 		// it.
-		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories..responses..schema.$ref`), "/CategoryList")
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories.get.responses..schema.$ref`), "/CategoryList")
 		assert.Contains(t, r.json(`$.components.schemas.CategoryList.allOf.*.$ref`), "/PagedResponse")
 	})
 
@@ -256,7 +369,7 @@ func TestConfig_DisableEagerLoadNonPagedOpt(t *testing.T) {
 
 		// The edge endpoint should also be non-paged, because we optimized away the
 		// need for pagination, given the edge is eager-loaded.
-		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories..responses..schema.$ref`), "/PetCategoryList")
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories.get.responses..schema.$ref`), "/PetCategoryList")
 		assert.Equal(t, "array", r.json(`$.components.schemas.PetCategoryList.type`))
 	})
 
@@ -264,7 +377,7 @@ func TestConfig_DisableEagerLoadNonPagedOpt(t *testing.T) {
 		t.Parallel()
 		r := mustBuildSpec(t, &Config{DisableEagerLoadNonPagedOpt: true})
 
-		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories..responses..schema.$ref`), "/CategoryList")
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/categories.get.responses..schema.$ref`), "/CategoryList")
 		assert.Contains(t, r.json(`$.components.schemas.CategoryList.allOf.*.$ref`), "/PagedResponse")
 	})
 }
@@ -420,6 +533,18 @@ func TestConfig_DefaultOperations(t *testing.T) {
 			name: "list-1",
 			ops:  []Operation{OperationList},
 		},
+		{
+			name: "search-1",
+			ops:  []Operation{OperationSearch},
+		},
+		{
+			name: "bulk-create-1",
+			ops:  []Operation{OperationBulkCreate},
+		},
+		{
+			name: "list-count-2",
+			ops:  []Operation{OperationList, OperationCount},
+		},
 		{
 			name: "create-read-2",
 			ops:  []Operation{OperationCreate, OperationRead},
@@ -477,6 +602,26 @@ func TestConfig_DefaultOperations(t *testing.T) {
 				assert.Nil(t, r.json(`$.paths./pets.get`))
 				assert.Nil(t, r.json(`$.paths./pets/{petID}/categories.get`))
 			}
+
+			if slices.Contains(tt.ops, OperationSearch) {
+				assert.NotNil(t, r.json(`$.paths./pets/search.post`))
+			} else {
+				assert.Nil(t, r.json(`$.paths./pets/search.post`))
+			}
+
+			if slices.Contains(tt.ops, OperationBulkCreate) {
+				assert.NotNil(t, r.json(`$.paths./pets/bulk.post`))
+			} else {
+				assert.Nil(t, r.json(`$.paths./pets/bulk.post`))
+			}
+
+			if slices.Contains(tt.ops, OperationCount) {
+				assert.NotNil(t, r.json(`$.paths./pets/count.get`))
+				assert.NotNil(t, r.json(`$.paths./pets.head`))
+			} else {
+				assert.Nil(t, r.json(`$.paths./pets/count.get`))
+				assert.Nil(t, r.json(`$.paths./pets.head`))
+			}
 		})
 	}
 }
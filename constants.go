@@ -53,15 +53,80 @@ const (
 	OperationDelete Operation = "delete"
 	// OperationList represents the list operation (method: GET).
 	OperationList Operation = "list"
+	// OperationSearch represents the search operation (method: POST). It accepts the same
+	// pagination, sorting and filtering options as OperationList, but in a JSON request
+	// body, for queries which are too long to fit in a URL.
+	OperationSearch Operation = "search"
+	// OperationCount represents the count operation (method: GET, and HEAD on the list
+	// endpoint). It accepts the same filters as OperationList, and only returns the number
+	// of matching entities.
+	OperationCount Operation = "count"
+	// OperationAggregate represents the aggregate operation (method: GET). It accepts the
+	// same filters as OperationList, and returns aggregated values of fields annotated
+	// with [WithAggregate], optionally grouped by other fields.
+	OperationAggregate Operation = "aggregate"
+	// OperationDistinct represents the distinct operation (method: GET). It accepts the
+	// same filters as OperationList, and returns the distinct values of a field annotated
+	// with [WithDistinct], with one endpoint per field.
+	OperationDistinct Operation = "distinct"
+	// OperationBulkCreate represents the bulk create operation (method: POST). It accepts
+	// a list of entities to create, which are all created in a single transaction.
+	OperationBulkCreate Operation = "bulk_create"
+	// OperationBulkDelete represents the bulk delete operation (method: DELETE). It deletes
+	// all entities which match the provided filters, which are the same as OperationList.
+	OperationBulkDelete Operation = "bulk_delete"
+	// OperationBulkUpdate represents the bulk update operation (method: PATCH). It updates
+	// all entities which match the provided filters, which are the same as OperationList.
+	OperationBulkUpdate Operation = "bulk_update"
 )
 
 // DefaultOperations is the default list of operations to generate.
 var DefaultOperations = []Operation{OperationCreate, OperationRead, OperationUpdate, OperationDelete, OperationList}
 
+// PaginationMode represents how paginated list endpoints page through results.
+type PaginationMode string
+
+const (
+	// PaginationOffset pages through results using the "page" and "per_page" query
+	// parameters. A count query is run on each request to calculate the last page.
+	PaginationOffset PaginationMode = "offset"
+	// PaginationCursor pages through results using an opaque "cursor" query parameter
+	// (keyset pagination), which is keyed on the active sort fields plus the ID of the
+	// last returned entity. No count query is run, and results don't drift when entities
+	// are added or removed while paging. Only fields which are never NULL can be sorted
+	// on, and only schemas with an ID field support this mode.
+	PaginationCursor PaginationMode = "cursor"
+)
+
+// CountMode represents how the total number of results is calculated for offset
+// paginated list endpoints.
+type CountMode string
+
+const (
+	// CountNone skips the count query entirely. Whether there are more results is
+	// determined by fetching one additional result, and "total_count" and "last_page"
+	// are returned as null.
+	CountNone CountMode = "false"
+	// CountEstimate uses the "CountEstimator" function of the generated page config to
+	// estimate the total number of results (e.g. using database statistics), falling
+	// back to [CountExact] if no estimator has been configured.
+	CountEstimate CountMode = "estimate"
+	// CountExact runs a count query on each request, to calculate the total number of
+	// results and the last page.
+	CountExact CountMode = "exact"
+)
+
+// CountModes are all supported count modes, ordered from least to most expensive.
+// Clients can request any count mode up to (and including) the configured one, using
+// the "count" query parameter.
+var CountModes = []CountMode{CountNone, CountEstimate, CountExact}
+
 const (
 	defaultMinItemsPerPage = 1
 	defaultMaxItemsPerPage = 100
 	defaultItemsPerPage    = 10
+	defaultMaxExpandDepth  = 3
+	defaultMaxBulkAffected = 1000
 )
 
 // HTTPHandler represents the HTTP handler to use for the HTTP server implementation.
@@ -128,6 +193,46 @@ func (r ErrorResponses) Append(toMerge ...ErrorResponses) ErrorResponses {
 	return out
 }
 
+// Constraints are JSON Schema validation keywords which are applied to the schema of a
+// field. See [WithMinLength], [WithMaxLength], [WithPattern], [WithMinimum] and
+// [WithMaximum].
+type Constraints struct {
+	MinLength *uint64  `json:",omitempty"`
+	MaxLength *uint64  `json:",omitempty"`
+	Pattern   string   `json:",omitempty"`
+	Minimum   *float64 `json:",omitempty"`
+	Maximum   *float64 `json:",omitempty"`
+}
+
+// Merge merges the provided constraints into the current constraints, where any
+// constraints which are set on the provided constraints take precedence.
+func (c *Constraints) Merge(o *Constraints) *Constraints {
+	if o == nil {
+		return c
+	}
+	if c == nil {
+		c = &Constraints{}
+	}
+
+	out := *c
+	if o.MinLength != nil {
+		out.MinLength = o.MinLength
+	}
+	if o.MaxLength != nil {
+		out.MaxLength = o.MaxLength
+	}
+	if o.Pattern != "" {
+		out.Pattern = o.Pattern
+	}
+	if o.Minimum != nil {
+		out.Minimum = o.Minimum
+	}
+	if o.Maximum != nil {
+		out.Maximum = o.Maximum
+	}
+	return &out
+}
+
 var (
 	// RateLimitHeaders are standardized rate limit response headers.
 	RateLimitHeaders = ResponseHeaders{
@@ -148,7 +148,25 @@ func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
 		}
 
 		for _, op := range ops {
-			if t.ID == nil && (op != OperationList && op != OperationCreate) {
+			if t.ID == nil {
+				switch op {
+				case OperationList, OperationSearch, OperationCount, OperationAggregate, OperationDistinct, OperationCreate:
+				case OperationRead, OperationUpdate, OperationDelete:
+					// Edge schemas with a composite ID are addressed by each of their ID
+					// fields instead.
+					if !t.HasCompositeID() {
+						continue
+					}
+				default:
+					continue
+				}
+			}
+			if (op == OperationAggregate && !HasAggregates(t)) || (op == OperationDistinct && len(GetDistinctFields(t)) == 0) {
+				continue
+			}
+			if (op == OperationBulkDelete || op == OperationBulkUpdate) && !HasFilters(t) {
+				// Bulk operations select entities using filters, so they aren't available
+				// for types without any.
 				continue
 			}
 			tspec, err = GetSpecType(t, op)
@@ -187,6 +205,35 @@ func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
 				panic(err)
 			}
 			specs = append(specs, tspec)
+
+			if EdgeHasCreateOperation(edge, t, e.config) {
+				tspec, err = GetSpecEdge(t, edge, OperationCreate)
+				if err != nil {
+					panic(err)
+				}
+				specs = append(specs, tspec)
+			}
+
+			for _, op := range []Operation{OperationRead, OperationUpdate, OperationDelete} {
+				if !EdgeHasNestedOperation(edge, t, e.config, op) {
+					continue
+				}
+				tspec, err = GetSpecEdge(t, edge, op)
+				if err != nil {
+					panic(err)
+				}
+				specs = append(specs, tspec)
+			}
+
+			if EdgeHasLinkOperations(edge, t, e.config) {
+				for _, op := range []Operation{OperationUpdate, OperationDelete} {
+					tspec, err = GetSpecEdge(t, edge, op)
+					if err != nil {
+						panic(err)
+					}
+					specs = append(specs, tspec)
+				}
+			}
 		}
 	}
 
@@ -194,6 +241,13 @@ func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
 		specs = append(specs, addOpenAPIEndpoint("/openapi.json"))
 	}
 
+	if e.config.Handler == HandlerStdlib {
+		err = CheckPathConflicts(specs...)
+		if err != nil {
+			return nil, fmt.Errorf("routes can't be registered with %q handler: %w", e.config.Handler, err)
+		}
+	}
+
 	err = MergeSpecOverlap(spec, specs...)
 	if err != nil {
 		panic(err)
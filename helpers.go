@@ -50,6 +50,89 @@ func EdgeHasOperation(edge *gen.Edge, parentType *gen.Type, config *Config, op O
 	return slices.Contains(config.DefaultOperations, op)
 }
 
+// EdgeHasLinkOperations returns true if link/unlink endpoints should be generated for the
+// given edge (e.g. "PUT /pets/{petID}/friends/{friendID}" and "DELETE /pets/{petID}/friends/{friendID}").
+// These are only supported on non-unique edges (O2M and M2M) which have an edge endpoint,
+// and are considered an update of the parent entity, so they follow the same rules as
+// the "add_<edge>" and "remove_<edge>" fields of the update operation. Edges to
+// subentities get nested operations instead (see [EdgeHasNestedOperation]). Edges whose
+// inverse is required (e.g. a pet which must always have an owner) are excluded, as
+// unlinking would leave the referenced entity without one.
+func EdgeHasLinkOperations(edge *gen.Edge, parentType *gen.Type, config *Config) bool {
+	ea := GetAnnotation(edge)
+
+	return parentType.ID != nil &&
+		edge.Type.ID != nil &&
+		!edge.Unique &&
+		!edge.Immutable &&
+		(edge.Ref == nil || !edge.Ref.Unique || edge.Ref.Optional) &&
+		!ea.ReadOnly &&
+		!ea.GetSkip(config) &&
+		!GetAnnotation(edge.Type).GetSkip(config) &&
+		!GetAnnotation(edge.Type).IsSubentity &&
+		ea.GetEdgeEndpoint(config) &&
+		EdgeHasOperation(edge, parentType, config, OperationUpdate)
+}
+
+// EdgeHasNestedOperation returns true if the nested read, update, or delete operation
+// should be generated for the given edge (e.g. "GET /users/{userID}/settings/{settingID}").
+// Subentities don't have top-level endpoints, so non-unique edges to them get these in
+// place of the link/unlink endpoints. Update and delete aren't generated for read-only
+// edges.
+func EdgeHasNestedOperation(edge *gen.Edge, parentType *gen.Type, config *Config, op Operation) bool {
+	ea := GetAnnotation(edge)
+	ra := GetAnnotation(edge.Type)
+
+	switch op {
+	case OperationRead:
+	case OperationUpdate, OperationDelete:
+		if ea.ReadOnly {
+			return false
+		}
+	default:
+		return false
+	}
+
+	return parentType.ID != nil &&
+		edge.Type.ID != nil &&
+		!edge.Unique &&
+		ra.IsSubentity &&
+		!ea.GetSkip(config) &&
+		!ra.GetSkip(config) &&
+		ea.GetEdgeEndpoint(config) &&
+		ra.HasOperation(config, op)
+}
+
+// EdgeHasCreateOperation returns true if a create endpoint should be generated for the
+// given edge (e.g. "POST /users/{userID}/pets"), which creates an entity of the edge type
+// that is already attached to the parent entity. The referenced type must have an edge
+// back to the parent (which is set from the path), and must support the create operation,
+// as must the edge itself (see [EdgeHasOperation]). Unique inverse edges (e.g. the owner
+// of a pet) and edges using a through schema are excluded, as the referenced entity isn't
+// a child of the parent.
+func EdgeHasCreateOperation(edge *gen.Edge, parentType *gen.Type, config *Config) bool {
+	ea := GetAnnotation(edge)
+	ra := GetAnnotation(edge.Type)
+
+	return parentType.ID != nil &&
+		edge.Type.ID != nil &&
+		edge.Ref != nil &&
+		edge.Through == nil &&
+		!(edge.Unique && edge.IsInverse()) &&
+		!ea.ReadOnly &&
+		!ea.GetSkip(config) &&
+		!ra.GetSkip(config) &&
+		ea.GetEdgeEndpoint(config) &&
+		ra.HasOperation(config, OperationCreate) &&
+		EdgeHasOperation(edge, parentType, config, OperationCreate)
+}
+
+// IsCompositeIDField returns true if the field is part of the composite ID of an edge
+// schema. These fields identify the entity (through the path), and cannot be updated.
+func IsCompositeIDField(t *gen.Type, f *gen.Field) bool {
+	return t.HasCompositeID() && slices.Contains(t.EdgeSchema.ID, f)
+}
+
 // ptr returns a pointer to the given value. Should only be used for primitives.
 func ptr[T any](v T) *T {
 	return &v
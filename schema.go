@@ -11,10 +11,12 @@ import (
 	"fmt"
 	"maps"
 	"math"
+	"regexp"
 	"slices"
 	"strings"
 
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	"github.com/ogen-go/ogen"
 )
 
@@ -133,9 +135,80 @@ func GetSchemaField(f *gen.Field) (*ogen.Schema, error) {
 		}
 	}
 
+	// Ent validators are closures, so the only one which can be derived is the
+	// size set by MaxLen/MaxRuneLen (which isn't overridden by an entsql size). Text
+	// fields default to an unlimited size, which isn't a constraint.
+	if fa.Schema == nil && f.Type.Type == field.TypeString && f.Validators > 0 {
+		if ant := f.EntSQL(); ant == nil || ant.Size == 0 {
+			if size := f.Column().Size; size > 0 && size != math.MaxInt32 {
+				schema.MaxLength = ptr(uint64(size))
+			}
+		}
+	}
+
+	err = applyConstraints(f, schema, fa.Constraints)
+	if err != nil {
+		return nil, err
+	}
+
 	return schema, nil
 }
 
+// applyConstraints applies the constraints provided through annotations to the schema
+// of the field, returning an error if the constraints aren't supported by the type.
+func applyConstraints(f *gen.Field, schema *ogen.Schema, c *Constraints) error {
+	if c == nil {
+		return nil
+	}
+
+	if (c.MinLength != nil || c.MaxLength != nil || c.Pattern != "") && schema.Type != "string" {
+		return fmt.Errorf(
+			"length and pattern constraints are only supported on string fields, field %s has type %q",
+			f.StructField(),
+			schema.Type,
+		)
+	}
+
+	if (c.Minimum != nil || c.Maximum != nil) && schema.Type != "integer" && schema.Type != "number" {
+		return fmt.Errorf(
+			"minimum and maximum constraints are only supported on numeric fields, field %s has type %q",
+			f.StructField(),
+			schema.Type,
+		)
+	}
+
+	if c.MinLength != nil {
+		schema.MinLength = c.MinLength
+	}
+	if c.MaxLength != nil {
+		schema.MaxLength = c.MaxLength
+	}
+
+	if c.Pattern != "" {
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern for field %s: %w", f.StructField(), err)
+		}
+		schema.Pattern = c.Pattern
+	}
+
+	if c.Minimum != nil {
+		v, err := json.Marshal(*c.Minimum)
+		if err != nil {
+			return fmt.Errorf("failed to marshal minimum for field %s: %w", f.StructField(), err)
+		}
+		schema.Minimum = v
+	}
+	if c.Maximum != nil {
+		v, err := json.Marshal(*c.Maximum)
+		if err != nil {
+			return fmt.Errorf("failed to marshal maximum for field %s: %w", f.StructField(), err)
+		}
+		schema.Maximum = v
+	}
+
+	return nil
+}
+
 // GetSchemaType returns a map of ogen.Schemas for the given gen.Type. Multiple may be
 // returned if the type has multiple schemas (e.g. a list of entities, or an entity which
 // has edges). Note that depending on the operation, this schema may be for the request or
@@ -151,6 +224,37 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 
 	entityName := Singularize(t.Name)
 
+	if op == OperationCreate && edge != nil {
+		// Creating through an edge uses the create schema of the edge type, without the
+		// edge back to the parent, as the parent is provided through the path.
+		refName := Singularize(edge.Type.Name)
+		schemas = GetSchemaType(edge.Type, op, nil)
+
+		omit := []string{edge.Ref.Name}
+		if edge.Ref.Field() != nil {
+			omit = append(omit, edge.Ref.Field().Name)
+		}
+
+		schema := *schemas[refName+"Create"]
+		delete(schemas, refName+"Create")
+
+		schema.Description = fmt.Sprintf(
+			"A single %s entity and the fields that can be created, when created through the %s of a %s.",
+			refName,
+			Pluralize(CamelCase(edge.Name)),
+			entityName,
+		)
+		schema.Properties = slices.DeleteFunc(slices.Clone(schema.Properties), func(p ogen.Property) bool {
+			return slices.Contains(omit, p.Name)
+		})
+		schema.Required = slices.DeleteFunc(slices.Clone(schema.Required), func(name string) bool {
+			return slices.Contains(omit, name)
+		})
+
+		schemas[entityName+Singularize(PascalCase(edge.Name))+"Create"] = &schema
+		return schemas
+	}
+
 	switch op {
 	case OperationCreate, OperationUpdate, OperationUpsert, OperationCreateOrReplace:
 		schema := &ogen.Schema{
@@ -189,7 +293,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 			fa := GetAnnotation(f)
 
 			// Sensitive fields are allowed to be set in create/update by default.
-			if fa.GetSkip(cfg) || fa.ReadOnly {
+			if fa.GetSkip(cfg) || fa.ReadOnly || (op == OperationUpdate && IsCompositeIDField(t, f)) {
 				continue
 			}
 
@@ -222,7 +326,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 			if ea.GetSkip(cfg) || ea.ReadOnly || !EdgeHasOperation(e, t, cfg, op) {
 				continue
 			}
-			if op == OperationUpdate && (e.Immutable || (e.Field() != nil && e.Field().Immutable)) {
+			if op == OperationUpdate && (e.Immutable || (e.Field() != nil && (e.Field().Immutable || IsCompositeIDField(t, e.Field())))) {
 				continue
 			}
 
@@ -357,10 +461,13 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 			Required:   []string{},
 		}
 
+		expandable := GetExpandableEdges(t)
+
 		for _, e := range t.Edges {
 			ea := GetAnnotation(e)
+			eagerLoad := ea.GetEagerLoad(cfg)
 
-			if ea.GetSkip(cfg) || !ea.GetEagerLoad(cfg) {
+			if ea.GetSkip(cfg) || (!eagerLoad && !slices.Contains(expandable, e)) {
 				continue
 			}
 
@@ -379,7 +486,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 				}
 			}
 
-			if !e.Optional {
+			if eagerLoad && !e.Optional {
 				// TODO: nullable?
 				// prop.Schema.Nullable = true
 				edgeSchema.Required = append(edgeSchema.Required, e.Name)
@@ -396,8 +503,18 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 		schemas[entityName] = schema
 
 		if len(edgeSchema.Properties) > 0 {
+			description := schema.Description
+
+			if len(expandable) > 0 {
+				edgeSchema.Description = fmt.Sprintf(
+					"Additional edges can be requested with the \"expand\" parameter: %s.",
+					strings.Join(GetExpandablePaths(t), ", "),
+				)
+				description = strings.TrimSpace(description + " " + edgeSchema.Description)
+			}
+
 			schemas[entityName+"Read"] = &ogen.Schema{
-				Description: schema.Description,
+				Description: description,
 				AllOf: []*ogen.Schema{
 					{Ref: "#/components/schemas/" + entityName},
 					{
@@ -436,7 +553,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 				// If edge pagination is enabled, but edge type isn't paginated, we cannot re-use
 				// the paginated schema from the edge type.
 				if !ra.GetPagination(cfg, edge) && ea.GetPagination(cfg, edge) {
-					schema = toPagedSchema(schema)
+					schema = toPagedSchema(schema, PaginationOffset)
 				}
 
 				// We're setting a specific schema for the edge response because we cannot re-use
@@ -461,12 +578,91 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 		} else {
 			schemas[entityName+"List"] = toPagedSchema(
 				ogen.NewSchema().
-					SetRef("#/components/schemas/" + entityName + "Read").
+					SetRef("#/components/schemas/"+entityName+"Read").
 					SetDescription(fmt.Sprintf("A paginated result set of %s entities. Includes eager-loaded edges (if any) for each entity.", entityName)),
+				ta.GetPaginationMode(cfg, t.ID != nil),
 			)
 		}
 
 		dependencies = append(dependencies, OperationRead)
+	case OperationBulkCreate:
+		schemas[entityName+"BulkCreate"] = &ogen.Schema{
+			Description: fmt.Sprintf("A list of %s entities to create, in a single transaction.", entityName),
+			Type:        "array",
+			Items:       &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/" + entityName + "Create"}},
+			MinItems:    ptr(uint64(1)),
+		}
+
+		dependencies = append(dependencies, OperationCreate, OperationRead)
+	case OperationAggregate:
+		row := &ogen.Schema{
+			Description: fmt.Sprintf(
+				"A single row of aggregated values for %s entities. Fields which weren't grouped by or aggregated are omitted.",
+				entityName,
+			),
+			Type: "object",
+			Properties: ogen.Properties{
+				{Name: "count", Schema: &ogen.Schema{
+					Type:        "integer",
+					Description: fmt.Sprintf("The number of %s entities in the group.", entityName),
+				}},
+			},
+			Required: []string{"count"},
+		}
+
+		var fieldSchema *ogen.Schema
+
+		for _, f := range GetAggregateFields(t, AggregateGroupBy) {
+			fieldSchema, err = GetSchemaField(f)
+			if err != nil {
+				panic(fmt.Sprintf("failed to generate schema for field %s: %v", f.StructField(), err))
+			}
+			fieldSchema.Nullable = fieldSchema.Nullable || f.Optional
+
+			// Hoist enums into components to reduce duplication where possible.
+			if updated, asRef, ref, ok := hoistEnums(t, f, fieldSchema); ok {
+				schemas[ref] = updated
+				row.Properties = append(row.Properties, ogen.Property{Name: f.Name, Schema: asRef})
+			} else {
+				row.Properties = append(row.Properties, *updated.ToProperty(f.Name))
+			}
+		}
+
+		for _, a := range GetAggregates(t) {
+			if a.Func == AggregateAvg {
+				fieldSchema = &ogen.Schema{Type: "number", Format: "double"}
+			} else {
+				fieldSchema, err = GetSchemaField(a.Field)
+				if err != nil {
+					panic(fmt.Sprintf("failed to generate schema for field %s: %v", a.Field.StructField(), err))
+				}
+				fieldSchema.Default = nil
+				fieldSchema.Example = nil
+
+				if a.Func == AggregateSum {
+					// Sums can easily exceed the bounds of the individual values.
+					fieldSchema.Minimum = nil
+					fieldSchema.Maximum = nil
+				}
+			}
+			fieldSchema.Nullable = true
+			fieldSchema.Description = fmt.Sprintf("The %s of the %q field, for the group.", aggregateDescription(a.Func), a.Field.Name)
+			row.Properties = append(row.Properties, *fieldSchema.ToProperty(a.Name()))
+		}
+
+		schemas[entityName+"AggregateRow"] = row
+		schemas[entityName+"AggregateList"] = &ogen.Schema{
+			Description: fmt.Sprintf("A list of aggregated values for %s entities, with one row per group.", entityName),
+			Type:        "array",
+			Items:       &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/" + entityName + "AggregateRow"}},
+		}
+	case OperationBulkDelete, OperationCount, OperationDistinct:
+	case OperationBulkUpdate:
+		dependencies = append(dependencies, OperationUpdate)
+	case OperationSearch:
+		// The request body schema is generated alongside the operation, as it's built from
+		// the list parameters. The response is the same as the list operation.
+		dependencies = append(dependencies, OperationList)
 	case OperationDelete:
 	default:
 		panic(fmt.Sprintf("unsupported operation %q", op))
@@ -522,9 +718,10 @@ func hoistEnums(t *gen.Type, f *gen.Field, existing *ogen.Schema) (updated, asRe
 	return existing, nil, "", false
 }
 
-// toPagedSchema converts a response schema to a paged response schema, hoisting the
-// description from the response schema to the paged response schema.
-func toPagedSchema(schema *ogen.Schema) *ogen.Schema {
+// toPagedSchema converts a response schema to a paged response schema (based on the
+// pagination mode), hoisting the description from the response schema to the paged
+// response schema.
+func toPagedSchema(schema *ogen.Schema, mode PaginationMode) *ogen.Schema {
 	desc := schema.Description
 	schema.Description = ""
 
@@ -535,7 +732,7 @@ func toPagedSchema(schema *ogen.Schema) *ogen.Schema {
 	return &ogen.Schema{
 		Description: desc,
 		AllOf: []*ogen.Schema{
-			{Ref: "#/components/schemas/PagedResponse"},
+			{Ref: "#/components/schemas/" + pagedResponseSchema(mode)},
 			{
 				Type: "object",
 				Properties: ogen.Properties{{
@@ -548,6 +745,15 @@ func toPagedSchema(schema *ogen.Schema) *ogen.Schema {
 	}
 }
 
+// pagedResponseSchema returns the name of the component schema containing the
+// pagination fields for the provided pagination mode.
+func pagedResponseSchema(mode PaginationMode) string {
+	if mode == PaginationCursor {
+		return "CursorPagedResponse"
+	}
+	return "PagedResponse"
+}
+
 // tolistSchema converts a response schema to a List response schema, hoisting the
 // description from the response schema to the paged response schema.
 func toListSchema(schema *ogen.Schema) *ogen.Schema {
@@ -0,0 +1,131 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+)
+
+// AggregateFunc represents how a field can be used with the aggregate operation.
+type AggregateFunc string
+
+const (
+	// AggregateGroupBy allows grouping aggregate results by the field.
+	AggregateGroupBy AggregateFunc = "group_by"
+	// AggregateSum allows calculating the sum of the field. Only numeric fields are
+	// supported.
+	AggregateSum AggregateFunc = "sum"
+	// AggregateAvg allows calculating the average of the field. Only numeric fields are
+	// supported.
+	AggregateAvg AggregateFunc = "avg"
+	// AggregateMin allows calculating the minimum value of the field. Only numeric
+	// fields are supported.
+	AggregateMin AggregateFunc = "min"
+	// AggregateMax allows calculating the maximum value of the field. Only numeric
+	// fields are supported.
+	AggregateMax AggregateFunc = "max"
+)
+
+// AggregateFuncs are the aggregate functions which can be applied to numeric fields,
+// in the order they are included in the aggregate operation.
+var AggregateFuncs = []AggregateFunc{AggregateSum, AggregateAvg, AggregateMin, AggregateMax}
+
+// aggregateDescription returns a human readable name for the provided aggregate function.
+func aggregateDescription(fn AggregateFunc) string {
+	switch fn {
+	case AggregateSum:
+		return "sum"
+	case AggregateAvg:
+		return "average"
+	case AggregateMin:
+		return "minimum value"
+	case AggregateMax:
+		return "maximum value"
+	default:
+		panic(fmt.Sprintf("unsupported aggregate function %q", fn))
+	}
+}
+
+// GetAggregateFields returns the fields of the given type which support the provided
+// aggregate function (or grouping, when using [AggregateGroupBy]).
+func GetAggregateFields(t *gen.Type, fn AggregateFunc) (fields []*gen.Field) {
+	cfg := GetConfig(t.Config)
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || !slices.Contains(fa.Aggregate, fn) {
+			continue
+		}
+
+		if f.Sensitive() {
+			panic(fmt.Sprintf("field %q on schema %q is sensitive, and cannot be used with aggregates", f.Name, t.Name))
+		}
+
+		switch {
+		case fn == AggregateGroupBy && (f.IsJSON() || f.IsOther() || f.Type.Type == field.TypeBytes):
+			panic(fmt.Sprintf("field %q on schema %q cannot be grouped by, as it's not a scalar type", f.Name, t.Name))
+		case fn != AggregateGroupBy && !f.Type.Numeric():
+			panic(fmt.Sprintf("aggregate %q on field %q of schema %q is only supported on numeric fields", fn, f.Name, t.Name))
+		}
+
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// Aggregate is an aggregate function applied to a field, which is returned as its own
+// column in the rows of the aggregate operation.
+type Aggregate struct {
+	Func  AggregateFunc
+	Field *gen.Field
+}
+
+// Name returns the name of the column in the aggregate rows (e.g. "age_sum").
+func (a *Aggregate) Name() string {
+	return a.Field.Name + "_" + string(a.Func)
+}
+
+// StructField returns the name of the struct field in the aggregate rows (e.g. "AgeSum").
+func (a *Aggregate) StructField() string {
+	return a.Field.StructField() + PascalCase(string(a.Func))
+}
+
+// EntFunc returns the name of the ent aggregate function (e.g. "Sum" for ent.Sum).
+func (a *Aggregate) EntFunc() string {
+	if a.Func == AggregateAvg {
+		return "Mean"
+	}
+	return PascalCase(string(a.Func))
+}
+
+// GoType returns the Go type of the aggregated value. Averages are always floats, and
+// all other functions return the same type as the field.
+func (a *Aggregate) GoType() string {
+	if a.Func == AggregateAvg {
+		return "float64"
+	}
+	return a.Field.Type.String()
+}
+
+// GetAggregates returns all aggregate functions which can be applied to the fields of
+// the given type, ordered by function, then by field.
+func GetAggregates(t *gen.Type) (aggregates []*Aggregate) {
+	for _, fn := range AggregateFuncs {
+		for _, f := range GetAggregateFields(t, fn) {
+			aggregates = append(aggregates, &Aggregate{Func: fn, Field: f})
+		}
+	}
+	return aggregates
+}
+
+// HasAggregates returns true if the given type has any fields which can be used with
+// the aggregate operation.
+func HasAggregates(t *gen.Type) bool {
+	return len(GetAggregateFields(t, AggregateGroupBy)) > 0 || len(GetAggregates(t)) > 0
+}
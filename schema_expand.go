@@ -0,0 +1,51 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// GetExpandableEdges returns the edges of the given type which clients can eager-load
+// at request time, using the "expand" query parameter.
+func GetExpandableEdges(t *gen.Type) (expandable []*gen.Edge) {
+	cfg := GetConfig(t.Config)
+
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+		if !ea.Expandable || ea.GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg) {
+			continue
+		}
+		expandable = append(expandable, e)
+	}
+	return expandable
+}
+
+// GetExpandablePaths returns all (dotted) edge paths which clients can request with the
+// "expand" query parameter for the given type, e.g. "pets" and "pets.categories". Paths
+// are limited to [Config.MaxExpandDepth]. To protect against cycles, an edge which leads
+// back to a type already in the path (e.g. "friends" from a pet to other pets) can be
+// expanded, but its own edges can't.
+func GetExpandablePaths(t *gen.Type) []string {
+	return expandablePaths(t, GetConfig(t.Config).MaxExpandDepth, []*gen.Type{t}, "")
+}
+
+func expandablePaths(t *gen.Type, depth int, visited []*gen.Type, prefix string) (paths []string) {
+	if depth < 1 {
+		return nil
+	}
+
+	for _, e := range GetExpandableEdges(t) {
+		path := prefix + e.Name
+		paths = append(paths, path)
+
+		if !slices.Contains(visited, e.Type) {
+			paths = append(paths, expandablePaths(e.Type, depth-1, append(slices.Clone(visited), e.Type), path+".")...)
+		}
+	}
+	return paths
+}
@@ -0,0 +1,182 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+)
+
+// GetSelectableFields returns the fields of the given type which clients can select
+// using the "fields" query parameter, which is all fields returned in read responses.
+// The ID is always returned, regardless of which fields are selected. Types without
+// an ID don't support field selection.
+func GetSelectableFields(t *gen.Type) (selectable []*gen.Field) {
+	if t.ID == nil {
+		return nil
+	}
+
+	cfg := GetConfig(t.Config)
+	selectable = append(selectable, t.ID)
+
+	for _, f := range t.Fields {
+		if GetAnnotation(f).GetSkip(cfg) || f.Sensitive() {
+			continue
+		}
+		selectable = append(selectable, f)
+	}
+	return selectable
+}
+
+// GetSelectableEdges returns the edges of the given type which clients can select the
+// fields of, using the "fields[<edge>]" query parameter. This is limited to edges
+// which are returned in read responses, i.e. those which are eager-loaded or
+// expandable.
+func GetSelectableEdges(t *gen.Type) (selectable []*gen.Edge) {
+	if t.ID == nil {
+		return nil
+	}
+
+	cfg := GetConfig(t.Config)
+	expandable := GetExpandableEdges(t)
+
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+
+		if e.Type.ID == nil || ea.GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg) {
+			continue
+		}
+
+		if ea.GetEagerLoad(cfg) || slices.Contains(expandable, e) {
+			selectable = append(selectable, e)
+		}
+	}
+	return selectable
+}
+
+// GetDistinctFields returns the fields of the given type which clients can list the
+// distinct values of, i.e. those annotated with [WithDistinct]. Only string, enum,
+// numeric and boolean fields are supported.
+func GetDistinctFields(t *gen.Type) (distinct []*gen.Field) {
+	cfg := GetConfig(t.Config)
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || !fa.Distinct {
+			continue
+		}
+
+		if f.Sensitive() {
+			panic(fmt.Sprintf("field %q on schema %q is sensitive, and cannot be used with distinct", f.Name, t.Name))
+		}
+
+		if !f.IsString() && !f.IsEnum() && !f.IsBool() && !f.Type.Numeric() {
+			panic(fmt.Sprintf(
+				"field %q on schema %q has type %q, but distinct is only supported on string, enum, numeric and boolean fields",
+				f.Name,
+				t.Name,
+				f.Type.String(),
+			))
+		}
+
+		distinct = append(distinct, f)
+	}
+	return distinct
+}
+
+// GetLookupFields returns the fields of the given type which can be used to look up
+// entities as an alternative to the ID, i.e. those annotated with [WithLookupKey].
+func GetLookupFields(t *gen.Type) (lookup []*gen.Field) {
+	cfg := GetConfig(t.Config)
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || !fa.LookupKey {
+			continue
+		}
+
+		if !f.Unique {
+			panic(fmt.Sprintf("field %q on schema %q is not unique, and cannot be used as a lookup key", f.Name, t.Name))
+		}
+
+		if f.Sensitive() {
+			panic(fmt.Sprintf("field %q on schema %q is sensitive, and cannot be used as a lookup key", f.Name, t.Name))
+		}
+
+		// Lookup keys are resolved from the path in the same way as IDs, which only
+		// supports strings, ints, and types which can be unmarshalled from text.
+		switch {
+		case f.Type.Type == field.TypeUUID:
+		case (f.Type.Type == field.TypeString || f.Type.Type == field.TypeInt) && f.Type.RType == nil:
+		default:
+			panic(fmt.Sprintf(
+				"field %q on schema %q has type %q, but lookup keys are only supported on string, int and UUID fields",
+				f.Name,
+				t.Name,
+				f.Type.String(),
+			))
+		}
+
+		lookup = append(lookup, f)
+	}
+	return lookup
+}
+
+// GetETagField returns the field of the given type which ETags are derived from, i.e. the
+// field annotated with [WithETag], or nil if the ETag should be derived from a hash of all
+// fields of the entity.
+func GetETagField(t *gen.Type) (etag *gen.Field) {
+	cfg := GetConfig(t.Config)
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || !fa.ETag {
+			continue
+		}
+
+		if etag != nil {
+			panic(fmt.Sprintf("schema %q has multiple ETag fields (%q and %q), only one is supported", t.Name, etag.Name, f.Name))
+		}
+
+		if f.Sensitive() {
+			panic(fmt.Sprintf("field %q on schema %q is sensitive, and cannot be used as the ETag", f.Name, t.Name))
+		}
+
+		etag = f
+	}
+	return etag
+}
+
+// GetLastModifiedField returns the timestamp field of the given type which the "Last-Modified"
+// header is derived from, i.e. the field annotated with [WithLastModified], or nil if there is
+// none.
+func GetLastModifiedField(t *gen.Type) (modified *gen.Field) {
+	cfg := GetConfig(t.Config)
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || !fa.LastModified {
+			continue
+		}
+
+		if modified != nil {
+			panic(fmt.Sprintf("schema %q has multiple Last-Modified fields (%q and %q), only one is supported", t.Name, modified.Name, f.Name))
+		}
+
+		if f.Type.Type != field.TypeTime {
+			panic(fmt.Sprintf("field %q on schema %q is not a time field, and cannot be used as the Last-Modified timestamp", f.Name, t.Name))
+		}
+
+		if f.Sensitive() {
+			panic(fmt.Sprintf("field %q on schema %q is sensitive, and cannot be used as the Last-Modified timestamp", f.Name, t.Name))
+		}
+
+		modified = f
+	}
+	return modified
+}
@@ -336,7 +336,15 @@ func (f *FilterableFieldOp) Parameter() *ogen.Parameter {
 		Deprecated: f.fieldSchema.Deprecated,
 	}
 
+	// Partial matches (e.g. contains) can be shorter than the field allows, and range
+	// or nil checks aren't compared against the length at all.
+	if !slices.Contains([]gen.Op{gen.EQ, gen.NEQ, gen.In, gen.NotIn}, f.Operation) {
+		schema.MinLength = nil
+	}
+
 	if f.Operation == gen.GT || f.Operation == gen.LT || f.Operation == gen.GTE || f.Operation == gen.LTE {
+		schema.MaxLength = nil
+
 		if schema.Items != nil && f.fieldSchema.Format != "date-time" {
 			schema.Items.Item.Type = "number"
 		} else if f.fieldSchema.Format != "date-time" {
@@ -345,6 +353,8 @@ func (f *FilterableFieldOp) Parameter() *ogen.Parameter {
 	}
 
 	if f.Operation == gen.IsNil {
+		schema.MaxLength = nil
+
 		if schema.Items != nil {
 			schema.Items.Item.Type = "boolean"
 			schema.Items.Item.Format = ""
@@ -574,12 +584,19 @@ func GetFilterGroups(t *gen.Type, edge *gen.Edge) []*FilterGroup {
 		}
 
 		if _, ok := groups[fa.FilterGroup]; !ok {
+			// Length and pattern constraints are specific to each field in the group,
+			// and the group parameter is also used for partial matches.
+			groupSchema := *fieldSchema
+			groupSchema.MinLength = nil
+			groupSchema.MaxLength = nil
+			groupSchema.Pattern = ""
+
 			groups[fa.FilterGroup] = &FilterGroup{
 				Name:       fa.FilterGroup,
 				Type:       t,
 				FieldType:  f.Type,
 				Operations: ops,
-				Schema:     fieldSchema,
+				Schema:     &groupSchema,
 			}
 		}
 
@@ -638,3 +655,9 @@ func GetFilterGroups(t *gen.Type, edge *gen.Edge) []*FilterGroup {
 		return strings.Compare(a.Name, b.Name)
 	})
 }
+
+// HasFilters returns true if the given type has any filters, either individual filters
+// or filter groups.
+func HasFilters(t *gen.Type) bool {
+	return len(GetFilterableFields(t, nil)) > 0 || len(GetFilterGroups(t, nil)) > 0
+}
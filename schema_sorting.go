@@ -22,8 +22,23 @@ const (
 	OrderDesc SortOrder = "desc"
 )
 
-// GetSortableFields returnsd a list of sortable fields for the given type. It
-// recurses through edges to find sortable fields as well.
+// NullsOrder represents where NULL values are placed when sorting.
+type NullsOrder string
+
+const (
+	// NullsFirst places NULL values before all other values.
+	NullsFirst NullsOrder = "first"
+	// NullsLast places NULL values after all other values.
+	NullsLast NullsOrder = "last"
+)
+
+// GetSortableFields returns a list of sortable fields for the given type. It
+// recurses through edges to find sortable fields as well. If the type uses
+// [PaginationCursor], only fields which a cursor can be keyed on are returned.
+//
+// Clients can sort by multiple of these fields at once (e.g. "sort=-created_at,name"),
+// where a "-" prefix sorts the field in descending order. The ID is always appended
+// as a tie-breaker, so results are ordered consistently across pages.
 func GetSortableFields(t *gen.Type, edge *gen.Edge) (sortable []string) {
 	cfg := GetConfig(t.Config)
 	ta := GetAnnotation(t)
@@ -33,7 +48,9 @@ func GetSortableFields(t *gen.Type, edge *gen.Edge) (sortable []string) {
 		fields = append([]*gen.Field{t.ID}, fields...)
 	}
 
-	if edge == nil {
+	cursor := edge == nil && ta.GetPagination(cfg, nil) && ta.GetPaginationMode(cfg, t.ID != nil) == PaginationCursor
+
+	if edge == nil && !cursor {
 		sortable = append(sortable, "random")
 	}
 
@@ -45,10 +62,13 @@ func GetSortableFields(t *gen.Type, edge *gen.Edge) (sortable []string) {
 		if !f.IsString() && !f.IsTime() && !f.IsBool() && !f.IsInt() && !f.IsInt64() && !f.IsUUID() {
 			continue
 		}
+		if cursor && !isCursorField(f) {
+			continue
+		}
 		sortable = append(sortable, f.Name)
 	}
 
-	if edge == nil {
+	if edge == nil && !cursor {
 		for _, e := range t.Edges {
 			ea := GetAnnotation(e)
 
@@ -88,3 +108,41 @@ func GetSortableFields(t *gen.Type, edge *gen.Edge) (sortable []string) {
 	slices.Sort(sortable)
 	return slices.Compact(sortable)
 }
+
+// isCursorField returns true if a cursor can be keyed on the provided field. The
+// keyset comparison used by cursor pagination doesn't work with NULL values.
+func isCursorField(f *gen.Field) bool {
+	return !f.Optional && !f.Nillable
+}
+
+// GetCursorFields returns the fields (excluding the ID field) which the cursor for
+// cursor-paginated list endpoints can be keyed on, for the given type.
+func GetCursorFields(t *gen.Type) (fields []*gen.Field) {
+	sortable := GetSortableFields(t, nil)
+
+	for _, f := range t.Fields {
+		if slices.Contains(sortable, f.Name) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GetNullableSortFields returns the sortable fields (excluding edge fields) of the given
+// type which can contain NULL values, and as such, can have the placement of NULL values
+// controlled when sorting (see [WithSortNulls]).
+func GetNullableSortFields(t *gen.Type) (fields []*gen.Field) {
+	sortable := GetSortableFields(t, nil)
+
+	for _, f := range t.Fields {
+		if isCursorField(f) || !slices.Contains(sortable, f.Name) {
+			continue
+		}
+
+		if v := GetAnnotation(f).SortNulls; v != "" && v != NullsFirst && v != NullsLast {
+			panic(fmt.Sprintf("invalid sort nulls value %q on field %q of schema %q", v, f.Name, t.Name))
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
@@ -21,9 +21,9 @@ import (
 	"github.com/ogen-go/ogen/jsonschema"
 )
 
-const eagerLoadDepthMessage = "If the entity has eager-loaded edges, the depth of when those will be loaded is limited to a depth of 1 (entity -> edge, not entity -> edge -> edge -> etc)."
+const eagerLoadDepthMessage = "If the entity has eager-loaded edges, the depth of when those will be loaded is limited to a depth of 1 (entity -> edge, not entity -> edge -> edge -> etc). Deeper edges can only be loaded with the \"expand\" parameter, where supported."
 
-func addPagination(spec *ogen.Spec, _ *Config) {
+func addPagination(spec *ogen.Spec, _ *Config, mode PaginationMode) {
 	if spec.Components == nil {
 		spec.Components = &ogen.Components{}
 	}
@@ -32,21 +32,52 @@ func addPagination(spec *ogen.Spec, _ *Config) {
 		spec.Components.Parameters = make(map[string]*ogen.Parameter)
 	}
 
-	if _, ok := spec.Components.Schemas["Page"]; !ok {
-		spec.Components.Parameters["Page"] = &ogen.Parameter{
-			Name:        "page",
-			In:          "query",
-			Description: "The page number to retrieve.",
-			Schema: ogen.Int().
-				SetMinimum(ptr(int64(1))).
-				SetDefault(json.RawMessage(`1`)),
-		}
-	}
-
 	if spec.Components.Schemas == nil {
 		spec.Components.Schemas = make(map[string]*ogen.Schema)
 	}
 
+	if mode == PaginationCursor {
+		if _, ok := spec.Components.Parameters["Cursor"]; !ok {
+			spec.Components.Parameters["Cursor"] = &ogen.Parameter{
+				Name:        "cursor",
+				In:          "query",
+				Description: "The opaque cursor to continue from, as returned in the \"next_cursor\" field of the previous page. Must be used with the same sort fields and order as the previous page.",
+				Schema:      ogen.String(),
+			}
+		}
+
+		if _, ok := spec.Components.Schemas["CursorPagedResponse"]; ok {
+			return
+		}
+
+		spec.Components.Schemas["CursorPagedResponse"] = &ogen.Schema{
+			Type: "object",
+			Properties: ogen.Properties{
+				{
+					Name: "next_cursor",
+					Schema: &ogen.Schema{
+						Type:        "string",
+						Description: "The cursor to provide to retrieve the next page of results, or null if this is the last page.",
+						Example:     jsonschema.RawValue(`"eyJmIjoiaWQiLCJvIjoiYXNjIiwiaWQiOjEwfQ"`),
+						Nullable:    true,
+					},
+				},
+				{
+					Name: "is_last_page",
+					Schema: &ogen.Schema{
+						Type:        "boolean",
+						Description: "If true, the current results are the last page of results.",
+						Example:     jsonschema.RawValue(`false`),
+					},
+				},
+			},
+			Required: []string{"next_cursor", "is_last_page"},
+		}
+		return
+	}
+
+	addPageParameter(spec)
+
 	if _, ok := spec.Components.Schemas["PagedResponse"]; ok {
 		return
 	}
@@ -67,9 +98,10 @@ func addPagination(spec *ogen.Spec, _ *Config) {
 				Name: "last_page",
 				Schema: &ogen.Schema{
 					Type:        "integer",
-					Description: "The number of the last page of results.",
+					Description: "The number of the last page of results. Approximate if the total count was estimated, and null if the total count was skipped.",
 					Example:     jsonschema.RawValue(`3`),
 					Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
+					Nullable:    true,
 				},
 			},
 			{
@@ -80,22 +112,124 @@ func addPagination(spec *ogen.Spec, _ *Config) {
 					Example:     jsonschema.RawValue(`false`),
 				},
 			},
+			{
+				Name: "has_more",
+				Schema: &ogen.Schema{
+					Type:        "boolean",
+					Description: "If true, there are more results after the current page. Unlike the total count, this is always accurate.",
+					Example:     jsonschema.RawValue(`true`),
+				},
+			},
 			{
 				Name: "total_count",
 				Schema: &ogen.Schema{
 					Type:        "integer",
-					Description: "The total number of results based on the provided query.",
+					Description: "The total number of results based on the provided query. Approximate if the total count was estimated, and null if the total count was skipped (see the \"count\" parameter).",
 					Example:     jsonschema.RawValue(`123`),
 					Minimum:     ogen.Int().SetMinimum(ptr(int64(0))).Minimum,
+					Nullable:    true,
 				},
 			},
 		},
-		Required: []string{"page", "last_page", "is_last_page", "total_count"},
+		Required: []string{"page", "last_page", "is_last_page", "has_more", "total_count"},
 	}
 
 	spec.Components.Schemas["PagedResponse"] = pagedSchema
 }
 
+// addPageParameter adds the "page" parameter, used by offset pagination, into the spec.
+func addPageParameter(spec *ogen.Spec) {
+	if _, ok := spec.Components.Parameters["Page"]; !ok {
+		spec.Components.Parameters["Page"] = &ogen.Parameter{
+			Name:        "page",
+			In:          "query",
+			Description: "The page number to retrieve.",
+			Schema: ogen.Int().
+				SetMinimum(ptr(int64(1))).
+				SetDefault(json.RawMessage(`1`)),
+		}
+	}
+}
+
+// addDistinctComponents adds the schema entry containing the pagination fields of the
+// response of distinct operations, and the "page" parameter, into the spec. Distinct
+// values are always paged using offset pagination, as there are no entities to key a
+// cursor on, and the total number of values isn't counted.
+func addDistinctComponents(spec *ogen.Spec) {
+	addPageParameter(spec)
+
+	if _, ok := spec.Components.Schemas["DistinctPagedResponse"]; ok {
+		return
+	}
+
+	spec.Components.Schemas["DistinctPagedResponse"] = &ogen.Schema{
+		Type: "object",
+		Properties: ogen.Properties{
+			{
+				Name: "page",
+				Schema: &ogen.Schema{
+					Type:        "integer",
+					Description: "Page which the values are associated with.",
+					Example:     jsonschema.RawValue(`1`),
+					Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
+				},
+			},
+			{
+				Name: "is_last_page",
+				Schema: &ogen.Schema{
+					Type:        "boolean",
+					Description: "If true, the current values are the last page of values.",
+					Example:     jsonschema.RawValue(`false`),
+				},
+			},
+			{
+				Name: "has_more",
+				Schema: &ogen.Schema{
+					Type:        "boolean",
+					Description: "If true, there are more values after the current page.",
+					Example:     jsonschema.RawValue(`true`),
+				},
+			},
+		},
+		Required: []string{"page", "is_last_page", "has_more"},
+	}
+}
+
+// paginationParameter returns the parameter used to select the page of results for
+// the provided pagination mode.
+func paginationParameter(mode PaginationMode) *ogen.Parameter {
+	if mode == PaginationCursor {
+		return &ogen.Parameter{Ref: "#/components/parameters/Cursor"}
+	}
+	return &ogen.Parameter{Ref: "#/components/parameters/Page"}
+}
+
+// countParameter returns the parameter used to select how the total number of results
+// is calculated, or nil if counting is disabled, in which case clients have nothing to
+// choose from.
+func countParameter(mode CountMode) *ogen.Parameter {
+	if mode == CountNone {
+		return nil
+	}
+
+	var allowed []CountMode
+	for _, m := range CountModes {
+		allowed = append(allowed, m)
+		if m == mode {
+			break
+		}
+	}
+
+	return &ogen.Parameter{
+		Name:        "count",
+		In:          "query",
+		Description: "How the total number of results is calculated. \"exact\" runs a count query, \"estimate\" uses a (cheaper) estimate where supported, and \"false\" skips counting, in which case \"total_count\" and \"last_page\" are null.",
+		Schema: ogen.String().
+			SetEnum(sliceToRawMessage(allowed)).
+			SetDefault(json.RawMessage(strconv.Quote(string(mode)))),
+	}
+}
+
 func newBaseSpec(_ *Config) *ogen.Spec {
 	spec := &ogen.Spec{
 		Paths: ogen.Paths{},
@@ -149,18 +283,38 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		Description: ta.Description,
 	})
 
-	if op != OperationList && op != OperationCreate {
-		idSchema, err := GetSchemaField(t.ID)
-		if err != nil {
-			return nil, err
+	var idParams []*ogen.Parameter
+	var err error
+
+	if !slices.Contains([]Operation{
+		OperationList,
+		OperationSearch,
+		OperationCount,
+		OperationAggregate,
+		OperationDistinct,
+		OperationCreate,
+		OperationBulkCreate,
+		OperationBulkDelete,
+		OperationBulkUpdate,
+	}, op) {
+		if t.ID != nil {
+			idSchema, err := GetSchemaField(t.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			spec.Components.Parameters[Singularize(t.Name)+"ID"] = &ogen.Parameter{
+				Name:        CamelCase(Singularize(t.Name)) + "ID",
+				In:          "path",
+				Description: fmt.Sprintf("The ID of the %s to act upon.", entityName),
+				Required:    true,
+				Schema:      idSchema,
+			}
 		}
 
-		spec.Components.Parameters[Singularize(t.Name)+"ID"] = &ogen.Parameter{
-			Name:        CamelCase(Singularize(t.Name)) + "ID",
-			In:          "path",
-			Description: fmt.Sprintf("The ID of the %s to act upon.", entityName),
-			Required:    true,
-			Schema:      idSchema,
+		idParams, err = idParameters(t)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -190,6 +344,41 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			},
 		}
 
+		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
+			Summary:     oper.Summary,
+			Description: oper.Description,
+			Post:        oper,
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/PrettyResponse"},
+			},
+		}
+	case OperationBulkCreate:
+		oper := &ogen.Operation{
+			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
+			Summary: cmp.Or(
+				ta.GetOperationSummary(op),
+				"Create multiple "+CamelCase(Pluralize(t.Name)),
+			),
+			Description: cmp.Or(
+				ta.GetOperationDescription(op),
+				fmt.Sprintf(
+					"Create multiple %s entities in a single transaction. If any of the entities are invalid, none are created. %s",
+					entityName,
+					eagerLoadDepthMessage,
+				),
+			),
+			OperationID: GetOperationIDName(op, t, nil),
+			Deprecated:  ta.Deprecated,
+			RequestBody: ogen.NewRequestBody().
+				SetRequired(true).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + entityName + "BulkCreate"}),
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusCreated): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The created %s entities, in the same order as provided.", entityName)).
+					SetJSONContent(ogen.NewSchema().SetRef("#/components/schemas/" + entityName + "Read").AsArray()),
+			},
+		}
+
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     oper.Summary,
 			Description: oper.Description,
@@ -200,7 +389,7 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		}
 	case OperationUpdate:
 		buildMutationOperation(
-			spec, op, t, ta, entityName, eagerLoadDepthMessage,
+			spec, op, t, ta, idParams, entityName, eagerLoadDepthMessage,
 			"Update",
 			"Update an existing %s entity. %s",
 			"Update",
@@ -209,7 +398,7 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		)
 	case OperationUpsert:
 		buildMutationOperation(
-			spec, op, t, ta, entityName, eagerLoadDepthMessage,
+			spec, op, t, ta, idParams, entityName, eagerLoadDepthMessage,
 			"Upsert",
 			"Create a new %s entity, or partially update an existing one if it already exists (unprovided optional fields are preserved). %s",
 			"Upsert",
@@ -218,7 +407,7 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		)
 	case OperationCreateOrReplace:
 		buildMutationOperation(
-			spec, op, t, ta, entityName, eagerLoadDepthMessage,
+			spec, op, t, ta, idParams, entityName, eagerLoadDepthMessage,
 			"Replace",
 			"Create a new %s entity, or fully replace an existing one if it already exists (unprovided optional fields are cleared). %s",
 			"Replace",
@@ -250,14 +439,17 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			oper.Tags = append(oper.Tags, edgesToTags(cfg, t)...)
 		}
 
+		if param := addExpandParameter(spec, t); param != nil {
+			oper.Parameters = append(oper.Parameters, param)
+		}
+
+		oper.Parameters = append(oper.Parameters, addFieldsParameters(spec, t)...)
+
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     fmt.Sprintf("Operate on a single %s entity", entityName),
 			Description: fmt.Sprintf("Operate on a single %s entity by its ID.", entityName),
 			Get:         oper,
-			Parameters: []*ogen.Parameter{
-				{Ref: "#/components/parameters/PrettyResponse"},
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
-			},
+			Parameters:  append([]*ogen.Parameter{{Ref: "#/components/parameters/PrettyResponse"}}, idParams...),
 		}
 	case OperationList:
 		oper := &ogen.Operation{
@@ -281,11 +473,12 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		}
 
 		if ta.GetPagination(cfg, nil) {
-			addPagination(spec, cfg)
+			mode := ta.GetPaginationMode(cfg, t.ID != nil)
+			addPagination(spec, cfg, mode)
 
 			oper.Parameters = append(
 				oper.Parameters,
-				&ogen.Parameter{Ref: "#/components/parameters/Page"},
+				paginationParameter(mode),
 				&ogen.Parameter{
 					Name:        "per_page",
 					In:          "query",
@@ -296,18 +489,16 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 						SetDefault(json.RawMessage(strconv.Itoa(ta.GetItemsPerPage(cfg)))),
 				},
 			)
+
+			if mode == PaginationOffset {
+				if param := countParameter(ta.GetPaginationCount(cfg)); param != nil {
+					oper.Parameters = append(oper.Parameters, param)
+				}
+			}
 		}
 
 		if sortable := GetSortableFields(t, nil); len(sortable) > 1 {
-			sortParam := &ogen.Parameter{
-				Name:        "sort",
-				In:          "query",
-				Description: "Sort entity results by the given field.",
-				Schema:      &ogen.Schema{Ref: "#/components/schemas/" + addSortableFields(spec, t, sortable)},
-			}
-			if v := ta.GetDefaultSort(t.ID != nil); v != "" {
-				sortParam.Schema = sortParam.Schema.SetDefault(json.RawMessage(fmt.Sprintf("%q", v)))
-			}
+			sortParam := sortParameter(spec, t, sortable, ta.GetDefaultSort(t.ID != nil))
 			orderParam := &ogen.Parameter{
 				Name:        "order",
 				In:          "query",
@@ -319,26 +510,58 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 				},
 			}
 			oper.Parameters = append(oper.Parameters, sortParam, orderParam)
+
+			if param := nullsParameter(t); param != nil {
+				oper.Parameters = append(oper.Parameters, param)
+			}
 		}
 
-		if filters := GetFilterableFields(t, nil); len(filters) > 0 {
-			oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/FilterOperation"})
+		oper.Parameters = append(oper.Parameters, addFilterParameters(spec, t)...)
 
-			for _, f := range filters {
-				name := f.ComponentName()
-				spec.Components.Parameters[name] = f.Parameter()
-				oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/" + name})
-			}
+		if cfg.AddEdgesToTags {
+			oper.Tags = append(oper.Tags, edgesToTags(cfg, t)...)
 		}
 
-		if groups := GetFilterGroups(t, nil); len(groups) > 0 {
-			for _, g := range groups {
-				for _, op := range g.Operations {
-					name := g.ComponentName(op)
-					spec.Components.Parameters[name] = g.Parameter(op)
-					oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/" + name})
-				}
-			}
+		if param := addExpandParameter(spec, t); param != nil {
+			oper.Parameters = append(oper.Parameters, param)
+		}
+
+		oper.Parameters = append(oper.Parameters, addFieldsParameters(spec, t)...)
+
+		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
+			Summary:     oper.Summary,
+			Description: oper.Description,
+			Get:         oper,
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/PrettyResponse"},
+			},
+		}
+	case OperationSearch:
+		oper := &ogen.Operation{
+			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
+			Summary: cmp.Or(
+				ta.GetOperationSummary(op),
+				"Search "+CamelCase(Pluralize(t.Name)),
+			),
+			Description: cmp.Or(
+				ta.GetOperationDescription(op),
+				fmt.Sprintf(
+					"Search %s entities, using the same pagination, filtering and sorting options as listing them, provided in the request body. Useful when the options are too long to fit in a URL. %s",
+					entityName,
+					eagerLoadDepthMessage,
+				),
+			),
+			OperationID: GetOperationIDName(op, t, nil),
+			Deprecated:  ta.Deprecated,
+			Parameters:  addFieldsParameters(spec, t),
+			RequestBody: ogen.NewRequestBody().
+				SetRequired(true).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + addSearchSchema(spec, cfg, t)}),
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The requested %s.", Pluralize(entityName))).
+					SetJSONContent(ogen.NewSchema().SetRef("#/components/schemas/" + entityName + "List")),
+			},
 		}
 
 		if cfg.AddEdgesToTags {
@@ -348,11 +571,213 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     oper.Summary,
 			Description: oper.Description,
-			Get:         oper,
+			Post:        oper,
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/PrettyResponse"},
+			},
+		}
+	case OperationCount:
+		summary := cmp.Or(ta.GetOperationSummary(op), "Count "+CamelCase(Pluralize(t.Name)))
+		description := cmp.Or(
+			ta.GetOperationDescription(op),
+			fmt.Sprintf("Count the %s entities which match the provided filters, without retrieving them.", entityName),
+		)
+		params := addFilterParameters(spec, t)
+
+		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
+			Summary:     summary,
+			Description: description,
+			Get: &ogen.Operation{
+				Tags:        sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
+				Summary:     summary,
+				Description: description,
+				OperationID: GetOperationIDName(op, t, nil),
+				Deprecated:  ta.Deprecated,
+				Parameters:  params,
+				Responses: ogen.Responses{
+					strconv.Itoa(http.StatusOK): ogen.NewResponse().
+						SetDescription(fmt.Sprintf("The number of matching %s entities.", entityName)).
+						SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + addCountResult(spec)}),
+				},
+			},
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/PrettyResponse"},
+			},
+		}
+
+		// The same count is also available using a HEAD request on the list endpoint. The
+		// path is shared with the list and create operations, so only the operation is set.
+		spec.Paths[GetPathName(OperationList, t, nil, true)] = &ogen.PathItem{
+			Head: &ogen.Operation{
+				Tags:        sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
+				Summary:     summary,
+				Description: description + " The count is returned in the X-Total-Count header.",
+				OperationID: GetOperationIDName(op, t, nil) + "Head",
+				Deprecated:  ta.Deprecated,
+				Parameters:  params,
+				Responses: ogen.Responses{
+					strconv.Itoa(http.StatusOK): &ogen.Response{
+						Description: fmt.Sprintf("The number of matching %s entities is returned in the headers.", entityName),
+						Headers: map[string]*ogen.Header{
+							"X-Total-Count": {
+								Description: fmt.Sprintf("The number of matching %s entities.", entityName),
+								Required:    true,
+								Schema:      ogen.Int(),
+							},
+						},
+					},
+				},
+			},
+		}
+	case OperationAggregate:
+		summary := cmp.Or(ta.GetOperationSummary(op), "Aggregate "+CamelCase(Pluralize(t.Name)))
+		description := cmp.Or(
+			ta.GetOperationDescription(op),
+			fmt.Sprintf(
+				"Aggregate the %s entities which match the provided filters, optionally grouped by one or more fields. Each row includes the number of entities in the group.",
+				entityName,
+			),
+		)
+
+		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
+			Summary:     summary,
+			Description: description,
+			Get: &ogen.Operation{
+				Tags:        sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
+				Summary:     summary,
+				Description: description,
+				OperationID: GetOperationIDName(op, t, nil),
+				Deprecated:  ta.Deprecated,
+				Parameters:  append(aggregateParameters(t), addFilterParameters(spec, t)...),
+				Responses: ogen.Responses{
+					strconv.Itoa(http.StatusOK): ogen.NewResponse().
+						SetDescription(fmt.Sprintf("The aggregated values of the matching %s entities.", entityName)).
+						SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + entityName + "AggregateList"}),
+				},
+			},
 			Parameters: []*ogen.Parameter{
 				{Ref: "#/components/parameters/PrettyResponse"},
 			},
 		}
+	case OperationDistinct:
+		params := addFilterParameters(spec, t)
+
+		addDistinctComponents(spec)
+
+		params = append(
+			[]*ogen.Parameter{
+				{Ref: "#/components/parameters/Page"},
+				{
+					Name:        "per_page",
+					In:          "query",
+					Description: "The number of values to retrieve per page.",
+					Schema: ogen.Int().
+						SetMinimum(ptr(int64(ta.GetMinItemsPerPage(cfg)))).
+						SetMaximum(ptr(int64(ta.GetMaxItemsPerPage(cfg)))).
+						SetDefault(json.RawMessage(strconv.Itoa(ta.GetItemsPerPage(cfg)))),
+				},
+			},
+			params...,
+		)
+
+		for _, f := range GetDistinctFields(t) {
+			fieldSchema, err := GetSchemaField(f)
+			if err != nil {
+				return nil, err
+			}
+			fieldSchema.Nullable = false
+			fieldSchema.Default = nil
+
+			summary := cmp.Or(ta.GetOperationSummary(op), fmt.Sprintf("List distinct %s %s values", CamelCase(Singularize(t.Name)), f.Name))
+			description := cmp.Or(
+				ta.GetOperationDescription(op),
+				fmt.Sprintf(
+					"List the distinct values of the %q field, for the %s entities which match the provided filters. Values are sorted in ascending order, NULL values are excluded, and values are always paged.",
+					f.Name,
+					entityName,
+				),
+			)
+
+			spec.Paths[GetDistinctPathName(t, f)] = &ogen.PathItem{
+				Summary:     summary,
+				Description: description,
+				Get: &ogen.Operation{
+					Tags:        sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
+					Summary:     summary,
+					Description: description,
+					OperationID: GetDistinctOperationIDName(t, f),
+					Deprecated:  ta.Deprecated,
+					Parameters:  params,
+					Responses: ogen.Responses{
+						strconv.Itoa(http.StatusOK): ogen.NewResponse().
+							SetDescription(fmt.Sprintf("The requested page of distinct values of the %q field.", f.Name)).
+							SetJSONContent(&ogen.Schema{
+								AllOf: []*ogen.Schema{
+									{Ref: "#/components/schemas/DistinctPagedResponse"},
+									{
+										Type: "object",
+										Properties: ogen.Properties{{
+											Name:   "content",
+											Schema: fieldSchema.AsArray().SetUniqueItems(true),
+										}},
+										Required: []string{"content"},
+									},
+								},
+							}),
+					},
+				},
+				Parameters: []*ogen.Parameter{
+					{Ref: "#/components/parameters/PrettyResponse"},
+				},
+			}
+		}
+	case OperationBulkDelete, OperationBulkUpdate:
+		params := addFilterParameters(spec, t)
+		if len(params) == 0 {
+			return nil, fmt.Errorf("type %q has no filters, which are required for operation %q", t.Name, op)
+		}
+
+		oper := &ogen.Operation{
+			Tags:        sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
+			OperationID: GetOperationIDName(op, t, nil),
+			Deprecated:  ta.Deprecated,
+			Parameters:  append(params, addBulkComponents(spec)),
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The number of %s entities which were affected.", entityName)).
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/BulkResult"}),
+			},
+		}
+
+		limits := fmt.Sprintf(
+			"At least one filter must be provided, and if more than %d entities match, the request is rejected without modifying any of them.",
+			ta.GetMaxBulkAffected(cfg),
+		)
+
+		// Only the operation is set on the path, as the path is shared with the list and
+		// create operations.
+		pathItem := &ogen.PathItem{}
+
+		if op == OperationBulkDelete {
+			oper.Summary = cmp.Or(ta.GetOperationSummary(op), "Delete multiple "+CamelCase(Pluralize(t.Name)))
+			oper.Description = cmp.Or(
+				ta.GetOperationDescription(op),
+				fmt.Sprintf("Delete all %s entities which match the provided filters, in a single transaction. %s", entityName, limits),
+			)
+			pathItem.Delete = oper
+		} else {
+			oper.Summary = cmp.Or(ta.GetOperationSummary(op), "Update multiple "+CamelCase(Pluralize(t.Name)))
+			oper.Description = cmp.Or(
+				ta.GetOperationDescription(op),
+				fmt.Sprintf("Update all %s entities which match the provided filters with the same values, in a single transaction. %s", entityName, limits),
+			)
+			oper.RequestBody = ogen.NewRequestBody().
+				SetRequired(true).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + entityName + "Update"})
+			pathItem.Patch = oper
+		}
+
+		spec.Paths[GetPathName(op, t, nil, true)] = pathItem
 	case OperationDelete:
 		oper := &ogen.Operation{
 			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
@@ -377,22 +802,235 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			Summary:     fmt.Sprintf("Operate on a single %s entity", entityName),
 			Description: fmt.Sprintf("Operate on a single %s entity by its ID.", entityName),
 			Delete:      oper,
-			Parameters: []*ogen.Parameter{
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
-			},
+			Parameters:  idParams,
 		}
 	default:
 		panic(fmt.Sprintf("unsupported operation %q", op))
 	}
 
-	return spec, nil
-}
+	if cfg.EnableETags {
+		addETagHeaders(spec, cfg, t, nil, op)
+	}
 
-// GetSpecEdge generates an independent spec for the given edge, which should encapsulate
-// all schemas, parameters, components and paths for the provided edge that can then be
-// merged into another spec.
-func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { // nolint:funlen,gocyclo,cyclop
-	cfg := GetConfig(t.Config)
+	if cfg.EnableConditionalGET {
+		addConditionalHeaders(spec, t, op)
+	}
+
+	if t.ID != nil && (op == OperationRead || op == OperationUpdate || op == OperationDelete) {
+		err = addLookupPaths(spec, t, op)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return spec, nil
+}
+
+// addETagHeaders documents the ETag response header of operations which return a single
+// entity, and the If-Match request header of operations which modify or delete an existing
+// entity (see [Config.EnableETags]). e is the edge for edge operations, where the If-Match
+// header of link/unlink operations is compared against the ETag of the entity the edge
+// belongs to.
+func addETagHeaders(spec *ogen.Spec, cfg *Config, t *gen.Type, e *gen.Edge, op Operation) {
+	pathItem, ok := spec.Paths[GetPathName(op, t, e, true)]
+	if !ok {
+		return
+	}
+
+	entityName := Singularize(t.Name)
+	if e != nil && (GetAnnotation(e.Type).IsSubentity || (op != OperationUpdate && op != OperationDelete)) {
+		entityName = Singularize(e.Type.Name)
+	}
+
+	PatchOperations(pathItem, func(_ string, o *ogen.Operation) *ogen.Operation {
+		if o == nil {
+			return nil
+		}
+
+		switch op {
+		case OperationUpdate, OperationDelete, OperationUpsert, OperationCreateOrReplace:
+			param := &ogen.Parameter{
+				Name: "If-Match",
+				In:   "header",
+				Description: fmt.Sprintf(
+					"Only perform the operation if the current ETag of the %s matches one of the provided (comma-separated) ETags, or \"*\". Otherwise, a 412 response is returned.",
+					entityName,
+				),
+				Schema: ogen.String(),
+			}
+
+			// Upsert and replace can also create the entity, in which case there is no
+			// ETag to match.
+			if cfg.RequireIfMatch && (op == OperationUpdate || op == OperationDelete) {
+				param.Required = true
+			}
+
+			o.Parameters = append(o.Parameters, param)
+		}
+
+		switch op {
+		case OperationRead, OperationCreate, OperationUpdate, OperationUpsert, OperationCreateOrReplace:
+			for code, resp := range o.Responses {
+				if resp.Ref != "" || !strings.HasPrefix(code, "2") || len(resp.Content) == 0 {
+					continue
+				}
+
+				if resp.Headers == nil {
+					resp.Headers = make(map[string]*ogen.Header)
+				}
+
+				resp.Headers["ETag"] = &ogen.Header{
+					Description: fmt.Sprintf("The current ETag of the %s, which can be provided in the If-Match header of subsequent requests.", entityName),
+					Schema:      ogen.String(),
+				}
+			}
+		}
+		return o
+	})
+}
+
+// hasIfMatchParameter returns true if the operation accepts the If-Match header (see
+// [Config.EnableETags]).
+func hasIfMatchParameter(op *ogen.Operation) bool {
+	return slices.ContainsFunc(op.Parameters, func(p *ogen.Parameter) bool {
+		return p.In == "header" && p.Name == "If-Match"
+	})
+}
+
+// addConditionalHeaders documents conditional GET requests (using the If-None-Match and
+// If-Modified-Since headers) on all read and list operations in the spec, and the 304
+// response returned when the client already has the current version of the response (see
+// [Config.EnableConditionalGET]). t is the type of the entity (or entities) being returned.
+func addConditionalHeaders(spec *ogen.Spec, t *gen.Type, op Operation) {
+	if op != OperationRead && op != OperationList {
+		return
+	}
+
+	var modified *gen.Field
+	if op == OperationRead {
+		modified = GetLastModifiedField(t)
+	}
+
+	etagHeader := &ogen.Header{
+		Description: "The ETag of the response, which can be provided in the If-None-Match header of subsequent requests.",
+		Schema:      ogen.String(),
+	}
+
+	modifiedHeader := &ogen.Header{
+		Description: "When the entity was last modified, which can be provided in the If-Modified-Since header of subsequent requests.",
+		Schema:      ogen.String(),
+	}
+
+	for _, pathItem := range spec.Paths {
+		if pathItem.Get == nil {
+			continue
+		}
+
+		o := pathItem.Get
+
+		o.Parameters = append(o.Parameters, &ogen.Parameter{
+			Name:        "If-None-Match",
+			In:          "header",
+			Description: "Only return the response if its current ETag doesn't match one of the provided (comma-separated) ETags. Otherwise, a 304 response is returned.",
+			Schema:      ogen.String(),
+		})
+
+		if modified != nil {
+			o.Parameters = append(o.Parameters, &ogen.Parameter{
+				Name:        "If-Modified-Since",
+				In:          "header",
+				Description: "Only return the response if the entity was modified after the provided time. Otherwise, a 304 response is returned. Ignored if If-None-Match is provided.",
+				Schema:      ogen.String(),
+			})
+		}
+
+		notModified := ogen.NewResponse().SetDescription("The response hasn't changed since it was last retrieved.")
+		notModified.Headers = map[string]*ogen.Header{"ETag": etagHeader}
+
+		if resp, ok := o.Responses[strconv.Itoa(http.StatusOK)]; ok && resp.Ref == "" {
+			if resp.Headers == nil {
+				resp.Headers = make(map[string]*ogen.Header)
+			}
+
+			if _, ok := resp.Headers["ETag"]; !ok {
+				resp.Headers["ETag"] = etagHeader
+			}
+
+			if modified != nil {
+				resp.Headers["Last-Modified"] = modifiedHeader
+				notModified.Headers["Last-Modified"] = modifiedHeader
+			}
+		}
+
+		o.Responses[strconv.Itoa(http.StatusNotModified)] = notModified
+	}
+}
+
+// addLookupPaths adds a copy of the provided single entity operation (which must already
+// be in the spec), for each lookup key of the type (see [WithLookupKey]). These are
+// identical to the original operation, however the entity is looked up using the field,
+// rather than the ID.
+func addLookupPaths(spec *ogen.Spec, t *gen.Type, op Operation) error {
+	pathItem, ok := spec.Paths[GetPathName(op, t, nil, true)]
+	if !ok {
+		return nil
+	}
+
+	idRef := "#/components/parameters/" + Singularize(t.Name) + "ID"
+	entityName := Singularize(t.Name)
+
+	for _, f := range GetLookupFields(t) {
+		fieldSchema, err := GetSchemaField(f)
+		if err != nil {
+			return err
+		}
+		fieldSchema.Nullable = false
+		fieldSchema.Default = nil
+
+		param := &ogen.Parameter{
+			Name:        CamelCase(f.Name),
+			In:          "path",
+			Description: fmt.Sprintf("The %q of the %s to act upon.", f.Name, entityName),
+			Required:    true,
+			Schema:      fieldSchema,
+		}
+
+		lookup := &ogen.PathItem{
+			Summary:     pathItem.Summary,
+			Description: fmt.Sprintf("Operate on a single %s entity by its %q.", entityName, f.Name),
+		}
+
+		for _, p := range pathItem.Parameters {
+			if p.Ref == idRef {
+				p = param
+			}
+			lookup.Parameters = append(lookup.Parameters, p)
+		}
+
+		lookup.Get = pathItem.Get
+		lookup.Patch = pathItem.Patch
+		lookup.Delete = pathItem.Delete
+
+		PatchOperations(lookup, func(_ string, o *ogen.Operation) *ogen.Operation {
+			if o == nil {
+				return nil
+			}
+			lo := *o
+			lo.OperationID = GetLookupOperationIDName(op, t, f)
+			lo.Description = strings.Replace(lo.Description, "by its ID", fmt.Sprintf("by its %q", f.Name), 1)
+			return &lo
+		})
+
+		spec.Paths[GetLookupPathName(t, f)] = lookup
+	}
+	return nil
+}
+
+// GetSpecEdge generates an independent spec for the given edge, which should encapsulate
+// all schemas, parameters, components and paths for the provided edge that can then be
+// merged into another spec.
+func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { // nolint:funlen,gocyclo,cyclop
+	cfg := GetConfig(t.Config)
 	ta := GetAnnotation(t)
 	ea := GetAnnotation(e)
 	ra := GetAnnotation(e.Type)
@@ -435,7 +1073,28 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 		Schema:      idSchema,
 	}
 
-	maps.Copy(spec.Components.Schemas, GetSchemaType(t, op, e))
+	if !e.Unique && ra.IsSubentity && (op == OperationRead || op == OperationUpdate || op == OperationDelete) {
+		// Subentities don't have top-level endpoints, so non-unique edges to them get
+		// nested operations instead of link/unlink.
+		spec, err = specNestedEdge(spec, t, e, op)
+		if err == nil && cfg.EnableETags {
+			addETagHeaders(spec, cfg, t, e, op)
+		}
+		if err == nil && cfg.EnableConditionalGET {
+			addConditionalHeaders(spec, e.Type, op)
+		}
+		return spec, err
+	}
+
+	if op == OperationRead || op == OperationList || op == OperationCreate {
+		maps.Copy(spec.Components.Schemas, GetSchemaType(t, op, e))
+
+		if ra.IsSubentity && op != OperationCreate {
+			// Subentities don't have a spec of their own, so the schemas for the edge type
+			// are only provided through their parent.
+			maps.Copy(spec.Components.Schemas, GetSchemaType(e.Type, op, nil))
+		}
+	}
 
 	switch op {
 	case OperationRead: // Unique.
@@ -470,6 +1129,12 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 			},
 		}
 
+		if param := addExpandParameter(spec, e.Type); param != nil {
+			oper.Parameters = append(oper.Parameters, param)
+		}
+
+		oper.Parameters = append(oper.Parameters, addFieldsParameters(spec, e.Type)...)
+
 		spec.Paths[GetPathName(op, t, e, true)] = &ogen.PathItem{
 			Summary:     oper.Summary,     // Will probably always be the same.
 			Description: oper.Description, // Will probably always be the same.
@@ -484,155 +1149,867 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 			return nil, errors.New("edge is unique")
 		}
 
-		oper := &ogen.Operation{
-			Tags: sliceCompact(sliceOr(ea.Tags, append([]string{Pluralize(t.Name), Pluralize(e.Type.Name)}, ea.AdditionalTags...))),
-			Summary: cmp.Or(
-				ea.GetOperationSummary(op),
-				e.Comment(),
-				fmt.Sprintf("List a %s associated %s", Pluralize(CamelCase(t.Name)), Pluralize(CamelCase(e.Name))),
-			),
-			Description: cmp.Or(
-				ea.GetOperationDescription(op),
-				fmt.Sprintf(
-					"List a %s associated %s (%s entity type). %s",
-					Pluralize(CamelCase(t.Name)),
-					Pluralize(CamelCase(e.Name)),
-					refEntityName,
-					eagerLoadDepthMessage,
-				),
-			),
-			OperationID: GetOperationIDName(op, t, e),
-			Deprecated:  ta.Deprecated || ea.Deprecated || ra.Deprecated,
-			Parameters:  []*ogen.Parameter{},
-			Responses: ogen.Responses{
-				strconv.Itoa(http.StatusOK): ogen.NewResponse().
-					SetDescription(fmt.Sprintf("The requested %s.", Pluralize(CamelCase(e.Name)))).
-					SetJSONContent(ogen.NewSchema().SetRef("#/components/schemas/" + refEntityName + "List")),
-			},
+		oper := &ogen.Operation{
+			Tags: sliceCompact(sliceOr(ea.Tags, append([]string{Pluralize(t.Name), Pluralize(e.Type.Name)}, ea.AdditionalTags...))),
+			Summary: cmp.Or(
+				ea.GetOperationSummary(op),
+				e.Comment(),
+				fmt.Sprintf("List a %s associated %s", Pluralize(CamelCase(t.Name)), Pluralize(CamelCase(e.Name))),
+			),
+			Description: cmp.Or(
+				ea.GetOperationDescription(op),
+				fmt.Sprintf(
+					"List a %s associated %s (%s entity type). %s",
+					Pluralize(CamelCase(t.Name)),
+					Pluralize(CamelCase(e.Name)),
+					refEntityName,
+					eagerLoadDepthMessage,
+				),
+			),
+			OperationID: GetOperationIDName(op, t, e),
+			Deprecated:  ta.Deprecated || ea.Deprecated || ra.Deprecated,
+			Parameters:  []*ogen.Parameter{},
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The requested %s.", Pluralize(CamelCase(e.Name)))).
+					SetJSONContent(ogen.NewSchema().SetRef("#/components/schemas/" + refEntityName + "List")),
+			},
+		}
+
+		code := strconv.Itoa(http.StatusOK)
+
+		if ea.GetPagination(cfg, e) || ra.GetPagination(cfg, e) {
+			// The edge endpoint uses the list handling of the edge type, so if the edge type
+			// isn't paginated, offset pagination is used.
+			mode := PaginationOffset
+			if ra.GetPagination(cfg, e) {
+				mode = ra.GetPaginationMode(cfg, e.Type.ID != nil)
+			}
+
+			addPagination(spec, cfg, mode)
+			oper.Parameters = append(oper.Parameters,
+				paginationParameter(mode),
+				&ogen.Parameter{
+					Name:        "per_page",
+					In:          "query",
+					Description: "The number of entities to retrieve per page.",
+					Schema: ogen.Int().
+						SetMinimum(ptr(int64(ta.GetMinItemsPerPage(cfg)))).
+						SetMaximum(ptr(int64(ta.GetMaxItemsPerPage(cfg)))).
+						SetDefault(json.RawMessage(strconv.Itoa(ta.GetItemsPerPage(cfg)))),
+				},
+			)
+
+			if mode == PaginationOffset {
+				if param := countParameter(ra.GetPaginationCount(cfg)); param != nil {
+					oper.Parameters = append(oper.Parameters, param)
+				}
+			}
+
+			// If edge pagination is enabled, but edge type is not paginated, we cannot re-use
+			// the paginated schema from the edge type.
+			if !ra.GetPagination(cfg, e) {
+				oper.Responses[code] = oper.Responses[code].SetJSONContent(&ogen.Schema{
+					Ref: "#/components/schemas/" + rootEntityName + entityName + "List",
+				})
+			}
+		} else if !cfg.DisableEagerLoadNonPagedOpt {
+			// We're setting a specific schema for the edge response because the edge isn't
+			// paginated, but the underlying edge type schema is.
+			oper.Responses[code] = oper.Responses[code].SetJSONContent(&ogen.Schema{
+				Ref: "#/components/schemas/" + rootEntityName + entityName + "List",
+			})
+		}
+
+		if sortable := GetSortableFields(e.Type, nil); len(sortable) > 1 {
+			sortParam := sortParameter(spec, e.Type, sortable, ra.GetDefaultSort(t.ID != nil && (e == nil || e.Field() == nil)))
+			orderParam := &ogen.Parameter{
+				Name:        "order",
+				In:          "query",
+				Description: "Order the results in ascending or descending order.",
+				Schema: &ogen.Schema{
+					Type:    "string",
+					Enum:    sliceToRawMessage([]string{"asc", "desc"}),
+					Default: ogen.Default(json.RawMessage(fmt.Sprintf("%q", ra.GetDefaultOrder()))),
+				},
+			}
+			oper.Parameters = append(oper.Parameters, sortParam, orderParam)
+
+			if param := nullsParameter(e.Type); param != nil {
+				oper.Parameters = append(oper.Parameters, param)
+			}
+		}
+
+		oper.Parameters = append(oper.Parameters, addFilterParameters(spec, e.Type)...)
+
+		if cfg.AddEdgesToTags {
+			oper.Tags = append(oper.Tags, edgesToTags(cfg, e.Type)...)
+		}
+
+		if param := addExpandParameter(spec, e.Type); param != nil {
+			oper.Parameters = append(oper.Parameters, param)
+		}
+
+		oper.Parameters = append(oper.Parameters, addFieldsParameters(spec, e.Type)...)
+
+		spec.Paths[GetPathName(op, t, e, true)] = &ogen.PathItem{
+			Summary:     oper.Summary,
+			Description: oper.Description,
+			Get:         oper,
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/PrettyResponse"},
+				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
+			},
+		}
+	case OperationCreate: // Create through the edge.
+		if !EdgeHasCreateOperation(e, t, cfg) {
+			return nil, errors.New("edge does not support the create operation")
+		}
+
+		oper := &ogen.Operation{
+			Tags: sliceCompact(sliceOr(ea.Tags, append([]string{Pluralize(t.Name), Pluralize(e.Type.Name)}, ea.AdditionalTags...))),
+			Summary: cmp.Or(
+				ea.GetOperationSummary(op),
+				fmt.Sprintf("Create a %s for a %s", CamelCase(Singularize(e.Name)), CamelCase(t.Name)),
+			),
+			Description: cmp.Or(
+				ea.GetOperationDescription(op),
+				fmt.Sprintf(
+					"Create a new %s entity, which is attached to the %s of a %s. %s",
+					refEntityName,
+					Pluralize(CamelCase(e.Name)),
+					CamelCase(t.Name),
+					eagerLoadDepthMessage,
+				),
+			),
+			OperationID: GetOperationIDName(op, t, e),
+			Deprecated:  ta.Deprecated || ea.Deprecated || ra.Deprecated,
+			RequestBody: ogen.NewRequestBody().
+				SetRequired(true).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + rootEntityName + entityName + "Create"}),
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusCreated): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The created %s entity.", refEntityName)).
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + refEntityName + "Read"}),
+			},
+		}
+
+		spec.Paths[GetPathName(op, t, e, true)] = &ogen.PathItem{
+			Post: oper,
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
+				{Ref: "#/components/parameters/PrettyResponse"},
+			},
+		}
+	case OperationUpdate, OperationDelete: // Link/unlink, not unique.
+		if !EdgeHasLinkOperations(e, t, cfg) {
+			return nil, errors.New("edge does not support link/unlink operations")
+		}
+
+		refIDSchema, err := GetSchemaField(e.Type.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		summary := fmt.Sprintf("Link a %s to a %s", CamelCase(Singularize(e.Name)), CamelCase(t.Name))
+		description := fmt.Sprintf(
+			"Add a %s (%s entity type) to the %s of a %s. Linking an entity which is already linked has no effect.",
+			CamelCase(Singularize(e.Name)),
+			refEntityName,
+			Pluralize(CamelCase(e.Name)),
+			CamelCase(t.Name),
+		)
+		response := fmt.Sprintf("The %s was successfully linked to the %s.", refEntityName, rootEntityName)
+
+		if op == OperationDelete {
+			summary = fmt.Sprintf("Unlink a %s from a %s", CamelCase(Singularize(e.Name)), CamelCase(t.Name))
+			description = fmt.Sprintf(
+				"Remove a %s (%s entity type) from the %s of a %s, without deleting it. Unlinking an entity which isn't linked has no effect.",
+				CamelCase(Singularize(e.Name)),
+				refEntityName,
+				Pluralize(CamelCase(e.Name)),
+				CamelCase(t.Name),
+			)
+			response = fmt.Sprintf("The %s was successfully unlinked from the %s.", refEntityName, rootEntityName)
+		}
+
+		oper := &ogen.Operation{
+			Tags:        sliceCompact(sliceOr(ea.Tags, append([]string{Pluralize(t.Name), Pluralize(e.Type.Name)}, ea.AdditionalTags...))),
+			Summary:     cmp.Or(ea.GetOperationSummary(op), summary),
+			Description: cmp.Or(ea.GetOperationDescription(op), description),
+			OperationID: GetOperationIDName(op, t, e),
+			Deprecated:  ta.Deprecated || ea.Deprecated || ra.Deprecated,
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusNoContent): ogen.NewResponse().SetDescription(response),
+			},
+		}
+
+		item := &ogen.PathItem{
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
+				{
+					Name:        CamelCase(Singularize(e.Name)) + "ID",
+					In:          "path",
+					Description: fmt.Sprintf("The ID of the %s to link or unlink.", refEntityName),
+					Required:    true,
+					Schema:      refIDSchema,
+				},
+			},
+		}
+
+		if op == OperationUpdate {
+			item.Put = oper
+		} else {
+			item.Delete = oper
+		}
+
+		spec.Paths[GetPathName(op, t, e, true)] = item
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+
+	if cfg.EnableETags {
+		addETagHeaders(spec, cfg, t, e, op)
+	}
+
+	if cfg.EnableConditionalGET {
+		addConditionalHeaders(spec, e.Type, op)
+	}
+
+	return spec, nil
+}
+
+// edgesToTags allows providing additional tags for a given operation based on the
+// eager-loaded schemas in the response schema.
+func edgesToTags(cfg *Config, t *gen.Type) (tags []string) {
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+		if !ea.GetSkip(cfg) && ea.GetEagerLoad(cfg) {
+			tags = append(tags, Pluralize(e.Type.Name))
+		}
+	}
+	return tags
+}
+
+// specNestedEdge adds the nested read, update, or delete operation for a subentity, which
+// is accessed through a non-unique edge of its parent (e.g. "/users/{userID}/settings/{settingID}").
+func specNestedEdge(spec *ogen.Spec, t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	ea := GetAnnotation(e)
+	ra := GetAnnotation(e.Type)
+
+	if !EdgeHasNestedOperation(e, t, cfg, op) {
+		return nil, fmt.Errorf("edge does not support the nested %q operation", op)
+	}
+
+	refEntityName := Singularize(e.Type.Name)
+	edgeName := CamelCase(Singularize(e.Name))
+
+	refIDSchema, err := GetSchemaField(e.Type.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != OperationDelete {
+		maps.Copy(spec.Components.Schemas, GetSchemaType(e.Type, op, nil))
+	}
+
+	oper := &ogen.Operation{
+		Tags:        sliceCompact(sliceOr(ea.Tags, append([]string{Pluralize(t.Name), Pluralize(e.Type.Name)}, ea.AdditionalTags...))),
+		OperationID: GetOperationIDName(op, t, e),
+		Deprecated:  ta.Deprecated || ea.Deprecated || ra.Deprecated,
+		Parameters:  []*ogen.Parameter{},
+	}
+
+	item := &ogen.PathItem{
+		Parameters: []*ogen.Parameter{
+			{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
+			{
+				Name:        edgeName + "ID",
+				In:          "path",
+				Description: fmt.Sprintf("The ID of the %s to act upon.", refEntityName),
+				Required:    true,
+				Schema:      refIDSchema,
+			},
+		},
+	}
+
+	switch op {
+	case OperationRead:
+		oper.Summary = cmp.Or(ea.GetOperationSummary(op), fmt.Sprintf("Get a %s of a %s", edgeName, CamelCase(t.Name)))
+		oper.Description = cmp.Or(
+			ea.GetOperationDescription(op),
+			fmt.Sprintf(
+				"Get a single %s (%s entity type) of a %s by its ID. %s",
+				edgeName,
+				refEntityName,
+				CamelCase(t.Name),
+				eagerLoadDepthMessage,
+			),
+		)
+		oper.Responses = ogen.Responses{
+			strconv.Itoa(http.StatusOK): ogen.NewResponse().
+				SetDescription(fmt.Sprintf("The requested %s entity.", refEntityName)).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + refEntityName + "Read"}),
+		}
+
+		if param := addExpandParameter(spec, e.Type); param != nil {
+			oper.Parameters = append(oper.Parameters, param)
+		}
+
+		oper.Parameters = append(oper.Parameters, addFieldsParameters(spec, e.Type)...)
+		item.Get = oper
+	case OperationUpdate:
+		oper.Summary = cmp.Or(ea.GetOperationSummary(op), fmt.Sprintf("Update a %s of a %s", edgeName, CamelCase(t.Name)))
+		oper.Description = cmp.Or(
+			ea.GetOperationDescription(op),
+			fmt.Sprintf(
+				"Update an existing %s (%s entity type) of a %s. %s",
+				edgeName,
+				refEntityName,
+				CamelCase(t.Name),
+				eagerLoadDepthMessage,
+			),
+		)
+		oper.RequestBody = ogen.NewRequestBody().
+			SetRequired(true).
+			SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + refEntityName + "Update"})
+		addPatchContent(spec, oper.RequestBody)
+		oper.Responses = ogen.Responses{
+			strconv.Itoa(http.StatusOK): ogen.NewResponse().
+				SetDescription(fmt.Sprintf("The updated %s entity.", refEntityName)).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + refEntityName + "Read"}),
+		}
+		item.Patch = oper
+	case OperationDelete:
+		oper.Summary = cmp.Or(ea.GetOperationSummary(op), fmt.Sprintf("Delete a %s of a %s", edgeName, CamelCase(t.Name)))
+		oper.Description = cmp.Or(
+			ea.GetOperationDescription(op),
+			fmt.Sprintf("Delete a single %s (%s entity type) of a %s by its ID.", edgeName, refEntityName, CamelCase(t.Name)),
+		)
+		oper.Responses = ogen.Responses{
+			strconv.Itoa(http.StatusNoContent): ogen.NewResponse().
+				SetDescription(fmt.Sprintf("The %s entity was deleted.", refEntityName)),
+		}
+		item.Delete = oper
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+
+	spec.Paths[GetPathName(op, t, e, true)] = item
+	return spec, nil
+}
+
+// sortParameter returns the parameter used to sort the results of the provided type by
+// one or more fields.
+func sortParameter(spec *ogen.Spec, t *gen.Type, sortable []string, defaultSort string) *ogen.Parameter {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	param := &ogen.Parameter{
+		Name:        "sort",
+		In:          "query",
+		Description: "Sort entity results by the given fields, in order of priority. Fields can be prefixed with \"-\" to sort in descending order, otherwise the \"order\" parameter is used.",
+		Style:       "form",
+		Explode:     ptr(false),
+		Schema:      (&ogen.Schema{Ref: "#/components/schemas/" + addSortableFields(spec, t, sortable)}).AsArray().SetUniqueItems(true),
+	}
+
+	if ta.GetPagination(cfg, nil) && ta.GetPaginationMode(cfg, t.ID != nil) == PaginationCursor {
+		param.Description += " Cursors are keyed on all sort fields, so the same sort fields and order must be provided when using a cursor."
+	}
+
+	if t.ID != nil {
+		param.Description += " The ID is always used as the final tie-breaker."
+	}
+
+	if defaultSort != "" {
+		param.Schema = param.Schema.SetDefault(json.RawMessage(fmt.Sprintf("[%q]", defaultSort)))
+	}
+	return param
+}
+
+// nullsParameter returns the parameter used to place NULL values first or last when
+// sorting by fields which can be NULL. Returns nil if the type has no such fields.
+func nullsParameter(t *gen.Type) *ogen.Parameter {
+	fields := GetNullableSortFields(t)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(fields))
+	var defaults []string
+	for _, f := range fields {
+		names = append(names, f.Name)
+		if nulls := GetAnnotation(f).SortNulls; nulls != "" {
+			defaults = append(defaults, fmt.Sprintf("%s (%s)", f.Name, nulls))
+		}
+	}
+
+	desc := fmt.Sprintf(
+		"Place NULL values first or last, when sorting by fields which can be NULL (%s). If not provided, the database default is used",
+		strings.Join(names, ", "),
+	)
+	if len(defaults) > 0 {
+		desc += ", except for fields with a configured default: " + strings.Join(defaults, ", ")
+	}
+
+	return &ogen.Parameter{
+		Name:        "nulls",
+		In:          "query",
+		Description: desc + ".",
+		Schema: &ogen.Schema{
+			Type: "string",
+			Enum: sliceToRawMessage([]string{string(NullsFirst), string(NullsLast)}),
+		},
+	}
+}
+
+// addSortableFields adds a schema entry for the provided type into the spec, returning
+// the name of the schema entry. Each field (other than "random") is also included with
+// a "-" prefix, for sorting in descending order.
+func addSortableFields(spec *ogen.Spec, t *gen.Type, fields []string) (ref string) {
+	ref = Singularize(t.Name) + "SortableFields"
+
+	enum := make([]string, 0, len(fields)*2)
+	for _, f := range fields {
+		enum = append(enum, f)
+		if f != "random" {
+			enum = append(enum, "-"+f)
+		}
+	}
+
+	s := &ogen.Schema{
+		Description: "All potential sortable fields for " + Singularize(t.Name) + " entities. Fields prefixed with \"-\" are sorted in descending order.",
+		Type:        "string",
+		Enum:        sliceToRawMessage(enum),
+	}
+	if t.ID != nil {
+		s.Default = jsonschema.RawValue(`"id"`)
+	}
+	spec.Components.Schemas[ref] = s
+	return ref
+}
+
+// addExpandParameter adds a parameter entry for the expandable edges of the provided
+// type into the spec, returning a reference to it. Returns nil if the type has no
+// expandable edges.
+func addExpandParameter(spec *ogen.Spec, t *gen.Type) *ogen.Parameter {
+	paths := GetExpandablePaths(t)
+	if len(paths) == 0 {
+		return nil
+	}
+
+	ref := Singularize(t.Name) + "Expand"
+
+	spec.Components.Parameters[ref] = &ogen.Parameter{
+		Name:        "expand",
+		In:          "query",
+		Description: "Comma-separated list of edges to eager-load on the " + Singularize(t.Name) + " entities returned, on top of the edges which are always eager-loaded. Nested edges can be requested using dot-notation (e.g. \"edge.nested_edge\").",
+		Style:       "form",
+		Explode:     ptr(false),
+		Schema:      ogen.String().SetEnum(sliceToRawMessage(paths)).AsArray().SetUniqueItems(true),
+	}
+	return &ogen.Parameter{Ref: "#/components/parameters/" + ref}
+}
+
+// aggregateParameters returns the parameters used to select which fields to group by, and
+// which fields to aggregate, for the aggregate operation of the provided type.
+func aggregateParameters(t *gen.Type) (params []*ogen.Parameter) {
+	param := func(name, description string, fields []*gen.Field) *ogen.Parameter {
+		names := make([]json.RawMessage, 0, len(fields))
+		for _, f := range fields {
+			names = append(names, json.RawMessage(strconv.Quote(f.Name)))
+		}
+
+		return &ogen.Parameter{
+			Name:        name,
+			In:          "query",
+			Description: description,
+			Style:       "form",
+			Explode:     ptr(false),
+			Schema:      (&ogen.Schema{Type: "string", Enum: names}).AsArray().SetUniqueItems(true),
+		}
+	}
+
+	if fields := GetAggregateFields(t, AggregateGroupBy); len(fields) > 0 {
+		params = append(params, param(
+			"group_by",
+			"Group the results by the given fields. If not provided, a single row is returned for all matching entities.",
+			fields,
+		))
+	}
+
+	for _, fn := range AggregateFuncs {
+		if fields := GetAggregateFields(t, fn); len(fields) > 0 {
+			params = append(params, param(
+				string(fn),
+				fmt.Sprintf("Calculate the %s of the given fields, returned as \"<field>_%s\" in each row.", aggregateDescription(fn), fn),
+				fields,
+			))
+		}
+	}
+	return params
+}
+
+// addCountResult adds the schema entry for the response of count operations into the
+// spec, returning the name of the schema.
+func addCountResult(spec *ogen.Spec) (ref string) {
+	ref = "CountResult"
+	if _, ok := spec.Components.Schemas[ref]; !ok {
+		spec.Components.Schemas[ref] = &ogen.Schema{
+			Type:        "object",
+			Description: "The number of entities which match the provided filters.",
+			Properties: ogen.Properties{
+				{Name: "count", Schema: &ogen.Schema{
+					Type:        "integer",
+					Description: "The number of matching entities.",
+				}},
+			},
+			Required: []string{"count"},
+		}
+	}
+	return ref
+}
+
+// addPatchContent adds the JSON Merge Patch (RFC 7396) and JSON Patch (RFC 6902) media
+// types to the request body of an update operation of a single entity, which must already
+// have JSON content. Both are applied to the current state of the entity, in the format of
+// the update schema, so merge patches use the same schema as the JSON content.
+func addPatchContent(spec *ogen.Spec, body *ogen.RequestBody) {
+	if _, ok := spec.Components.Schemas["JSONPatch"]; !ok {
+		spec.Components.Schemas["JSONPatch"] = &ogen.Schema{
+			Type: "array",
+			Description: "A JSON Patch (RFC 6902) document, which is applied to the current state of the entity, " +
+				"in the format of the update schema. Sensitive fields aren't part of the current state. Non-unique " +
+				"edges are arrays of the IDs of the linked entities, ordered by ID, and entities are linked or " +
+				"unlinked by adding their ID to, or removing it from, the array. If a \"test\" operation fails, " +
+				"the entity isn't modified, and a 409 status code is returned.",
+			Items: &ogen.Items{Item: &ogen.Schema{
+				Type: "object",
+				Properties: ogen.Properties{
+					{Name: "op", Schema: &ogen.Schema{
+						Type:        "string",
+						Description: "The operation to perform.",
+						Enum:        sliceToRawMessage([]string{"add", "remove", "replace", "move", "copy", "test"}),
+					}},
+					{Name: "path", Schema: &ogen.Schema{
+						Type:        "string",
+						Description: "A JSON Pointer to the location in the document to operate on.",
+					}},
+					{Name: "from", Schema: &ogen.Schema{
+						Type:        "string",
+						Description: "A JSON Pointer to the location to move or copy the value from, for the \"move\" and \"copy\" operations.",
+					}},
+					{Name: "value", Schema: &ogen.Schema{
+						Description: "The value to use, for the \"add\", \"replace\" and \"test\" operations.",
+					}},
+				},
+				Required: []string{"op", "path"},
+			}},
+		}
+	}
+
+	media := body.Content["application/json"]
+	body.Content["application/merge-patch+json"] = media
+	body.Content["application/json-patch+json"] = ogen.Media{
+		Schema: &ogen.Schema{Ref: "#/components/schemas/JSONPatch"},
+	}
+}
+
+// addBulkComponents adds the schema entry for the response of bulk delete and bulk
+// update operations, and the "dry_run" parameter, into the spec, returning a reference
+// to the parameter.
+func addBulkComponents(spec *ogen.Spec) *ogen.Parameter {
+	if _, ok := spec.Components.Schemas["BulkResult"]; !ok {
+		spec.Components.Schemas["BulkResult"] = &ogen.Schema{
+			Type:        "object",
+			Description: "The result of a bulk operation.",
+			Properties: ogen.Properties{
+				{Name: "affected", Schema: &ogen.Schema{
+					Type:        "integer",
+					Description: "The number of entities which were affected, or would be affected when using a dry run.",
+				}},
+				{Name: "dry_run", Schema: &ogen.Schema{
+					Type:        "boolean",
+					Description: "If true, no entities were modified.",
+				}},
+			},
+			Required: []string{"affected", "dry_run"},
+		}
+	}
+
+	if _, ok := spec.Components.Parameters["DryRun"]; !ok {
+		spec.Components.Parameters["DryRun"] = &ogen.Parameter{
+			Name:        "dry_run",
+			In:          "query",
+			Description: "If set to true, only the number of entities which match the provided filters is returned, without modifying them, even if it exceeds the maximum number of affected entities.",
+			Schema:      ogen.Bool(),
+		}
+	}
+	return &ogen.Parameter{Ref: "#/components/parameters/DryRun"}
+}
+
+// addFilterParameters adds parameter entries for all filters of the provided type (the
+// filter operation, individual filters, filter groups and the filter expression) into
+// the spec, returning references to them.
+func addFilterParameters(spec *ogen.Spec, t *gen.Type) (params []*ogen.Parameter) {
+	if filters := GetFilterableFields(t, nil); len(filters) > 0 {
+		params = append(params, &ogen.Parameter{Ref: "#/components/parameters/FilterOperation"})
+
+		for _, f := range filters {
+			name := f.ComponentName()
+			spec.Components.Parameters[name] = f.Parameter()
+			params = append(params, &ogen.Parameter{Ref: "#/components/parameters/" + name})
+		}
+	}
+
+	for _, g := range GetFilterGroups(t, nil) {
+		for _, op := range g.Operations {
+			name := g.ComponentName(op)
+			spec.Components.Parameters[name] = g.Parameter(op)
+			params = append(params, &ogen.Parameter{Ref: "#/components/parameters/" + name})
+		}
+	}
+
+	if param := addFilterExpressionParameter(spec, t); param != nil {
+		params = append(params, param)
+	}
+	return params
+}
+
+// addFilterExpressionParameter adds a parameter entry (and a schema entry describing
+// the expression tree) for the "filter" parameter of the provided type into the spec,
+// returning a reference to it. The expression uses the same filters as the individual
+// filter parameters. Returns nil if the type has no filters.
+func addFilterExpressionParameter(spec *ogen.Spec, t *gen.Type) *ogen.Parameter {
+	filters := GetFilterableFields(t, nil)
+	groups := GetFilterGroups(t, nil)
+	if len(filters) == 0 && len(groups) == 0 {
+		return nil
+	}
+
+	ref := Singularize(t.Name) + "FilterExpression"
+	self := &ogen.Schema{Ref: "#/components/schemas/" + ref}
+
+	props := ogen.Properties{
+		{Name: "and", Schema: &ogen.Schema{
+			Description: "Matches entities which match all of the provided expressions.",
+			Type:        "array",
+			Items:       &ogen.Items{Item: self},
+			MinItems:    ptr(uint64(1)),
+		}},
+		{Name: "or", Schema: &ogen.Schema{
+			Description: "Matches entities which match any of the provided expressions.",
+			Type:        "array",
+			Items:       &ogen.Items{Item: self},
+			MinItems:    ptr(uint64(1)),
+		}},
+		{Name: "not", Schema: &ogen.Schema{
+			Description: "Matches entities which don't match the provided expression.",
+			AllOf:       []*ogen.Schema{self},
+		}},
+	}
+
+	for _, f := range filters {
+		props = append(props, ogen.Property{Name: f.ParameterName(), Schema: parameterSchema(f.Parameter())})
+	}
+
+	for _, g := range groups {
+		for _, op := range g.Operations {
+			props = append(props, ogen.Property{Name: g.ParameterName(op), Schema: filterGroupSchema(g, op)})
+		}
+	}
+
+	spec.Components.Schemas[ref] = &ogen.Schema{
+		Description: "Filter expression for " + Singularize(t.Name) + " entities. Keys within the same object are combined with AND.",
+		Type:        "object",
+		Properties:  props,
+		AdditionalProperties: &ogen.AdditionalProperties{
+			Bool: ptr(false),
+		},
+		MinProperties: ptr(uint64(1)),
+	}
+
+	spec.Components.Parameters[ref] = &ogen.Parameter{
+		Name: "filter",
+		In:   "query",
+		Description: "JSON-encoded filter expression, which allows grouping filters. Filters use the same " +
+			"names as the individual filter parameters (e.g. {\"name.eq\": \"foo\"}), and multiple filters " +
+			"in the same object are combined with AND. Expressions can be grouped using \"and\" and " +
+			"\"or\" (arrays of expressions), and negated using \"not\", e.g. " +
+			"{\"or\": [{\"and\": [{...}, {...}]}, {\"not\": {...}}]}. The expression is combined with all " +
+			"other filter parameters using AND.",
+		Content: map[string]ogen.Media{
+			"application/json": {Schema: self},
+		},
+	}
+	return &ogen.Parameter{Ref: "#/components/parameters/" + ref}
+}
+
+// filterGroupSchema returns the schema of the value of a filter group operation, when
+// used in a request body (rather than as a query parameter).
+func filterGroupSchema(g *FilterGroup, op gen.Op) *ogen.Schema {
+	schema := &ogen.Schema{Type: "boolean"}
+	if !op.Niladic() {
+		schema = &ogen.Schema{AllOf: []*ogen.Schema{g.Schema}}
+		if op.Variadic() {
+			schema = g.Schema.AsArray()
 		}
+	}
+	schema.Description = g.Description(op)
+	return schema
+}
 
-		code := strconv.Itoa(http.StatusOK)
+// parameterSchema returns a copy of the schema of the provided parameter, including the
+// description of the parameter, for use as a property in a request body.
+func parameterSchema(param *ogen.Parameter) *ogen.Schema {
+	schema := param.Schema
+	if schema.Ref != "" {
+		schema = &ogen.Schema{AllOf: []*ogen.Schema{schema}}
+	} else {
+		clone := *schema
+		schema = &clone
+	}
+	schema.Description = param.Description
+	return schema
+}
 
-		if ea.GetPagination(cfg, e) || ra.GetPagination(cfg, e) {
-			addPagination(spec, cfg)
-			oper.Parameters = append(oper.Parameters,
-				&ogen.Parameter{Ref: "#/components/parameters/Page"},
-				&ogen.Parameter{
-					Name:        "per_page",
-					In:          "query",
-					Description: "The number of entities to retrieve per page.",
-					Schema: ogen.Int().
-						SetMinimum(ptr(int64(ta.GetMinItemsPerPage(cfg)))).
-						SetMaximum(ptr(int64(ta.GetMaxItemsPerPage(cfg)))).
-						SetDefault(json.RawMessage(strconv.Itoa(ta.GetItemsPerPage(cfg)))),
-				},
-			)
+// addSearchSchema adds a schema entry for the request body of the search operation of
+// the provided type into the spec, returning the name of the schema entry. The body
+// accepts the same pagination, sorting and filtering options as the list operation,
+// using the JSON names of the list parameters.
+func addSearchSchema(spec *ogen.Spec, cfg *Config, t *gen.Type) (ref string) {
+	ta := GetAnnotation(t)
+	ref = Singularize(t.Name) + "Search"
 
-			// If edge pagination is enabled, but edge type is not paginated, we cannot re-use
-			// the paginated schema from the edge type.
-			if !ra.GetPagination(cfg, e) {
-				oper.Responses[code] = oper.Responses[code].SetJSONContent(&ogen.Schema{
-					Ref: "#/components/schemas/" + rootEntityName + entityName + "List",
-				})
+	var props ogen.Properties
+
+	if ta.GetPagination(cfg, nil) {
+		mode := ta.GetPaginationMode(cfg, t.ID != nil)
+		addPagination(spec, cfg, mode)
+
+		page := spec.Components.Parameters[strings.TrimPrefix(paginationParameter(mode).Ref, "#/components/parameters/")]
+		props = append(props, ogen.Property{Name: page.Name, Schema: parameterSchema(page)})
+		props = append(props, ogen.Property{
+			Name: "per_page",
+			Schema: ogen.Int().
+				SetDescription("The number of entities to retrieve per page.").
+				SetMinimum(ptr(int64(ta.GetMinItemsPerPage(cfg)))).
+				SetMaximum(ptr(int64(ta.GetMaxItemsPerPage(cfg)))).
+				SetDefault(json.RawMessage(strconv.Itoa(ta.GetItemsPerPage(cfg)))),
+		})
+
+		if mode == PaginationOffset {
+			if param := countParameter(ta.GetPaginationCount(cfg)); param != nil {
+				props = append(props, ogen.Property{Name: param.Name, Schema: parameterSchema(param)})
 			}
-		} else if !cfg.DisableEagerLoadNonPagedOpt {
-			// We're setting a specific schema for the edge response because the edge isn't
-			// paginated, but the underlying edge type schema is.
-			oper.Responses[code] = oper.Responses[code].SetJSONContent(&ogen.Schema{
-				Ref: "#/components/schemas/" + rootEntityName + entityName + "List",
-			})
 		}
+	}
 
-		if sortable := GetSortableFields(e.Type, nil); len(sortable) > 1 {
-			sortParam := &ogen.Parameter{
-				Name:        "sort",
-				In:          "query",
-				Description: "Sort entity results by the given field.",
-				Schema:      &ogen.Schema{Ref: "#/components/schemas/" + addSortableFields(spec, e.Type, sortable)},
-			}
-			if v := ra.GetDefaultSort(t.ID != nil && (e == nil || e.Field() == nil)); v != "" {
-				sortParam.Schema = sortParam.Schema.SetDefault(json.RawMessage(fmt.Sprintf("%q", v)))
-			}
-			orderParam := &ogen.Parameter{
-				Name:        "order",
-				In:          "query",
+	if sortable := GetSortableFields(t, nil); len(sortable) > 1 {
+		props = append(
+			props,
+			ogen.Property{Name: "sort", Schema: parameterSchema(sortParameter(spec, t, sortable, ta.GetDefaultSort(t.ID != nil)))},
+			ogen.Property{Name: "order", Schema: &ogen.Schema{
 				Description: "Order the results in ascending or descending order.",
-				Schema: &ogen.Schema{
-					Type:    "string",
-					Enum:    sliceToRawMessage([]string{"asc", "desc"}),
-					Default: ogen.Default(json.RawMessage(fmt.Sprintf("%q", ra.GetDefaultOrder()))),
-				},
-			}
-			oper.Parameters = append(oper.Parameters, sortParam, orderParam)
+				Type:        "string",
+				Enum:        sliceToRawMessage([]string{"asc", "desc"}),
+				Default:     ogen.Default(json.RawMessage(fmt.Sprintf("%q", ta.GetDefaultOrder()))),
+			}},
+		)
+
+		if param := nullsParameter(t); param != nil {
+			props = append(props, ogen.Property{Name: param.Name, Schema: parameterSchema(param)})
 		}
+	}
 
-		if filters := GetFilterableFields(e.Type, nil); len(filters) > 0 {
-			oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/FilterOperation"})
+	filters := GetFilterableFields(t, nil)
+	groups := GetFilterGroups(t, nil)
 
-			for _, f := range filters {
-				name := f.ComponentName()
-				spec.Components.Parameters[name] = f.Parameter()
-				oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/" + name})
-			}
+	if len(filters) > 0 || len(groups) > 0 {
+		props = append(props, ogen.Property{Name: "filter_op", Schema: &ogen.Schema{
+			Description: "Filter operation to use.",
+			AllOf:       []*ogen.Schema{{Ref: "#/components/schemas/FilterOperation"}},
+		}})
+
+		for _, f := range filters {
+			props = append(props, ogen.Property{Name: SnakeCase(f.ComponentName()), Schema: parameterSchema(f.Parameter())})
 		}
 
-		if groups := GetFilterGroups(e.Type, nil); len(groups) > 0 {
-			for _, g := range groups {
-				for _, op := range g.Operations {
-					name := g.ComponentName(op)
-					spec.Components.Parameters[name] = g.Parameter(op)
-					oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/" + name})
-				}
+		for _, g := range groups {
+			for _, op := range g.Operations {
+				props = append(props, ogen.Property{Name: SnakeCase(g.ComponentName(op)), Schema: filterGroupSchema(g, op)})
 			}
 		}
 
-		if cfg.AddEdgesToTags {
-			oper.Tags = append(oper.Tags, edgesToTags(cfg, e.Type)...)
+		if param := addFilterExpressionParameter(spec, t); param != nil {
+			expr := spec.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+			props = append(props, ogen.Property{Name: "filter", Schema: &ogen.Schema{
+				Description: "Filter expression, which allows grouping filters. Uses the same format as the \"filter\" query parameter of the list operation, but doesn't need to be JSON-encoded.",
+				AllOf:       []*ogen.Schema{expr.Content["application/json"].Schema},
+			}})
 		}
+	}
 
-		spec.Paths[GetPathName(op, t, e, true)] = &ogen.PathItem{
-			Summary:     oper.Summary,
-			Description: oper.Description,
-			Get:         oper,
-			Parameters: []*ogen.Parameter{
-				{Ref: "#/components/parameters/PrettyResponse"},
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
-			},
-		}
-	default:
-		panic(fmt.Sprintf("unsupported operation %q", op))
+	if param := addExpandParameter(spec, t); param != nil {
+		expand := spec.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+		props = append(props, ogen.Property{Name: expand.Name, Schema: parameterSchema(expand)})
 	}
 
-	return spec, nil
+	spec.Components.Schemas[ref] = &ogen.Schema{
+		Description: fmt.Sprintf("Search options for %s entities, which are the same as the query parameters of the list operation.", Singularize(t.Name)),
+		Type:        "object",
+		Properties:  props,
+	}
+	return ref
 }
 
-// edgesToTags allows providing additional tags for a given operation based on the
-// eager-loaded schemas in the response schema.
-func edgesToTags(cfg *Config, t *gen.Type) (tags []string) {
-	for _, e := range t.Edges {
-		ea := GetAnnotation(e)
-		if !ea.GetSkip(cfg) && ea.GetEagerLoad(cfg) {
-			tags = append(tags, Pluralize(e.Type.Name))
-		}
+// addFieldsParameters adds parameter entries for selecting the fields of the provided
+// type, and the fields of its eager-loaded or expandable edges, returning references
+// to them.
+func addFieldsParameters(spec *ogen.Spec, t *gen.Type) (params []*ogen.Parameter) {
+	fields := GetSelectableFields(t)
+	if len(fields) == 0 {
+		return nil
 	}
-	return tags
-}
 
-// addSortableFields adds a schema entry for the provided type into the spec, returning
-// the name of the schema entry.
-func addSortableFields(spec *ogen.Spec, t *gen.Type, fields []string) (ref string) {
-	ref = Singularize(t.Name) + "SortableFields"
+	ref := Singularize(t.Name) + "Fields"
 
-	s := &ogen.Schema{
-		Description: "All potential sortable fields for " + Singularize(t.Name) + " entities.",
-		Type:        "string",
-		Enum:        sliceToRawMessage(fields),
+	spec.Components.Parameters[ref] = &ogen.Parameter{
+		Name:        "fields",
+		In:          "query",
+		Description: "Comma-separated list of fields to return for the " + Singularize(t.Name) + " entities, with only those fields being queried. The ID and edges are always returned. If not provided, all fields are returned.",
+		Style:       "form",
+		Explode:     ptr(false),
+		Schema:      fieldsSchema(fields),
 	}
-	if t.ID != nil {
-		s.Default = jsonschema.RawValue(`"id"`)
+	params = append(params, &ogen.Parameter{Ref: "#/components/parameters/" + ref})
+
+	for _, e := range GetSelectableEdges(t) {
+		edgeRef := ref + PascalCase(e.Name)
+
+		spec.Components.Parameters[edgeRef] = &ogen.Parameter{
+			Name:        "fields[" + e.Name + "]",
+			In:          "query",
+			Description: fmt.Sprintf("Comma-separated list of fields to return for the %q edge, if loaded. The ID and edges are always returned.", e.Name),
+			Style:       "form",
+			Explode:     ptr(false),
+			Schema:      fieldsSchema(GetSelectableFields(e.Type)),
+		}
+		params = append(params, &ogen.Parameter{Ref: "#/components/parameters/" + edgeRef})
 	}
-	spec.Components.Schemas[ref] = s
-	return ref
+	return params
+}
+
+// fieldsSchema returns the schema for a list of selectable fields.
+func fieldsSchema(fields []*gen.Field) *ogen.Schema {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name
+	}
+	return ogen.String().SetEnum(sliceToRawMessage(names)).AsArray().SetUniqueItems(true)
 }
 
 // addGlobalRequestHeaders adds the given headers to shared component parameters,
@@ -716,10 +2093,15 @@ func addGlobalErrorResponses(cfg *Config, spec *ogen.Spec, responses map[int]*og
 	for k, v := range responses {
 		name := "Error" + PascalCase(http.StatusText(k))
 		spec.Components.Schemas[name] = v
+		contentType := "application/json"
+		if cfg.ProblemDetails {
+			contentType = "application/problem+json"
+		}
+
 		spec.Components.Responses[name] = &ogen.Response{
 			Description: fmt.Sprintf("%s (http status code %d)", http.StatusText(k), k),
 			Content: map[string]ogen.Media{
-				"application/json": {
+				contentType: {
 					Schema: &ogen.Schema{Ref: "#/components/schemas/" + name},
 				},
 			},
@@ -738,10 +2120,17 @@ func addGlobalErrorResponses(cfg *Config, spec *ogen.Spec, responses map[int]*og
 
 			for k := range responses {
 				switch {
-				case strings.HasPrefix(op.OperationID, "list") && k == http.StatusNotFound && !cfg.ListNotFound:
+				case (strings.HasPrefix(op.OperationID, "count") || strings.HasPrefix(op.OperationID, "aggregate") || strings.HasPrefix(op.OperationID, "distinct")) && k == http.StatusNotFound:
+					continue
+				case (strings.HasPrefix(op.OperationID, "list") || strings.HasPrefix(op.OperationID, "search")) && k == http.StatusNotFound && !cfg.ListNotFound:
+					continue
+				case (strings.HasPrefix(op.OperationID, "deleteBulk") || strings.HasPrefix(op.OperationID, "updateBulk")) && k == http.StatusNotFound:
+					// Matching no entities isn't an error for bulk operations.
 					continue
 				case !strings.HasPrefix(op.OperationID, "create") && !strings.HasPrefix(op.OperationID, "update") && !strings.HasPrefix(op.OperationID, "upsert") && k == http.StatusConflict:
 					continue
+				case (k == http.StatusPreconditionFailed || k == http.StatusPreconditionRequired) && !hasIfMatchParameter(op):
+					continue
 				}
 
 				op.Responses[strconv.Itoa(k)] = &ogen.Response{Ref: "#/components/responses/Error" + PascalCase(http.StatusText(k))}
@@ -753,8 +2142,10 @@ func addGlobalErrorResponses(cfg *Config, spec *ogen.Spec, responses map[int]*og
 }
 
 // ErrorResponseObject returns a default error schema for the provided HTTP status code.
+// 400 errors also include the fields of the request which are invalid (e.g. because they
+// failed validation, or couldn't be decoded), when known.
 func ErrorResponseObject(code int) *ogen.Schema {
-	return &ogen.Schema{
+	schema := &ogen.Schema{
 		Type: "object",
 		Properties: []ogen.Property{
 			{
@@ -800,6 +2191,154 @@ func ErrorResponseObject(code int) *ogen.Schema {
 		},
 		Required: []string{"error", "type", "code", "timestamp"},
 	}
+
+	if code == http.StatusBadRequest {
+		schema.Properties = append(schema.Properties, fieldErrorsProperty())
+	}
+	return schema
+}
+
+// ProblemTypeURI returns the "type" member of Problem Details (RFC 9457) responses for the
+// provided HTTP status code. See [Config.ProblemTypeBaseURI].
+func ProblemTypeURI(baseURI string, code int) string {
+	if baseURI == "" {
+		return "about:blank"
+	}
+	return baseURI + KebabCase(strings.ReplaceAll(http.StatusText(code), "'", ""))
+}
+
+// ProblemDetailsObject returns a Problem Details (RFC 9457) error schema for the provided
+// HTTP status code, which is used instead of [ErrorResponseObject] when [Config.ProblemDetails]
+// is enabled. In addition to the standard members, it includes the "request_id" and "timestamp"
+// extension members, as well as "errors" for 400 errors (see [ErrorResponseObject]).
+func ProblemDetailsObject(code int, baseURI string) *ogen.Schema {
+	schema := &ogen.Schema{
+		Type: "object",
+		Properties: []ogen.Property{
+			{
+				Name: "type",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Format:      "uri-reference",
+					Description: "A URI reference which identifies the problem type.",
+					Example:     jsonschema.RawValue(strconv.Quote(ProblemTypeURI(baseURI, code))),
+				},
+			},
+			{
+				Name: "title",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "A short, human-readable summary of the problem type.",
+					Example:     jsonschema.RawValue(strconv.Quote(http.StatusText(code))),
+				},
+			},
+			{
+				Name: "status",
+				Schema: &ogen.Schema{
+					Type:        "integer",
+					Description: "The HTTP status code.",
+					Example:     jsonschema.RawValue(strconv.Itoa(code)),
+				},
+			},
+			{
+				Name: "detail",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "A human-readable explanation specific to this occurrence of the problem, which may be masked when debugging is disabled.",
+				},
+			},
+			{
+				Name: "instance",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Format:      "uri-reference",
+					Description: "The path of the request which caused the problem.",
+					Example:     jsonschema.RawValue(`"/pets/1"`),
+				},
+			},
+			{
+				Name: "request_id",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "The unique request ID for this error.",
+					Example:     jsonschema.RawValue(`"cb6f6f9c1783cdc9752cee2a4e95dd4c"`),
+				},
+			},
+			{
+				Name: "timestamp",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Format:      "date-time",
+					Description: "The timestamp of the error, in RFC3339 format.",
+					Example:     jsonschema.RawValue(`"2024-04-26T12:19:01Z"`),
+				},
+			},
+		},
+		Required: []string{"type", "title", "status"},
+	}
+
+	if code == http.StatusBadRequest {
+		schema.Properties = append(schema.Properties, fieldErrorsProperty())
+	}
+	return schema
+}
+
+// fieldErrorsProperty returns the "errors" property of 400 error schemas, which describes
+// the fields of the request which are invalid.
+func fieldErrorsProperty() ogen.Property {
+	return ogen.Property{
+		Name: "errors",
+		Schema: &ogen.Schema{
+			Type:        "array",
+			Description: "The fields which caused the error, if known.",
+			Items: &ogen.Items{Item: &ogen.Schema{
+				Type: "object",
+				Properties: []ogen.Property{
+					{Name: "field", Schema: &ogen.Schema{
+						Type:        "string",
+						Description: "The name (or path) of the field. Not provided if the error isn't specific to a field (e.g. malformed JSON).",
+						Example:     jsonschema.RawValue(`"age"`),
+					}},
+					{Name: "reason", Schema: &ogen.Schema{
+						Type:        "string",
+						Description: "Why the field is invalid.",
+						Example:     jsonschema.RawValue(`"value out of range"`),
+					}},
+					{Name: "value", Schema: &ogen.Schema{
+						Description: "The provided value, if known.",
+					}},
+				},
+				Required: []string{"reason"},
+			}},
+		},
+	}
+}
+
+// idParameters returns the path parameters which identify a single entity of the given
+// type. Edge schemas with a composite ID are identified by each of their ID fields (in
+// order), rather than a single shared ID parameter.
+func idParameters(t *gen.Type) ([]*ogen.Parameter, error) {
+	if !t.HasCompositeID() {
+		return []*ogen.Parameter{{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"}}, nil
+	}
+
+	params := make([]*ogen.Parameter, 0, len(t.EdgeSchema.ID))
+
+	for _, f := range t.EdgeSchema.ID {
+		schema, err := GetSchemaField(f)
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, &ogen.Parameter{
+			Name:        CamelCase(f.Name),
+			In:          "path",
+			Description: fmt.Sprintf("The %q field of the %s to act upon.", f.Name, Singularize(t.Name)),
+			Required:    true,
+			Schema:      schema,
+		})
+	}
+	return params, nil
 }
 
 // buildMutationOperation creates an OpenAPI operation and path item for mutation operations
@@ -809,6 +2348,7 @@ func buildMutationOperation(
 	op Operation,
 	t *gen.Type,
 	ta *Annotation,
+	idParams []*ogen.Parameter,
 	entityName string,
 	eagerLoadDepthMessage string,
 	summaryVerb string, // e.g., "Update", "Upsert", "Replace"
@@ -840,13 +2380,14 @@ func buildMutationOperation(
 		},
 	}
 
+	if op == OperationUpdate {
+		addPatchContent(spec, oper.RequestBody)
+	}
+
 	pathItem := &ogen.PathItem{
 		Summary:     fmt.Sprintf("Operate on a single %s entity", entityName),
 		Description: fmt.Sprintf("Operate on a single %s entity by its ID.", entityName),
-		Parameters: []*ogen.Parameter{
-			{Ref: "#/components/parameters/PrettyResponse"},
-			{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
-		},
+		Parameters:  append([]*ogen.Parameter{{Ref: "#/components/parameters/PrettyResponse"}}, idParams...),
 	}
 	setMethod(pathItem, oper)
 
@@ -870,6 +2411,18 @@ func GetOperationIDName(op Operation, t *gen.Type, e *gen.Edge) string {
 			return "get" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
 		case OperationList:
 			return "list" + Singularize(t.Name) + Pluralize(PascalCase(e.Name))
+		case OperationCreate:
+			return "create" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+		case OperationUpdate:
+			if GetAnnotation(e.Type).IsSubentity {
+				return "update" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+			}
+			return "link" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+		case OperationDelete:
+			if GetAnnotation(e.Type).IsSubentity {
+				return "delete" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+			}
+			return "unlink" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
 		default:
 			panic(fmt.Sprintf("unsupported operation %q", op))
 		}
@@ -882,6 +2435,12 @@ func GetOperationIDName(op Operation, t *gen.Type, e *gen.Edge) string {
 	switch op {
 	case OperationCreate:
 		return "create" + Singularize(t.Name)
+	case OperationBulkCreate:
+		return "createBulk" + Pluralize(t.Name)
+	case OperationBulkUpdate:
+		return "updateBulk" + Pluralize(t.Name)
+	case OperationBulkDelete:
+		return "deleteBulk" + Pluralize(t.Name)
 	case OperationUpdate:
 		return "update" + Singularize(t.Name)
 	case OperationUpsert:
@@ -892,6 +2451,14 @@ func GetOperationIDName(op Operation, t *gen.Type, e *gen.Edge) string {
 		return "get" + Singularize(t.Name)
 	case OperationList:
 		return "list" + Pluralize(t.Name)
+	case OperationSearch:
+		return "search" + Pluralize(t.Name)
+	case OperationCount:
+		return "count" + Pluralize(t.Name)
+	case OperationAggregate:
+		return "aggregate" + Pluralize(t.Name)
+	case OperationDistinct:
+		return "distinct" + Pluralize(t.Name)
 	case OperationDelete:
 		return "delete" + Singularize(t.Name)
 	default:
@@ -908,10 +2475,21 @@ func GetPathName(op Operation, t *gen.Type, e *gen.Edge, useUniqueID bool) strin
 		id = "{" + CamelCase(Singularize(t.Name)) + "ID}"
 	}
 
+	if e == nil && t.HasCompositeID() {
+		// Edge schemas with a composite ID are identified by each of their ID fields.
+		ids := make([]string, len(t.EdgeSchema.ID))
+		for i, f := range t.EdgeSchema.ID {
+			ids[i] = "{" + CamelCase(f.Name) + "}"
+		}
+		id = strings.Join(ids, "/")
+	}
+
 	if e != nil {
-		switch op {
-		case OperationRead, OperationList:
+		switch {
+		case op == OperationList || op == OperationCreate || (op == OperationRead && e.Unique):
 			return "/" + Pluralize(KebabCase(t.Name)) + "/" + id + "/" + KebabCase(e.Name)
+		case op == OperationRead || op == OperationUpdate || op == OperationDelete: // Nested, or link/unlink.
+			return "/" + Pluralize(KebabCase(t.Name)) + "/" + id + "/" + KebabCase(e.Name) + "/{" + CamelCase(Singularize(e.Name)) + "ID}"
 		default:
 			panic(fmt.Sprintf("unsupported operation %q", op))
 		}
@@ -920,13 +2498,124 @@ func GetPathName(op Operation, t *gen.Type, e *gen.Edge, useUniqueID bool) strin
 	switch op {
 	case OperationRead, OperationUpdate, OperationUpsert, OperationCreateOrReplace, OperationDelete:
 		return "/" + Pluralize(KebabCase(t.Name)) + "/" + id
-	case OperationCreate, OperationList:
+	case OperationCreate, OperationList, OperationBulkDelete, OperationBulkUpdate:
 		return "/" + Pluralize(KebabCase(t.Name))
+	case OperationSearch:
+		return "/" + Pluralize(KebabCase(t.Name)) + "/search"
+	case OperationCount:
+		return "/" + Pluralize(KebabCase(t.Name)) + "/count"
+	case OperationAggregate:
+		return "/" + Pluralize(KebabCase(t.Name)) + "/aggregate"
+	case OperationDistinct:
+		// There is one endpoint per field, under this path (see [GetDistinctPathName]).
+		return "/" + Pluralize(KebabCase(t.Name)) + "/distinct"
+	case OperationBulkCreate:
+		return "/" + Pluralize(KebabCase(t.Name)) + "/bulk"
 	default:
 		panic(fmt.Sprintf("unsupported operation %q", op))
 	}
 }
 
+// GetLookupPathName returns the path name of the read, update and delete operations
+// which look up entities of the given type using the provided field. Lookup keys are
+// prefixed with "by", so they don't conflict with the edge endpoints of the type in
+// routers which don't prioritize static path segments (e.g. "/users/by/email/{email}"
+// and "/users/{userID}/pets").
+func GetLookupPathName(t *gen.Type, f *gen.Field) string {
+	return "/" + Pluralize(KebabCase(t.Name)) + "/by/" + KebabCase(f.Name) + "/{" + CamelCase(f.Name) + "}"
+}
+
+// CheckPathConflicts returns an error if any two operations within the provided specs
+// can't be registered together on a Go 1.22+ [http.ServeMux], as both patterns match
+// some of the same paths, but neither is more specific than the other (e.g.
+// "/users/by/{x}/{y}" and "/users/{userID}/by/{z}"). ServeMux panics when registering
+// conflicting patterns, so this is checked during code generation instead.
+func CheckPathConflicts(specs ...*ogen.Spec) error {
+	type route struct {
+		method   string
+		path     string
+		segments []string
+	}
+
+	var routes []route
+	for _, spec := range specs {
+		for path, item := range spec.Paths {
+			PatchOperations(item, func(method string, op *ogen.Operation) *ogen.Operation {
+				if op != nil {
+					routes = append(routes, route{method: method, path: path, segments: strings.Split(strings.Trim(path, "/"), "/")})
+				}
+				return op
+			})
+		}
+	}
+
+	slices.SortFunc(routes, func(a, b route) int {
+		return cmp.Or(cmp.Compare(a.path, b.path), cmp.Compare(a.method, b.method))
+	})
+
+	for i, a := range routes {
+		for _, b := range routes[i+1:] {
+			if a.path == b.path || !methodsOverlap(a.method, b.method) || !patternsConflict(a.segments, b.segments) {
+				continue
+			}
+			return fmt.Errorf(
+				"path %q conflicts with path %q for %s requests, as both match some of the same paths, but neither is more specific than the other",
+				a.path,
+				b.path,
+				cmp.Or(a.method, b.method),
+			)
+		}
+	}
+	return nil
+}
+
+// methodsOverlap returns true if patterns with the provided methods can match the same
+// request. GET patterns also match HEAD requests.
+func methodsOverlap(a, b string) bool {
+	isGet := func(m string) bool { return m == http.MethodGet || m == http.MethodHead }
+	return a == b || (isGet(a) && isGet(b))
+}
+
+// patternsConflict returns true if the provided path segments (where segments wrapped
+// in braces are wildcards) overlap, and neither is more specific than the other.
+func patternsConflict(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	aSpecific, bSpecific := true, true
+	for i := range a {
+		aWild, bWild := strings.HasPrefix(a[i], "{"), strings.HasPrefix(b[i], "{")
+		switch {
+		case !aWild && !bWild && a[i] != b[i]:
+			return false // No overlap.
+		case aWild && !bWild:
+			aSpecific = false
+		case !aWild && bWild:
+			bSpecific = false
+		}
+	}
+	return aSpecific == bSpecific
+}
+
+// GetLookupOperationIDName returns the operation ID of the provided operation, when
+// looking up entities of the given type using the provided field.
+func GetLookupOperationIDName(op Operation, t *gen.Type, f *gen.Field) string {
+	return GetOperationIDName(op, t, nil) + "By" + PascalCase(f.Name)
+}
+
+// GetDistinctPathName returns the path name of the distinct operation for the given
+// type and field.
+func GetDistinctPathName(t *gen.Type, f *gen.Field) string {
+	return GetPathName(OperationDistinct, t, nil, false) + "/" + KebabCase(f.Name)
+}
+
+// GetDistinctOperationIDName returns the operation ID of the distinct operation for
+// the given type and field.
+func GetDistinctOperationIDName(t *gen.Type, f *gen.Field) string {
+	return GetOperationIDName(OperationDistinct, t, nil) + PascalCase(f.Name)
+}
+
 // PatchOperations applies a callback to each operation in a path inside of the OpenAPI spec.
 func PatchOperations(pathItem *ogen.PathItem, cb func(method string, op *ogen.Operation) *ogen.Operation) *ogen.PathItem {
 	pathItem.Get = cb(http.MethodGet, pathItem.Get)
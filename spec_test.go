@@ -24,12 +24,12 @@ func TestSpec_ThroughSchema_TwoTypes(t *testing.T) {
 	// Through schemas can be a bit different than normal schemas. Primarily:
 	//   - they may not have an ID field (if composite of two different IDs
 	//     via field.ID() annotation).
-	//   - if they don't have an ID, you cannot individually query them.
-	//   - you should still be able to create them in isolation, but updates/deletes
-	//     either require a where() clause (which we don't do outright), or must
-	//     be deleted through the edges in which they are attached (e.g. on a
-	//     Pet, we have remove_users_following, which removes the user from the
-	//     list of users following the pet).
+	//   - if they don't have an ID, they are individually queried, updated and
+	//     deleted using each of the fields of their composite ID in the path (e.g.
+	//     /follows/{userID}/{petID}).
+	//   - they can also be created in isolation, or linked/unlinked through the
+	//     edges in which they are attached (e.g. on a Pet, we have remove_followed_by,
+	//     which removes the user from the list of users following the pet).
 
 	assert.NotNil(t, r.json(`$.paths./follows.get.responses.200`))
 	assert.NotNil(t, r.json(`$.paths./follows.post.responses.201`))
@@ -42,13 +42,26 @@ func TestSpec_ThroughSchema_TwoTypes(t *testing.T) {
 	assert.NotNil(t, r.json(`$.components.schemas.PetUpdate.properties.add_followed_by`))
 	assert.NotNil(t, r.json(`$.components.schemas.PetUpdate.properties.remove_followed_by`))
 	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/follows"))
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPatch, http.MethodDelete}, getPathMethods(t, r, "/follows/{userID}/{petID}"))
+	assert.Equal(t, "userID", r.json(`$.paths./follows/{userID}/{petID}.parameters[1].name`))
+	assert.Equal(t, "string", r.json(`$.paths./follows/{userID}/{petID}.parameters[1].schema.type`))
+	assert.Equal(t, "petID", r.json(`$.paths./follows/{userID}/{petID}.parameters[2].name`))
+	assert.Equal(t, "integer", r.json(`$.paths./follows/{userID}/{petID}.parameters[2].schema.type`))
+	assert.Nil(t, r.json(`$.components.schemas.FollowUpdate.properties.user_id`))
+	assert.Nil(t, r.json(`$.components.schemas.FollowUpdate.properties.pet_id`))
+	assert.Nil(t, r.json(`$.components.parameters.FollowID`))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/pets/{petID}/followed-by"))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/users/{userID}/followed-pets"))
+	assert.ElementsMatch(t, []string{http.MethodPut, http.MethodDelete}, getPathMethods(t, r, "/pets/{petID}/followed-by/{followedByID}"))
+	assert.ElementsMatch(t, []string{http.MethodPut, http.MethodDelete}, getPathMethods(t, r, "/users/{userID}/followed-pets/{followedPetID}"))
 
 	allowedPaths := []string{
 		"/follows",
+		"/follows/{userID}/{petID}",
 		"/pets/{petID}/followed-by",
+		"/pets/{petID}/followed-by/{followedByID}",
 		"/users/{userID}/followed-pets",
+		"/users/{userID}/followed-pets/{followedPetID}",
 	}
 
 	for name := range r.spec.Paths {
@@ -72,7 +85,11 @@ func TestSpec_ThroughSchema_OneType(t *testing.T) {
 	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPatch, http.MethodDelete}, getPathMethods(t, r, "/friendships/{friendshipID}"))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/friendships/{friendshipID}/friend"))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/friendships/{friendshipID}/user"))
-	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/users/{userID}/friendships"))
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/users/{userID}/friendships"))
+
+	// Friendships created through a user have the user set from the path.
+	assert.NotContains(t, r.json(`$.components.schemas.UserFriendshipCreate.properties`), "user_id")
+	assert.NotNil(t, r.json(`$.components.schemas.UserFriendshipCreate.properties.friend_id`))
 
 	allowedPaths := []string{
 		"/friendships",
@@ -114,6 +131,601 @@ func TestSpec_HoistedEnums(t *testing.T) {
 	assert.Contains(t, r.json(`$.components.parameters.UserTypeNotIn.schema.items.$ref`), "/UserTypeEnum")
 }
 
+func TestSpec_FilterExpression(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.type", WithFilter(FilterGroupEqualExact|FilterGroupArray))
+			injectAnnotations(t, g, "User.email", WithFilter(FilterContains|FilterIsNil), WithFilterGroup("search"))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "#/components/parameters/UserFilterExpression", r.json(`$.paths./users.get.parameters[?(@.$ref == "#/components/parameters/UserFilterExpression")].$ref`))
+	assert.Equal(t, "filter", r.json(`$.components.parameters.UserFilterExpression.name`))
+	assert.Equal(t, "#/components/schemas/UserFilterExpression", r.json(`$.components.parameters.UserFilterExpression.content['application/json'].schema.$ref`))
+
+	assert.Equal(t, false, r.json(`$.components.schemas.UserFilterExpression.additionalProperties`))
+	assert.Equal(t, "#/components/schemas/UserFilterExpression", r.json(`$.components.schemas.UserFilterExpression.properties.or.items.$ref`))
+	assert.Equal(t, "#/components/schemas/UserFilterExpression", r.json(`$.components.schemas.UserFilterExpression.properties.not.allOf[0].$ref`))
+	assert.Contains(t, r.json(`$.components.schemas.UserFilterExpression.properties['type.eq'].allOf[0].$ref`), "/UserTypeEnum")
+	assert.Equal(t, "array", r.json(`$.components.schemas.UserFilterExpression.properties['type.in'].type`))
+	assert.Equal(t, "boolean", r.json(`$.components.schemas.UserFilterExpression.properties['email.null'].type`))
+	assert.Equal(t, "boolean", r.json(`$.components.schemas.UserFilterExpression.properties['search.null'].type`))
+	assert.NotNil(t, r.json(`$.components.schemas.UserFilterExpression.properties['search.has']`))
+
+	// Pets have no filters.
+	assert.Nil(t, r.json(`$.components.parameters.PetFilterExpression`))
+}
+
+func TestSpec_SearchOperation(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationSearch),
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithSortable(true), WithFilter(FilterEQ))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "searchPets", r.json(`$.paths./pets/search.post.operationId`))
+	assert.Equal(t, "#/components/schemas/PetSearch", r.json(`$.paths./pets/search.post.requestBody.content['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/PetList", r.json(`$.paths./pets/search.post.responses.200.content['application/json'].schema.$ref`))
+	assert.Nil(t, r.json(`$.paths./pets/search.post.responses.201`))
+
+	assert.Equal(t, "integer", r.json(`$.components.schemas.PetSearch.properties.page.type`))
+	assert.Equal(t, "array", r.json(`$.components.schemas.PetSearch.properties.sort.type`))
+	assert.Equal(t, "string", r.json(`$.components.schemas.PetSearch.properties.pet_name_eq.type`))
+	assert.Equal(t, "#/components/schemas/PetFilterExpression", r.json(`$.components.schemas.PetSearch.properties.filter.allOf[0].$ref`))
+}
+
+func TestSpec_BulkCreateOperation(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationBulkCreate),
+	})
+
+	assert.Equal(t, "createBulkPets", r.json(`$.paths./pets/bulk.post.operationId`))
+	assert.Equal(t, "#/components/schemas/PetBulkCreate", r.json(`$.paths./pets/bulk.post.requestBody.content['application/json'].schema.$ref`))
+	assert.Equal(t, "array", r.json(`$.paths./pets/bulk.post.responses.201.content['application/json'].schema.type`))
+	assert.Equal(t, "#/components/schemas/PetRead", r.json(`$.paths./pets/bulk.post.responses.201.content['application/json'].schema.items.$ref`))
+	assert.NotNil(t, r.json(`$.paths./pets/bulk.post.responses.409`))
+
+	assert.Equal(t, "array", r.json(`$.components.schemas.PetBulkCreate.type`))
+	assert.Equal(t, "#/components/schemas/PetCreate", r.json(`$.components.schemas.PetBulkCreate.items.$ref`))
+	assert.InDelta(t, 1, r.json(`$.components.schemas.PetBulkCreate.minItems`), 0)
+}
+
+func TestSpec_CountOperation(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationCount),
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithFilter(FilterEQ))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "countPets", r.json(`$.paths./pets/count.get.operationId`))
+	assert.Equal(t, "#/components/schemas/CountResult", r.json(`$.paths./pets/count.get.responses.200.content['application/json'].schema.$ref`))
+	assert.Nil(t, r.json(`$.paths./pets/count.get.responses.404`))
+	assert.Contains(t, r.json(`$.paths./pets/count.get.parameters[*].$ref`), "#/components/parameters/PetNameEQ")
+
+	// HEAD on the list endpoint returns the count in the headers, alongside the list operation.
+	assert.Equal(t, "countPetsHead", r.json(`$.paths./pets.head.operationId`))
+	assert.Equal(t, "listPets", r.json(`$.paths./pets.get.operationId`))
+	assert.Equal(t, "integer", r.json(`$.paths./pets.head.responses.200.headers.X-Total-Count.schema.type`))
+}
+
+func TestSpec_AggregateOperation(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationAggregate),
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithFilter(FilterEQ), WithAggregate(AggregateGroupBy))
+			injectAnnotations(t, g, "Pet.age", WithAggregate(AggregateSum, AggregateAvg))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "aggregatePets", r.json(`$.paths./pets/aggregate.get.operationId`))
+	assert.Equal(t, "#/components/schemas/PetAggregateList", r.json(`$.paths./pets/aggregate.get.responses.200.content['application/json'].schema.$ref`))
+	assert.Nil(t, r.json(`$.paths./pets/aggregate.get.responses.404`))
+	assert.Contains(t, r.json(`$.paths./pets/aggregate.get.parameters[*].$ref`), "#/components/parameters/PetNameEQ")
+	assert.Equal(t, []any{"group_by", "sum", "avg"}, r.json(`$.paths./pets/aggregate.get.parameters[?(@.in=="query")].name`))
+	assert.Equal(t, []any{"name"}, r.json(`$.paths./pets/aggregate.get.parameters[0].schema.items.enum`))
+
+	assert.Equal(t, "string", r.json(`$.components.schemas.PetAggregateRow.properties.name.type`))
+	assert.Equal(t, "integer", r.json(`$.components.schemas.PetAggregateRow.properties.age_sum.type`))
+	assert.Equal(t, "number", r.json(`$.components.schemas.PetAggregateRow.properties.age_avg.type`))
+	assert.Nil(t, r.json(`$.components.schemas.PetAggregateRow.properties.age_min`))
+
+	// Types without any aggregate fields don't have the operation.
+	assert.Nil(t, r.json(`$.paths./categories/aggregate`))
+}
+
+func TestSpec_DistinctOperation(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationDistinct),
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithFilter(FilterEQ), WithDistinct(true))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "distinctPetsName", r.json(`$.paths./pets/distinct/name.get.operationId`))
+	schema := `$.paths./pets/distinct/name.get.responses.200.content['application/json'].schema`
+	assert.Equal(t, "#/components/schemas/DistinctPagedResponse", r.json(schema+`.allOf[0].$ref`))
+	assert.Equal(t, "array", r.json(schema+`.allOf[1].properties.content.type`))
+	assert.Equal(t, "string", r.json(schema+`.allOf[1].properties.content.items.type`))
+	assert.ElementsMatch(t, []any{"page", "is_last_page", "has_more"}, r.json(`$.components.schemas.DistinctPagedResponse.required`))
+	assert.Nil(t, r.json(`$.paths./pets/distinct/name.get.responses.404`))
+	assert.Contains(t, r.json(`$.paths./pets/distinct/name.get.parameters[*].$ref`), "#/components/parameters/PetNameEQ")
+	assert.Contains(t, r.json(`$.paths./pets/distinct/name.get.parameters[*].$ref`), "#/components/parameters/Page")
+	assert.Nil(t, r.json(`$.paths./pets/distinct/age`))
+
+	// Values are always paged, even when the schema uses cursor pagination.
+	r = mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationDistinct),
+		PaginationMode:    PaginationCursor,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithDistinct(true))
+			return nil
+		},
+	})
+
+	assert.Contains(t, r.json(`$.paths./pets/distinct/name.get.parameters[*].$ref`), "#/components/parameters/Page")
+	assert.Contains(t, r.json(`$.paths./pets/distinct/name.get.parameters[*].name`), "per_page")
+	assert.Contains(t, r.json(`$.paths./pets.get.parameters[*].$ref`), "#/components/parameters/Cursor")
+}
+
+func TestSpec_EdgeCreateOperation(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	assert.Equal(t, "createUserPet", r.json(`$.paths./users/{userID}/pets.post.operationId`))
+	assert.Equal(t, "#/components/schemas/UserPetCreate", r.json(`$.paths./users/{userID}/pets.post.requestBody.content['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/PetRead", r.json(`$.paths./users/{userID}/pets.post.responses.201.content['application/json'].schema.$ref`))
+	assert.NotNil(t, r.json(`$.components.schemas.UserPetCreate.properties.name`))
+	assert.NotContains(t, r.json(`$.components.schemas.UserPetCreate.properties`), "owner")
+	assert.NotNil(t, r.json(`$.components.schemas.PetCreate.properties.owner`))
+
+	// Unique inverse edges (the owner isn't a child of the pet) can't be created through.
+	assert.Nil(t, r.json(`$.paths./pets/{petID}/owner.post`))
+
+	r = mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.pets", WithReadOnly(true))
+			injectAnnotations(t, g, "Category", WithExcludeOperations(OperationCreate))
+			return nil
+		},
+	})
+
+	assert.Nil(t, r.json(`$.paths./users/{userID}/pets.post`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}/categories.post`))
+	assert.Nil(t, r.json(`$.components.schemas.UserPetCreate`))
+
+	// The create operation must also be enabled on the edge itself.
+	r = mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.pets", WithExcludeOperations(OperationCreate))
+			return nil
+		},
+	})
+
+	assert.Nil(t, r.json(`$.paths./users/{userID}/pets.post`))
+	assert.NotNil(t, r.json(`$.paths./users/{userID}/pets.get`))
+}
+
+func TestSpec_SubentityOperations(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithSubentity(true))
+			return nil
+		},
+	})
+
+	assert.Nil(t, r.json(`$.paths./pets`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}`))
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/users/{userID}/pets"))
+	assert.ElementsMatch(
+		t,
+		[]string{http.MethodGet, http.MethodPatch, http.MethodDelete},
+		getPathMethods(t, r, "/users/{userID}/pets/{petID}"),
+	)
+
+	assert.Equal(t, "getUserPet", r.json(`$.paths./users/{userID}/pets/{petID}.get.operationId`))
+	assert.Equal(t, "updateUserPet", r.json(`$.paths./users/{userID}/pets/{petID}.patch.operationId`))
+	assert.Equal(t, "deleteUserPet", r.json(`$.paths./users/{userID}/pets/{petID}.delete.operationId`))
+	assert.Equal(t, "#/components/schemas/PetUpdate", r.json(`$.paths./users/{userID}/pets/{petID}.patch.requestBody.content['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/PetRead", r.json(`$.paths./users/{userID}/pets/{petID}.get.responses.200.content['application/json'].schema.$ref`))
+	assert.NotNil(t, r.json(`$.components.schemas.PetUpdate`))
+
+	// Operation IDs are unique per parent.
+	assert.Equal(t, "getCategoryPet", r.json(`$.paths./categories/{categoryID}/pets/{petID}.get.operationId`))
+
+	r = mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithSubentity(true), WithExcludeOperations(OperationDelete))
+			injectAnnotations(t, g, "Category.pets", WithReadOnly(true))
+			return nil
+		},
+	})
+
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPatch}, getPathMethods(t, r, "/users/{userID}/pets/{petID}"))
+	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/categories/{categoryID}/pets/{petID}"))
+}
+
+func TestSpec_EdgeLinkOperations(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	assert.Equal(t, "linkPetFriend", r.json(`$.paths./pets/{petID}/friends/{friendID}.put.operationId`))
+	assert.Equal(t, "unlinkPetFriend", r.json(`$.paths./pets/{petID}/friends/{friendID}.delete.operationId`))
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}/friends/{friendID}.put.responses.204`))
+	assert.Contains(t, r.json(`$.paths./pets/{petID}/friends/{friendID}.parameters[*].name`), "friendID")
+	assert.ElementsMatch(t, []string{http.MethodPut, http.MethodDelete}, getPathMethods(t, r, "/users/{userID}/pets/{petID}"))
+
+	// Unique edges can't be linked/unlinked.
+	assert.Nil(t, r.json(`$.paths./pets/{petID}/owner/{ownerID}`))
+
+	r = mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.friends", WithReadOnly(true))
+			injectAnnotations(t, g, "User.pets", WithExcludeOperations(OperationUpdate))
+			return nil
+		},
+	})
+
+	assert.Nil(t, r.json(`$.paths./pets/{petID}/friends/{friendID}`))
+	assert.Nil(t, r.json(`$.paths./users/{userID}/pets/{petID}`))
+	assert.NotNil(t, r.json(`$.paths./users/{userID}/pets.get`))
+}
+
+func TestSpec_LookupKey(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			for _, n := range g.Nodes {
+				for _, f := range n.Fields {
+					if n.Name == "User" && f.Name == "email" {
+						f.Unique = true
+					}
+				}
+			}
+			injectAnnotations(t, g, "User.email", WithLookupKey(true))
+			return nil
+		},
+	})
+
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPatch, http.MethodDelete}, getPathMethods(t, r, "/users/by/email/{email}"))
+	assert.Equal(t, "getUserByEmail", r.json(`$.paths./users/by/email/{email}.get.operationId`))
+	assert.Equal(t, "updateUserByEmail", r.json(`$.paths./users/by/email/{email}.patch.operationId`))
+	assert.Equal(t, "deleteUserByEmail", r.json(`$.paths./users/by/email/{email}.delete.operationId`))
+	assert.Equal(t, "#/components/schemas/UserRead", r.json(`$.paths./users/by/email/{email}.get.responses.200.content['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/UserUpdate", r.json(`$.paths./users/by/email/{email}.patch.requestBody.content['application/json'].schema.$ref`))
+	assert.NotNil(t, r.json(`$.paths./users/by/email/{email}.get.responses.404`))
+	assert.Equal(t, "email", r.json(`$.paths./users/by/email/{email}.parameters[?(@.in=="path")].name`))
+	assert.Equal(t, "string", r.json(`$.paths./users/by/email/{email}.parameters[?(@.in=="path")].schema.type`))
+
+	// The original operations should be left untouched.
+	assert.Equal(t, "getUser", r.json(`$.paths./users/{userID}.get.operationId`))
+	assert.Equal(t, "#/components/parameters/UserID", r.json(`$.paths./users/{userID}.parameters[1].$ref`))
+	assert.Nil(t, r.json(`$.paths./pets/by/name/{name}`))
+}
+
+func TestSpec_LookupKeyStdlib(t *testing.T) {
+	t.Parallel()
+
+	// Lookup paths shouldn't conflict with any other routes when registered on a ServeMux.
+	r := mustBuildSpec(t, &Config{
+		Handler: HandlerStdlib,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			for _, n := range g.Nodes {
+				for _, f := range n.Fields {
+					if n.Name == "User" && f.Name == "email" {
+						f.Unique = true
+					}
+				}
+			}
+			injectAnnotations(t, g, "User.email", WithLookupKey(true))
+			return nil
+		},
+	})
+	assert.NotNil(t, r.json(`$.paths./users/by/email/{email}`))
+	assert.NotNil(t, r.json(`$.paths./users/{userID}/pets`))
+}
+
+func TestCheckPathConflicts(t *testing.T) {
+	t.Parallel()
+
+	newSpec := func(paths map[string]*ogen.PathItem) *ogen.Spec {
+		spec := ogen.NewSpec()
+		spec.Paths = paths
+		return spec
+	}
+	op := &ogen.Operation{}
+
+	tests := []struct {
+		name     string
+		paths    map[string]*ogen.PathItem
+		conflict bool
+	}{
+		{
+			name: "static-more-specific",
+			paths: map[string]*ogen.PathItem{
+				"/users/by/email/{email}":  {Get: op},
+				"/users/{userID}/pets/{x}": {Get: op},
+				"/users/{userID}":          {Get: op},
+			},
+		},
+		{
+			name: "neither-more-specific",
+			paths: map[string]*ogen.PathItem{
+				"/users/by-email/{email}": {Get: op},
+				"/users/{userID}/pets":    {Get: op},
+			},
+			conflict: true,
+		},
+		{
+			name: "head-and-get",
+			paths: map[string]*ogen.PathItem{
+				"/users/by-email/{email}": {Head: op},
+				"/users/{userID}/pets":    {Get: op},
+			},
+			conflict: true,
+		},
+		{
+			name: "different-methods",
+			paths: map[string]*ogen.PathItem{
+				"/users/by-email/{email}": {Delete: op},
+				"/users/{userID}/pets":    {Get: op},
+			},
+		},
+		{
+			name: "equivalent-wildcards",
+			paths: map[string]*ogen.PathItem{
+				"/users/{id}":     {Get: op},
+				"/users/{userID}": {Get: op},
+			},
+			conflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckPathConflicts(newSpec(tt.paths))
+			if tt.conflict {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "neither is more specific")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSpec_ETags(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{EnableETags: true, RequireIfMatch: true})
+
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}.get.responses.200.headers.ETag`))
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}.patch.responses.200.headers.ETag`))
+	assert.NotNil(t, r.json(`$.paths./pets.post.responses.201.headers.ETag`))
+
+	for _, method := range []string{"patch", "delete"} {
+		assert.Equal(t, "header", r.json(`$.paths./pets/{petID}.`+method+`.parameters[?(@.name=="If-Match")].in`))
+		assert.Equal(t, true, r.json(`$.paths./pets/{petID}.`+method+`.parameters[?(@.name=="If-Match")].required`))
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}.`+method+`.responses.412`))
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}.`+method+`.responses.428`))
+	}
+
+	// Only operations which accept If-Match can fail the precondition.
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.get.parameters[?(@.name=="If-Match")]`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.get.responses.412`))
+	assert.Nil(t, r.json(`$.paths./pets.get.responses.412`))
+	assert.Nil(t, r.json(`$.paths./pets.post.responses.412`))
+
+	// Link/unlink operations are compared against the ETag of the entity the edge belongs to,
+	// and don't return an entity.
+	for _, method := range []string{"put", "delete"} {
+		path := `$.paths./users/{userID}/pets/{petID}.` + method
+		assert.Contains(t, r.json(path+`.parameters[?(@.name=="If-Match")].description`), "ETag of the User ")
+		assert.Equal(t, true, r.json(path+`.parameters[?(@.name=="If-Match")].required`))
+		assert.NotNil(t, r.json(path+`.responses.412`))
+		assert.NotNil(t, r.json(path+`.responses.428`))
+		assert.Nil(t, r.json(path+`.responses.204.headers`))
+	}
+
+	r = mustBuildSpec(t, &Config{
+		EnableETags: true,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithSubentity(true))
+			return nil
+		},
+	})
+
+	// Nested subentity operations are compared against the ETag of the subentity.
+	for _, method := range []string{"patch", "delete"} {
+		path := `$.paths./users/{userID}/pets/{petID}.` + method
+		assert.Contains(t, r.json(path+`.parameters[?(@.name=="If-Match")].description`), "ETag of the Pet ")
+		assert.NotNil(t, r.json(path+`.responses.412`))
+	}
+	assert.NotNil(t, r.json(`$.paths./users/{userID}/pets/{petID}.patch.responses.200.headers.ETag`))
+
+	r = mustBuildSpec(t, &Config{EnableETags: false})
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.get.responses.200.headers`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.patch.responses.412`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}/friends/{friendID}.put.responses.412`))
+}
+
+func TestSpec_PatchContentTypes(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationBulkUpdate),
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithFilter(FilterEQ))
+			return nil
+		},
+	})
+
+	path := `$.paths./pets/{petID}.patch.requestBody.content`
+	assert.Equal(t, "#/components/schemas/PetUpdate", r.json(path+`['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/PetUpdate", r.json(path+`['application/merge-patch+json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/JSONPatch", r.json(path+`['application/json-patch+json'].schema.$ref`))
+
+	assert.Equal(t, "array", r.json(`$.components.schemas.JSONPatch.type`))
+	assert.ElementsMatch(
+		t,
+		[]any{"add", "remove", "replace", "move", "copy", "test"},
+		r.json(`$.components.schemas.JSONPatch.items.properties.op.enum`),
+	)
+	assert.NotNil(t, r.json(`$.components.schemas.JSONPatch.items.properties.from`))
+
+	// Patch documents are only accepted by update operations of a single entity, as they're
+	// applied to its current state.
+	assert.Equal(t, "#/components/schemas/PetUpdate", r.json(`$.paths./pets.patch.requestBody.content['application/json'].schema.$ref`))
+	assert.Nil(t, r.json(`$.paths./pets.patch.requestBody.content['application/merge-patch+json']`))
+	assert.Nil(t, r.json(`$.paths./pets.patch.requestBody.content['application/json-patch+json']`))
+	assert.Nil(t, r.json(`$.paths./pets.post.requestBody.content['application/merge-patch+json']`))
+}
+
+func TestSpec_ConditionalGET(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		EnableConditionalGET: true,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.updated_at", WithLastModified(true))
+			return nil
+		},
+	})
+
+	for _, path := range []string{"/users", "/users/{userID}", "/users/{userID}/pets", "/pets/{petID}/owner"} {
+		assert.Equal(t, "header", r.json(`$.paths.`+path+`.get.parameters[?(@.name=="If-None-Match")].in`), path)
+		assert.NotNil(t, r.json(`$.paths.`+path+`.get.responses.304.headers.ETag`), path)
+		assert.NotNil(t, r.json(`$.paths.`+path+`.get.responses.200.headers.ETag`), path)
+	}
+
+	// Last-Modified is only provided for single entities with an annotated field.
+	assert.Equal(t, "header", r.json(`$.paths./users/{userID}.get.parameters[?(@.name=="If-Modified-Since")].in`))
+	assert.NotNil(t, r.json(`$.paths./users/{userID}.get.responses.200.headers.Last-Modified`))
+	assert.NotNil(t, r.json(`$.paths./users/{userID}.get.responses.304.headers.Last-Modified`))
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}/owner.get.responses.200.headers.Last-Modified`))
+	assert.Nil(t, r.json(`$.paths./users.get.parameters[?(@.name=="If-Modified-Since")]`))
+	assert.Nil(t, r.json(`$.paths./users.get.responses.200.headers.Last-Modified`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.get.parameters[?(@.name=="If-Modified-Since")]`))
+
+	// Only GET operations are conditional.
+	assert.Nil(t, r.json(`$.paths./users/{userID}.patch.responses.304`))
+	assert.Nil(t, r.json(`$.paths./users.post.responses.304`))
+
+	// Conditional GET requests are independent of ETags used for If-Match.
+	assert.Nil(t, r.json(`$.paths./users/{userID}.patch.responses.412`))
+
+	r = mustBuildSpec(t, &Config{EnableETags: true})
+	assert.Nil(t, r.json(`$.paths./users/{userID}.get.parameters[?(@.name=="If-None-Match")]`))
+	assert.Nil(t, r.json(`$.paths./users/{userID}.get.responses.304`))
+}
+
+func TestSpec_FieldErrors(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	assert.Equal(t, "array", r.json(`$.components.schemas.ErrorBadRequest.properties.errors.type`))
+	assert.Equal(t, "string", r.json(`$.components.schemas.ErrorBadRequest.properties.errors.items.properties.field.type`))
+	assert.Equal(t, "string", r.json(`$.components.schemas.ErrorBadRequest.properties.errors.items.properties.reason.type`))
+	assert.NotNil(t, r.json(`$.components.schemas.ErrorBadRequest.properties.errors.items.properties.value`))
+	assert.Nil(t, r.json(`$.components.schemas.ErrorNotFound.properties.errors`))
+
+	r = mustBuildSpec(t, &Config{ProblemDetails: true})
+	assert.Equal(t, "array", r.json(`$.components.schemas.ErrorBadRequest.properties.errors.type`))
+	assert.Nil(t, r.json(`$.components.schemas.ErrorNotFound.properties.errors`))
+}
+
+func TestSpec_ProblemDetails(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		ProblemDetails:     true,
+		ProblemTypeBaseURI: "https://example.com/problems/",
+		EnableETags:        true,
+	})
+
+	assert.Equal(t, "#/components/schemas/ErrorNotFound", r.json(`$.components.responses.ErrorNotFound.content['application/problem+json'].schema.$ref`))
+	assert.Nil(t, r.json(`$.components.responses.ErrorNotFound.content['application/json']`))
+	assert.Equal(t, "https://example.com/problems/not-found", r.json(`$.components.schemas.ErrorNotFound.properties.type.example`))
+	assert.Equal(t, "Not Found", r.json(`$.components.schemas.ErrorNotFound.properties.title.example`))
+	assert.InDelta(t, http.StatusNotFound, r.json(`$.components.schemas.ErrorNotFound.properties.status.example`), 0)
+	assert.NotNil(t, r.json(`$.components.schemas.ErrorNotFound.properties.instance`))
+	assert.NotNil(t, r.json(`$.components.schemas.ErrorNotFound.properties.request_id`))
+	assert.NotNil(t, r.json(`$.components.schemas.ErrorBadRequest.properties.errors.items.properties.field`))
+	assert.Nil(t, r.json(`$.components.schemas.ErrorNotFound.properties.error`))
+	assert.ElementsMatch(t, []any{"type", "title", "status"}, r.json(`$.components.schemas.ErrorNotFound.required`))
+
+	// Responses added through other options use the same format.
+	assert.Equal(t, "https://example.com/problems/precondition-failed", r.json(`$.components.schemas.ErrorPreconditionFailed.properties.type.example`))
+
+	r = mustBuildSpec(t, &Config{ProblemDetails: true})
+	assert.Equal(t, "about:blank", r.json(`$.components.schemas.ErrorNotFound.properties.type.example`))
+
+	r = mustBuildSpec(t, &Config{})
+	assert.Equal(t, "#/components/schemas/ErrorNotFound", r.json(`$.components.responses.ErrorNotFound.content['application/json'].schema.$ref`))
+	assert.NotNil(t, r.json(`$.components.schemas.ErrorNotFound.properties.error`))
+}
+
+func TestSpec_BulkOperations(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationBulkDelete, OperationBulkUpdate),
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithMaxBulkAffected(50))
+			injectAnnotations(t, g, "Pet.name", WithFilter(FilterEQ))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "deleteBulkPets", r.json(`$.paths./pets.delete.operationId`))
+	assert.Equal(t, "updateBulkPets", r.json(`$.paths./pets.patch.operationId`))
+	assert.Equal(t, "listPets", r.json(`$.paths./pets.get.operationId`))
+	assert.Equal(t, "#/components/schemas/PetUpdate", r.json(`$.paths./pets.patch.requestBody.content['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/BulkResult", r.json(`$.paths./pets.delete.responses.200.content['application/json'].schema.$ref`))
+	assert.Nil(t, r.json(`$.paths./pets.delete.responses.404`))
+	assert.Contains(t, r.json(`$.paths./pets.delete.description`), "more than 50 entities")
+
+	assert.Contains(t, r.json(`$.paths./pets.delete.parameters[*].$ref`), "#/components/parameters/PetNameEQ")
+	assert.Contains(t, r.json(`$.paths./pets.delete.parameters[*].$ref`), "#/components/parameters/DryRun")
+
+	// Only filters apply to bulk operations, not sorting, pagination, etc.
+	for _, method := range []string{"delete", "patch"} {
+		for _, name := range []string{"sort", "order", "page", "per_page", "expand", "fields"} {
+			assert.Nil(t, r.json(`$.paths./pets.`+method+`.parameters[?(@.name=="`+name+`")]`), "%s %s", method, name)
+		}
+		assert.NotContains(t, r.json(`$.paths./pets.`+method+`.parameters[*].$ref`), "#/components/parameters/Page")
+	}
+
+	// Types without filters can't have bulk operations.
+	assert.Nil(t, r.json(`$.paths./categories.delete`))
+}
+
 func TestSpec_Sensitive(t *testing.T) {
 	t.Parallel()
 
@@ -129,6 +741,72 @@ func TestSpec_Sensitive(t *testing.T) {
 	assert.NotContains(t, string(b), `"password_hashed"`)
 }
 
+func TestSpec_FieldsParameter(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.owner", WithEagerLoad(true))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "fields", r.json(`$.components.parameters.PetFields.name`))
+	assert.Equal(t, []any{"id", "name", "nicknames", "age"}, r.json(`$.components.parameters.PetFields.schema.items.enum`))
+	assert.Contains(t, r.json(`$.paths./pets/{petID}.get.parameters[*].$ref`), "#/components/parameters/PetFields")
+	assert.Contains(t, r.json(`$.paths./pets.get.parameters[*].$ref`), "#/components/parameters/PetFields")
+
+	// Only edges which are returned in responses can have their fields selected.
+	assert.Equal(t, "fields[owner]", r.json(`$.components.parameters.PetFieldsOwner.name`))
+	assert.Contains(t, r.json(`$.components.parameters.PetFieldsOwner.schema.items.enum`), "email")
+	assert.NotContains(t, r.json(`$.components.parameters.PetFieldsOwner.schema.items.enum`), "password_hashed")
+	assert.Nil(t, r.json(`$.components.parameters.PetFieldsFriends`))
+}
+
+func TestSpec_SortParameter(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithSortable(true))
+			return nil
+		},
+	})
+
+	assert.Contains(t, r.json(`$.components.schemas.PetSortableFields.enum`), "name")
+	assert.Contains(t, r.json(`$.components.schemas.PetSortableFields.enum`), "-name")
+	assert.Contains(t, r.json(`$.components.schemas.PetSortableFields.enum`), "random")
+	assert.NotContains(t, r.json(`$.components.schemas.PetSortableFields.enum`), "-random")
+
+	assert.Equal(t, "array", r.json(`$.paths./pets.get.parameters[?(@.name == "sort")].schema.type`))
+	assert.Equal(t, false, r.json(`$.paths./pets.get.parameters[?(@.name == "sort")].explode`))
+	assert.Equal(t, []any{"id"}, r.json(`$.paths./pets.get.parameters[?(@.name == "sort")].schema.default`))
+	assert.Equal(t, "#/components/schemas/PetSortableFields", r.json(`$.paths./pets.get.parameters[?(@.name == "sort")].schema.items.$ref`))
+}
+
+func TestSpec_NullsParameter(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.type", WithSortable(true))
+			injectAnnotations(t, g, "User.description", WithSortable(true))
+			injectAnnotations(t, g, "User.email", WithSortable(true), WithSortNulls(NullsLast))
+			return nil
+		},
+	})
+
+	assert.Equal(t, []any{"first", "last"}, r.json(`$.paths./users.get.parameters[?(@.name == "nulls")].schema.enum`))
+
+	desc, _ := r.json(`$.paths./users.get.parameters[?(@.name == "nulls")].description`).(string)
+	assert.Contains(t, desc, "(description, email)")
+	assert.Contains(t, desc, "email (last)")
+	assert.NotContains(t, desc, "type")
+
+	// Pets have no sortable fields which can be NULL.
+	assert.Nil(t, r.json(`$.paths./pets.get.parameters[?(@.name == "nulls")]`))
+}
+
 var testRequiredMethods = []string{
 	http.MethodGet,
 	http.MethodPost,
@@ -516,3 +1194,101 @@ func TestMergeSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestSpec_Constraints(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		DefaultOperations: append(slices.Clone(DefaultOperations), OperationAggregate),
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithMinLength(1), WithPattern(`^[a-z]+$`), WithFilter(FilterGroupEqualExact|FilterGroupContains))
+			injectAnnotations(t, g, "Pet.age",
+				WithMinimum(0),
+				WithMaximum(100),
+				WithFilter(FilterGroupArray),
+				WithAggregate(AggregateSum, AggregateMax),
+			)
+
+			// Simulate a validator (e.g. NotEmpty) on a Text field, without changing the
+			// shared schema.
+			for _, n := range g.Nodes {
+				for _, f := range n.Fields {
+					if n.Name == "AllTypes" && f.Name == "text" {
+						f.Validators++
+					}
+				}
+			}
+			return nil
+		},
+	})
+
+	// Derived from the MaxLen validator.
+	for _, name := range []string{"User", "UserCreate", "UserUpdate"} {
+		assert.Equal(t, 1000.0, r.json(`$.components.schemas.`+name+`.properties.description.maxLength`))
+		assert.Nil(t, r.json(`$.components.schemas.`+name+`.properties.name.maxLength`))
+	}
+
+	// Text fields have an unlimited size, even with other validators.
+	assert.Equal(t, "string", r.json(`$.components.schemas.AllType.properties.text.type`))
+	assert.Nil(t, r.json(`$.components.schemas.AllType.properties.text.maxLength`))
+
+	for _, name := range []string{"Pet", "PetCreate", "PetUpdate"} {
+		assert.Equal(t, 1.0, r.json(`$.components.schemas.`+name+`.properties.name.minLength`))
+		assert.Equal(t, "^[a-z]+$", r.json(`$.components.schemas.`+name+`.properties.name.pattern`))
+		assert.Equal(t, 0.0, r.json(`$.components.schemas.`+name+`.properties.age.minimum`))
+		assert.Equal(t, 100.0, r.json(`$.components.schemas.`+name+`.properties.age.maximum`))
+	}
+
+	assert.Equal(t, 1.0, r.json(`$.components.parameters.PetNameEQ.schema.minLength`))
+	assert.Nil(t, r.json(`$.components.parameters.PetNameContains.schema.minLength`))
+	assert.Equal(t, 100.0, r.json(`$.components.parameters.PetAgeIn.schema.items.maximum`))
+
+	// Sums aren't bound by the constraints of individual values.
+	assert.Nil(t, r.json(`$.components.schemas.PetAggregateRow.properties.age_sum.maximum`))
+	assert.Equal(t, 100.0, r.json(`$.components.schemas.PetAggregateRow.properties.age_max.maximum`))
+}
+
+func TestSpec_ConstraintsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		field       string
+		annotation  Annotation
+		errContains string
+	}{
+		{name: "length-on-int", field: "Pet.age", annotation: WithMaxLength(10), errContains: "only supported on string fields"},
+		{name: "minimum-on-string", field: "Pet.name", annotation: WithMinimum(1), errContains: "only supported on numeric fields"},
+		{name: "invalid-pattern", field: "Pet.name", annotation: WithPattern(`[a-z`), errContains: "invalid pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mustBuildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, tt.field, tt.annotation)
+
+					for _, n := range g.Nodes {
+						for _, f := range n.Fields {
+							if n.Name+"."+f.Name != tt.field {
+								continue
+							}
+
+							_, err := GetSchemaField(f)
+							require.Error(t, err)
+							assert.Contains(t, err.Error(), tt.errContains)
+
+							// Clear the annotation so the rest of the spec can be generated.
+							ant := GetAnnotation(f)
+							ant.Constraints = nil
+							f.Annotations.Set(ant.Name(), *ant)
+						}
+					}
+					return nil
+				},
+			})
+		})
+	}
+}
@@ -22,13 +22,35 @@ var (
 
 		// Use this function when you want to invoke annotation functions (which are
 		// often created if they depend on [Config]).
-		"getAnnotation":       GetAnnotation,
-		"getSortableFields":   GetSortableFields,
-		"getFilterableFields": GetFilterableFields,
-		"getFilterGroups":     GetFilterGroups,
-		"getOperationIDName":  GetOperationIDName,
-		"getPathName":         GetPathName,
-		"edgeHasOperation":    EdgeHasOperation,
+		"getAnnotation":              GetAnnotation,
+		"getSortableFields":          GetSortableFields,
+		"getCursorFields":            GetCursorFields,
+		"getNullableSortFields":      GetNullableSortFields,
+		"getExpandableEdges":         GetExpandableEdges,
+		"getExpandablePaths":         GetExpandablePaths,
+		"getSelectableFields":        GetSelectableFields,
+		"getSelectableEdges":         GetSelectableEdges,
+		"getFilterableFields":        GetFilterableFields,
+		"getFilterGroups":            GetFilterGroups,
+		"hasFilters":                 HasFilters,
+		"getAggregateFields":         GetAggregateFields,
+		"getAggregates":              GetAggregates,
+		"getDistinctFields":          GetDistinctFields,
+		"hasAggregates":              HasAggregates,
+		"getOperationIDName":         GetOperationIDName,
+		"getPathName":                GetPathName,
+		"getDistinctPathName":        GetDistinctPathName,
+		"getLookupFields":            GetLookupFields,
+		"getETagField":               GetETagField,
+		"getLastModifiedField":       GetLastModifiedField,
+		"getLookupPathName":          GetLookupPathName,
+		"getLookupOperationIDName":   GetLookupOperationIDName,
+		"getDistinctOperationIDName": GetDistinctOperationIDName,
+		"edgeHasOperation":           EdgeHasOperation,
+		"edgeHasLinkOperations":      EdgeHasLinkOperations,
+		"edgeHasCreateOperation":     EdgeHasCreateOperation,
+		"edgeHasNestedOperation":     EdgeHasNestedOperation,
+		"isCompositeIDField":         IsCompositeIDField,
 	}
 
 	//go:embed templates